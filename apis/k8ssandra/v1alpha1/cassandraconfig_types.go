@@ -35,435 +35,529 @@ type CassandraConfig struct {
 // CassandraYaml defines the contents of the cassandra.yaml file. For more info see:
 // https://cassandra.apache.org/doc/latest/cassandra/configuration/cass_yaml_file.html
 type CassandraYaml struct {
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	AllocateTokensForKeyspace *string `json:"allocate_tokens_for_keyspace,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AllocateTokensForLocalReplicationFactor *int `json:"allocate_tokens_for_local_replication_factor,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AuditLoggingOptions *AuditLogOptions `json:"audit_logging_options,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +kubebuilder:validation:Enum=ANY;ONE;TWO;THREE;QUORUM;ALL;LOCAL_QUORUM;EACH_QUORUM;SERIAL;LOCAL_SERIAL;LOCAL_ONE;NODE_LOCAL
 	// +optional
 	AuthReadConsistencyLevel *string `json:"auth_read_consistency_level,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +kubebuilder:validation:Enum=ANY;ONE;TWO;THREE;QUORUM;ALL;LOCAL_QUORUM;EACH_QUORUM;SERIAL;LOCAL_SERIAL;LOCAL_ONE;NODE_LOCAL
 	// +optional
 	AuthWriteConsistencyLevel *string `json:"auth_write_consistency_level,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	Authenticator *string `json:"authenticator,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	Authorizer *string `json:"authorizer,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	AutoHintsCleanupEnabled *bool `json:"auto_hints_cleanup_enabled,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AutoOptimiseFullRepairStreams *bool `json:"auto_optimise_full_repair_streams,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AutoOptimiseIncRepairStreams *bool `json:"auto_optimise_inc_repair_streams,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AutoOptimisePreviewRepairStreams *bool `json:"auto_optimise_preview_repair_streams,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	AutoSnapshot *bool `json:"auto_snapshot,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AutocompactionOnStartupEnabled *bool `json:"autocompaction_on_startup_enabled,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	AutomaticSstableUpgrade *bool `json:"automatic_sstable_upgrade,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	AvailableProcessors *int `json:"available_processors,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	BackPressureEnabled *bool `json:"back_pressure_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	BackPressureStrategy *ParameterizedClass `json:"back_pressure_strategy,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces batch_size_fail_threshold_in_kb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	BatchSizeFailThreshold *string `json:"batch_size_fail_threshold,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by batch_size_fail_threshold in 4.1.
 	// +optional
 	BatchSizeFailThresholdInKb *int `json:"batch_size_fail_threshold_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces batch_size_warn_threshold_in_kb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	BatchSizeWarnThreshold *string `json:"batch_size_warn_threshold,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by batch_size_warn_threshold in 4.1.
 	// +optional
 	BatchSizeWarnThresholdInKb *int `json:"batch_size_warn_threshold_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	BatchlogReplayThrottleInKb *int `json:"batchlog_replay_throttle_in_kb,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	BlockForPeersInRemoteDcs *bool `json:"block_for_peers_in_remote_dcs,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	BlockForPeersTimeoutInSecs *int `json:"block_for_peers_timeout_in_secs,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	BufferPoolUseHeapIfExhausted *bool `json:"buffer_pool_use_heap_if_exhausted,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces cas_contention_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	CasContentionTimeout *string `json:"cas_contention_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by cas_contention_timeout in 4.1.
 	// +optional
 	CasContentionTimeoutInMs *int `json:"cas_contention_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CdcEnabled *bool `json:"cdc_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CdcFreeSpaceCheckIntervalMs *int `json:"cdc_free_space_check_interval_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CdcRawDirectory *string `json:"cdc_raw_directory,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CdcTotalSpaceInMb *int `json:"cdc_total_space_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CheckForDuplicateRowsDuringCompaction *bool `json:"check_for_duplicate_rows_during_compaction,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CheckForDuplicateRowsDuringReads *bool `json:"check_for_duplicate_rows_during_reads,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ClientEncryptionOptions *encryption.ClientEncryptionOptions `json:"client_encryption_options,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	ClientErrorReportingExclusions *SubnetGroups `json:"client_error_reporting_exclusions,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ColumnIndexCacheSizeInKb *int `json:"column_index_cache_size_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces column_index_size_in_kb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	ColumnIndexSize *string `json:"column_index_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by column_index_size in 4.1.
 	// +optional
 	ColumnIndexSizeInKb *int `json:"column_index_size_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CommitlogCompression *ParameterizedClass `json:"commitlog_compression,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CommitlogMaxCompressionBuffersInPool *int `json:"commitlog_max_compression_buffers_in_pool,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CommitlogPeriodicQueueSize *int `json:"commitlog_periodic_queue_size,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces commitlog_segment_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	CommitlogSegmentSize *string `json:"commitlog_segment_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by commitlog_segment_size in 4.1.
 	// +optional
 	CommitlogSegmentSizeInMb *int `json:"commitlog_segment_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=periodic;batch;group
 	// +optional
 	CommitlogSync *string `json:"commitlog_sync,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CommitlogSyncBatchWindowInMs *string `json:"commitlog_sync_batch_window_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in 4.1, trunk. Replaces commitlog_sync_group_window_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	CommitlogSyncGroupWindow *string `json:"commitlog_sync_group_window,omitempty"`
+
+	// Exists in: 4.0, 4.1, trunk. Replaced by commitlog_sync_group_window in 4.1.
 	// +optional
 	CommitlogSyncGroupWindowInMs *int `json:"commitlog_sync_group_window_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces commitlog_sync_period_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	CommitlogSyncPeriod *string `json:"commitlog_sync_period,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by commitlog_sync_period in 4.1.
 	// +optional
 	CommitlogSyncPeriodInMs *int `json:"commitlog_sync_period_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces commitlog_total_space_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	CommitlogTotalSpace *string `json:"commitlog_total_space,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by commitlog_total_space in 4.1.
 	// +optional
 	CommitlogTotalSpaceInMb *int `json:"commitlog_total_space_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CompactionLargePartitionWarningThresholdMb *int `json:"compaction_large_partition_warning_threshold_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces compaction_throughput_mb_per_sec.
+	// +kubebuilder:validation:Pattern=`^\d+(B/s|KiB/s|MiB/s)$`
+	// +optional
+	CompactionThroughput *string `json:"compaction_throughput,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by compaction_throughput in 4.1.
 	// +optional
 	CompactionThroughputMbPerSec *int `json:"compaction_throughput_mb_per_sec,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	CompactionTombstoneWarningThreshold *int `json:"compaction_tombstone_warning_threshold,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ConcurrentCompactors *int `json:"concurrent_compactors,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ConcurrentCounterWrites *int `json:"concurrent_counter_writes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	ConcurrentMaterializedViewBuilders *int `json:"concurrent_materialized_view_builders,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ConcurrentMaterializedViewWrites *int `json:"concurrent_materialized_view_writes,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ConcurrentReads *int `json:"concurrent_reads,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ConcurrentReplicates *int `json:"concurrent_replicates,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	ConcurrentValidations *int `json:"concurrent_validations,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ConcurrentWrites *int `json:"concurrent_writes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	ConsecutiveMessageErrorsThreshold *int `json:"consecutive_message_errors_threshold,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=disabled;warn;exception
 	// +optional
 	CorruptedTombstoneStrategy *string `json:"corrupted_tombstone_strategy,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CounterCacheKeysToSave *int `json:"counter_cache_keys_to_save,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CounterCacheSavePeriod *int `json:"counter_cache_save_period,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces counter_cache_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	CounterCacheSize *string `json:"counter_cache_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by counter_cache_size in 4.1.
 	// +optional
 	CounterCacheSizeInMb *int `json:"counter_cache_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces counter_write_request_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	CounterWriteRequestTimeout *string `json:"counter_write_request_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by counter_write_request_timeout in 4.1.
 	// +optional
 	CounterWriteRequestTimeoutInMs *int `json:"counter_write_request_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CredentialsCacheMaxEntries *int `json:"credentials_cache_max_entries,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces credentials_update_interval_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	CredentialsUpdateInterval *string `json:"credentials_update_interval,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by credentials_update_interval in 4.1.
 	// +optional
 	CredentialsUpdateIntervalInMs *int `json:"credentials_update_interval_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces credentials_validity_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	CredentialsValidity *string `json:"credentials_validity,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by credentials_validity in 4.1.
 	// +optional
 	CredentialsValidityInMs *int `json:"credentials_validity_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	CrossNodeTimeout *bool `json:"cross_node_timeout,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	DefaultKeyspaceRf *int `json:"default_keyspace_rf,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +kubebuilder:validation:Enum=ANY;ONE;TWO;THREE;QUORUM;ALL;LOCAL_QUORUM;EACH_QUORUM;SERIAL;LOCAL_SERIAL;LOCAL_ONE;NODE_LOCAL
 	// +optional
 	DenylistConsistencyLevel *string `json:"denylist_consistency_level,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	DenylistInitialLoadRetrySeconds *int `json:"denylist_initial_load_retry_seconds,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	DenylistMaxKeysPerTable *int `json:"denylist_max_keys_per_table,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	DenylistMaxKeysTotal *int `json:"denylist_max_keys_total,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	DenylistRefreshSeconds *int `json:"denylist_refresh_seconds,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	DiagnosticEventsEnabled *bool `json:"diagnostic_events_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=auto;mmap;mmap_index_only;standard
 	// +optional
 	DiskAccessMode *string `json:"disk_access_mode,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	DiskOptimizationEstimatePercentile *string `json:"disk_optimization_estimate_percentile,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	DiskOptimizationPageCrossChance *string `json:"disk_optimization_page_cross_chance,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=ssd;spinning
 	// +optional
 	DiskOptimizationStrategy *string `json:"disk_optimization_strategy,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces enable_drop_compact_storage.
+	// +optional
+	DropCompactStorageEnabled *bool `json:"drop_compact_storage_enabled,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	DynamicSnitch *bool `json:"dynamic_snitch,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	DynamicSnitchBadnessThreshold *string `json:"dynamic_snitch_badness_threshold,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	DynamicSnitchResetIntervalInMs *int `json:"dynamic_snitch_reset_interval_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	DynamicSnitchUpdateIntervalInMs *int `json:"dynamic_snitch_update_interval_in_ms,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	EnableDenylistRangeReads *bool `json:"enable_denylist_range_reads,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	EnableDenylistReads *bool `json:"enable_denylist_reads,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	EnableDenylistWrites *bool `json:"enable_denylist_writes,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by drop_compact_storage_enabled in 4.1.
 	// +optional
 	EnableDropCompactStorage *bool `json:"enable_drop_compact_storage,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by materialized_views_enabled in 4.1.
 	// +optional
 	EnableMaterializedViews *bool `json:"enable_materialized_views,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	EnablePartitionDenylist *bool `json:"enable_partition_denylist,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by sasi_indexes_enabled in 4.1.
 	// +optional
 	EnableSasiIndexes *bool `json:"enable_sasi_indexes,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by scripted_user_defined_functions_enabled in 4.1.
 	// +optional
 	EnableScriptedUserDefinedFunctions *bool `json:"enable_scripted_user_defined_functions,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk. Replaced by transient_replication_enabled in 4.1.
 	// +optional
 	EnableTransientReplication *bool `json:"enable_transient_replication,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by user_defined_functions_enabled in 4.1.
 	// +optional
 	EnableUserDefinedFunctions *bool `json:"enable_user_defined_functions,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by user_defined_functions_threads_enabled in 4.1.
 	// +optional
 	EnableUserDefinedFunctionsThreads *bool `json:"enable_user_defined_functions_threads,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	EndpointSnitch *string `json:"endpoint_snitch,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	FailureDetector *string `json:"failure_detector,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	FileCacheEnabled *bool `json:"file_cache_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	FileCacheRoundUp *bool `json:"file_cache_round_up,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces file_cache_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	FileCacheSize *string `json:"file_cache_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by file_cache_size in 4.1.
 	// +optional
 	FileCacheSizeInMb *int `json:"file_cache_size_in_mb,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=none;fast;table
 	// +optional
 	FlushCompression *string `json:"flush_compression,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	FullQueryLoggingOptions *FullQueryLoggerOptions `json:"full_query_logging_options,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces gc_log_threshold_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	GcLogThreshold *string `json:"gc_log_threshold,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by gc_log_threshold in 4.1.
 	// +optional
 	GcLogThresholdInMs *int `json:"gc_log_threshold_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces gc_warn_threshold_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	GcWarnThreshold *string `json:"gc_warn_threshold,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by gc_warn_threshold in 4.1.
 	// +optional
 	GcWarnThresholdInMs *int `json:"gc_warn_threshold_in_ms,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	HintWindowPersistentEnabled *bool `json:"hint_window_persistent_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	HintedHandoffDisabledDatacenters *[]string `json:"hinted_handoff_disabled_datacenters,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	HintedHandoffEnabled *bool `json:"hinted_handoff_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces hinted_handoff_throttle_in_kb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	HintedHandoffThrottle *string `json:"hinted_handoff_throttle,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by hinted_handoff_throttle in 4.1.
 	// +optional
 	HintedHandoffThrottleInKb *int `json:"hinted_handoff_throttle_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	HintsCompression *ParameterizedClass `json:"hints_compression,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces hints_flush_period_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	HintsFlushPeriod *string `json:"hints_flush_period,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by hints_flush_period in 4.1.
 	// +optional
 	HintsFlushPeriodInMs *int `json:"hints_flush_period_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=ANY;ONE;TWO;THREE;QUORUM;ALL;LOCAL_QUORUM;EACH_QUORUM;SERIAL;LOCAL_SERIAL;LOCAL_ONE;NODE_LOCAL
 	// +optional
 	IdealConsistencyLevel *string `json:"ideal_consistency_level,omitempty"`
@@ -472,64 +566,64 @@ type CassandraYaml struct {
 	// +optional
 	IndexInterval *int `json:"index_interval,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	IndexSummaryCapacityInMb *int `json:"index_summary_capacity_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	IndexSummaryResizeIntervalInMinutes *int `json:"index_summary_resize_interval_in_minutes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InitialRangeTombstoneListAllocationSize *int `json:"initial_range_tombstone_list_allocation_size,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	InterDcStreamThroughputOutboundMegabitsPerSec *int `json:"inter_dc_stream_throughput_outbound_megabits_per_sec,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	InterDcTcpNodelay *bool `json:"inter_dc_tcp_nodelay,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeApplicationReceiveQueueCapacityInBytes *int `json:"internode_application_receive_queue_capacity_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeApplicationReceiveQueueReserveEndpointCapacityInBytes *int `json:"internode_application_receive_queue_reserve_endpoint_capacity_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeApplicationReceiveQueueReserveGlobalCapacityInBytes *int `json:"internode_application_receive_queue_reserve_global_capacity_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeApplicationSendQueueCapacityInBytes *int `json:"internode_application_send_queue_capacity_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeApplicationSendQueueReserveEndpointCapacityInBytes *int `json:"internode_application_send_queue_reserve_endpoint_capacity_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeApplicationSendQueueReserveGlobalCapacityInBytes *int `json:"internode_application_send_queue_reserve_global_capacity_in_bytes,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	InternodeAuthenticator *string `json:"internode_authenticator,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=all;none;dc
 	// +optional
 	InternodeCompression *string `json:"internode_compression,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	InternodeErrorReportingExclusions *SubnetGroups `json:"internode_error_reporting_exclusions,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeMaxMessageSizeInBytes *int `json:"internode_max_message_size_in_bytes,omitempty"`
 
@@ -541,164 +635,203 @@ type CassandraYaml struct {
 	// +optional
 	InternodeSendBuffSizeInBytes *int `json:"internode_send_buff_size_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeSocketReceiveBufferSizeInBytes *int `json:"internode_socket_receive_buffer_size_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeSocketSendBufferSizeInBytes *int `json:"internode_socket_send_buffer_size_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeStreamingTcpUserTimeoutInMs *int `json:"internode_streaming_tcp_user_timeout_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeTcpConnectTimeoutInMs *int `json:"internode_tcp_connect_timeout_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	InternodeTcpUserTimeoutInMs *int `json:"internode_tcp_user_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	KeyCacheKeysToSave *int `json:"key_cache_keys_to_save,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	KeyCacheMigrateDuringCompaction *bool `json:"key_cache_migrate_during_compaction,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	KeyCacheSavePeriod *int `json:"key_cache_save_period,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces key_cache_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	KeyCacheSize *string `json:"key_cache_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by key_cache_size in 4.1.
 	// +optional
 	KeyCacheSizeInMb *int `json:"key_cache_size_in_mb,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	KeyspaceCountWarnThreshold *int `json:"keyspace_count_warn_threshold,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in 4.1, trunk. Replaces enable_materialized_views.
+	// +optional
+	MaterializedViewsEnabled *bool `json:"materialized_views_enabled,omitempty"`
+
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	MaxConcurrentAutomaticSstableUpgrades *int `json:"max_concurrent_automatic_sstable_upgrades,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces max_hint_window_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	MaxHintWindow *string `json:"max_hint_window,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by max_hint_window in 4.1.
 	// +optional
 	MaxHintWindowInMs *int `json:"max_hint_window_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MaxHintsDeliveryThreads *int `json:"max_hints_delivery_threads,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces max_hints_file_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	MaxHintsFileSize *string `json:"max_hints_file_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by max_hints_file_size in 4.1.
 	// +optional
 	MaxHintsFileSizeInMb *int `json:"max_hints_file_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MaxMutationSizeInKb *int `json:"max_mutation_size_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MaxStreamingRetries *int `json:"max_streaming_retries,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MaxValueSizeInMb *int `json:"max_value_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=unslabbed_heap_buffers;unslabbed_heap_buffers_logged;heap_buffers;offheap_buffers;offheap_objects
 	// +optional
 	MemtableAllocationType *string `json:"memtable_allocation_type,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MemtableCleanupThreshold *string `json:"memtable_cleanup_threshold,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MemtableFlushWriters *int `json:"memtable_flush_writers,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces memtable_heap_space_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	MemtableHeapSpace *string `json:"memtable_heap_space,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by memtable_heap_space in 4.1.
 	// +optional
 	MemtableHeapSpaceInMb *int `json:"memtable_heap_space_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces memtable_offheap_space_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	MemtableOffheapSpace *string `json:"memtable_offheap_space,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by memtable_offheap_space in 4.1.
 	// +optional
 	MemtableOffheapSpaceInMb *int `json:"memtable_offheap_space_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	MinFreeSpacePerDriveInMb *int `json:"min_free_space_per_drive_in_mb,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	MinimumKeyspaceRf *int `json:"minimum_keyspace_rf,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	NativeTransportAllowOlderProtocols *bool `json:"native_transport_allow_older_protocols,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportFlushInBatchesLegacy *bool `json:"native_transport_flush_in_batches_legacy,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in 4.1, trunk. Replaces native_transport_idle_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	NativeTransportIdleTimeout *string `json:"native_transport_idle_timeout,omitempty"`
+
+	// Exists in: 4.0, 4.1, trunk. Replaced by native_transport_idle_timeout in 4.1.
 	// +optional
 	NativeTransportIdleTimeoutInMs *int `json:"native_transport_idle_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportMaxConcurrentConnections *int `json:"native_transport_max_concurrent_connections,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportMaxConcurrentConnectionsPerIp *int `json:"native_transport_max_concurrent_connections_per_ip,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportMaxConcurrentRequestsInBytes *int `json:"native_transport_max_concurrent_requests_in_bytes,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportMaxConcurrentRequestsInBytesPerIp *int `json:"native_transport_max_concurrent_requests_in_bytes_per_ip,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces native_transport_max_frame_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	NativeTransportMaxFrameSize *string `json:"native_transport_max_frame_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by native_transport_max_frame_size in 4.1.
 	// +optional
 	NativeTransportMaxFrameSizeInMb *int `json:"native_transport_max_frame_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportMaxNegotiableProtocolVersion *int `json:"native_transport_max_negotiable_protocol_version,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	NativeTransportMaxRequestsPerSecond *int `json:"native_transport_max_requests_per_second,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NativeTransportMaxThreads *int `json:"native_transport_max_threads,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	NativeTransportRateLimitingEnabled *bool `json:"native_transport_rate_limiting_enabled,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	NativeTransportReceiveQueueCapacityInBytes *int `json:"native_transport_receive_queue_capacity_in_bytes,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	NetworkAuthorizer *string `json:"network_authorizer,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	NetworkingCacheSizeInMb *int `json:"networking_cache_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	NumTokens *int `json:"num_tokens,omitempty"`
 
@@ -706,92 +839,112 @@ type CassandraYaml struct {
 	// +optional
 	OtcBacklogExpirationIntervalMs *int `json:"otc_backlog_expiration_interval_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	OtcCoalescingEnoughCoalescedMessages *int `json:"otc_coalescing_enough_coalesced_messages,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	OtcCoalescingStrategy *string `json:"otc_coalescing_strategy,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	OtcCoalescingWindowUs *int `json:"otc_coalescing_window_us,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	PaxosCacheSizeInMb *int `json:"paxos_cache_size_in_mb,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	PeriodicCommitlogSyncLagBlockInMs *int `json:"periodic_commitlog_sync_lag_block_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	PermissionsCacheMaxEntries *int `json:"permissions_cache_max_entries,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces permissions_update_interval_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	PermissionsUpdateInterval *string `json:"permissions_update_interval,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by permissions_update_interval in 4.1.
 	// +optional
 	PermissionsUpdateIntervalInMs *int `json:"permissions_update_interval_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces permissions_validity_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	PermissionsValidity *string `json:"permissions_validity,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by permissions_validity in 4.1.
 	// +optional
 	PermissionsValidityInMs *int `json:"permissions_validity_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	PhiConvictThreshold *string `json:"phi_convict_threshold,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	PreparedStatementsCacheSizeMb *int `json:"prepared_statements_cache_size_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces range_request_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	RangeRequestTimeout *string `json:"range_request_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by range_request_timeout in 4.1.
 	// +optional
 	RangeRequestTimeoutInMs *int `json:"range_request_timeout_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	RangeTombstoneListGrowthFactor *string `json:"range_tombstone_list_growth_factor,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces read_request_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	ReadRequestTimeout *string `json:"read_request_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by read_request_timeout in 4.1.
 	// +optional
 	ReadRequestTimeoutInMs *int `json:"read_request_timeout_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	RejectRepairCompactionThreshold *int `json:"reject_repair_compaction_threshold,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=queue;reject
 	// +optional
 	RepairCommandPoolFullStrategy *string `json:"repair_command_pool_full_strategy,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	RepairCommandPoolSize *int `json:"repair_command_pool_size,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	RepairSessionMaxTreeDepth *int `json:"repair_session_max_tree_depth,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	RepairSessionSpaceInMb *int `json:"repair_session_space_in_mb,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	RepairedDataTrackingForPartitionReadsEnabled *bool `json:"repaired_data_tracking_for_partition_reads_enabled,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	RepairedDataTrackingForRangeReadsEnabled *bool `json:"repaired_data_tracking_for_range_reads_enabled,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ReplicaFilteringProtection *ReplicaFilteringProtectionOptions `json:"replica_filtering_protection,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	ReportUnconfirmedRepairedDataMismatches *bool `json:"report_unconfirmed_repaired_data_mismatches,omitempty"`
 
@@ -808,83 +961,116 @@ type CassandraYaml struct {
 	// +optional
 	RequestSchedulerOptions *RequestSchedulerOptions `json:"request_scheduler_options,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces request_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	RequestTimeout *string `json:"request_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by request_timeout in 4.1.
 	// +optional
 	RequestTimeoutInMs *int `json:"request_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	RoleManager *string `json:"role_manager,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	RolesCacheMaxEntries *int `json:"roles_cache_max_entries,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces roles_update_interval_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	RolesUpdateInterval *string `json:"roles_update_interval,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by roles_update_interval in 4.1.
 	// +optional
 	RolesUpdateIntervalInMs *int `json:"roles_update_interval_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces roles_validity_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	RolesValidity *string `json:"roles_validity,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by roles_validity in 4.1.
 	// +optional
 	RolesValidityInMs *int `json:"roles_validity_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	RowCacheClassName *string `json:"row_cache_class_name,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	RowCacheKeysToSave *int `json:"row_cache_keys_to_save,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	RowCacheSavePeriod *int `json:"row_cache_save_period,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces row_cache_size_in_mb.
+	// +kubebuilder:validation:Pattern=`^\d+(B|KiB|MiB|GiB)$`
+	// +optional
+	RowCacheSize *string `json:"row_cache_size,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by row_cache_size in 4.1.
 	// +optional
 	RowCacheSizeInMb *int `json:"row_cache_size_in_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces enable_sasi_indexes.
+	// +optional
+	SasiIndexesEnabled *bool `json:"sasi_indexes_enabled,omitempty"`
+
+	// Exists in 4.1, trunk. Replaces enable_scripted_user_defined_functions.
+	// +optional
+	ScriptedUserDefinedFunctionsEnabled *bool `json:"scripted_user_defined_functions_enabled,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	ServerEncryptionOptions *encryption.ServerEncryptionOptions `json:"server_encryption_options,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces slow_query_log_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	SlowQueryLogTimeout *string `json:"slow_query_log_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by slow_query_log_timeout in 4.1.
 	// +optional
 	SlowQueryLogTimeoutInMs *int `json:"slow_query_log_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	SnapshotBeforeCompaction *bool `json:"snapshot_before_compaction,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	SnapshotLinksPerSecond *int `json:"snapshot_links_per_second,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	SnapshotOnDuplicateRowDetection *bool `json:"snapshot_on_duplicate_row_detection,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	SnapshotOnRepairedDataMismatch *bool `json:"snapshot_on_repaired_data_mismatch,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	SstablePreemptiveOpenIntervalInMb *int `json:"sstable_preemptive_open_interval_in_mb,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	StreamEntireSstables *bool `json:"stream_entire_sstables,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	StreamThroughputOutboundMegabitsPerSec *int `json:"stream_throughput_outbound_megabits_per_sec,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	StreamingConnectionsPerHost *int `json:"streaming_connections_per_host,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	StreamingKeepAlivePeriodInSecs *int `json:"streaming_keep_alive_period_in_secs,omitempty"`
 
@@ -892,7 +1078,7 @@ type CassandraYaml struct {
 	// +optional
 	StreamingSocketTimeoutInMs *int `json:"streaming_socket_timeout_in_ms,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	TableCountWarnThreshold *int `json:"table_count_warn_threshold,omitempty"`
 
@@ -908,76 +1094,98 @@ type CassandraYaml struct {
 	// +optional
 	ThriftPreparedStatementsCacheSizeMb *int `json:"thrift_prepared_statements_cache_size_mb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	TombstoneFailureThreshold *int `json:"tombstone_failure_threshold,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	TombstoneWarnThreshold *int `json:"tombstone_warn_threshold,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	TracetypeQueryTtl *int `json:"tracetype_query_ttl,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	TracetypeRepairTtl *int `json:"tracetype_repair_ttl,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	TrackWarnings *TrackWarnings `json:"track_warnings,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk. Replaces enable_transient_replication.
+	// +optional
+	TransientReplicationEnabled *bool `json:"transient_replication_enabled,omitempty"`
+
+	// Exists in 4.1, trunk
 	// +optional
 	TraverseAuthFromRoot *bool `json:"traverse_auth_from_root,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	TrickleFsync *bool `json:"trickle_fsync,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	TrickleFsyncIntervalInKb *int `json:"trickle_fsync_interval_in_kb,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces truncate_request_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	TruncateRequestTimeout *string `json:"truncate_request_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by truncate_request_timeout in 4.1.
 	// +optional
 	TruncateRequestTimeoutInMs *int `json:"truncate_request_timeout_in_ms,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	UnloggedBatchAcrossPartitionsWarnThreshold *int `json:"unlogged_batch_across_partitions_warn_threshold,omitempty"`
 
-	// Exists in trunk
+	// Exists in 4.1, trunk
 	// +optional
 	UseDeterministicTableId *bool `json:"use_deterministic_table_id,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	UseOffheapMerkleTrees *bool `json:"use_offheap_merkle_trees,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	UserDefinedFunctionFailTimeout *int `json:"user_defined_function_fail_timeout,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	UserDefinedFunctionWarnTimeout *int `json:"user_defined_function_warn_timeout,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces enable_user_defined_functions.
+	// +optional
+	UserDefinedFunctionsEnabled *bool `json:"user_defined_functions_enabled,omitempty"`
+
+	// Exists in 4.1, trunk. Replaces enable_user_defined_functions_threads.
+	// +optional
+	UserDefinedFunctionsThreadsEnabled *bool `json:"user_defined_functions_threads_enabled,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +kubebuilder:validation:Enum=ignore;die;die_immediate
 	// +optional
 	UserFunctionTimeoutPolicy *string `json:"user_function_timeout_policy,omitempty"`
 
-	// Exists in: 4.0, trunk
+	// Exists in: 4.0, 4.1, trunk
 	// +optional
 	ValidationPreviewPurgeHeadStartInSec *int `json:"validation_preview_purge_head_start_in_sec,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 3.11, 4.0, 4.1, trunk
 	// +optional
 	WindowsTimerInterval *int `json:"windows_timer_interval,omitempty"`
 
-	// Exists in 3.11, 4.0, trunk
+	// Exists in 4.1, trunk. Replaces write_request_timeout_in_ms.
+	// +kubebuilder:validation:Pattern=`^\d+(d|h|m|s|ms|us|ns)$`
+	// +optional
+	WriteRequestTimeout *string `json:"write_request_timeout,omitempty"`
+
+	// Exists in 3.11, 4.0, 4.1, trunk. Replaced by write_request_timeout in 4.1.
 	// +optional
 	WriteRequestTimeoutInMs *int `json:"write_request_timeout_in_ms,omitempty"`
 }
@@ -0,0 +1,180 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CassandraPermission is a CQL permission that can be granted on a keyspace or a table.
+// +kubebuilder:validation:Enum=ALL;CREATE;ALTER;DROP;SELECT;MODIFY;AUTHORIZE;DESCRIBE
+type CassandraPermission string
+
+const (
+	PermissionAll       = CassandraPermission("ALL")
+	PermissionCreate    = CassandraPermission("CREATE")
+	PermissionAlter     = CassandraPermission("ALTER")
+	PermissionDrop      = CassandraPermission("DROP")
+	PermissionSelect    = CassandraPermission("SELECT")
+	PermissionModify    = CassandraPermission("MODIFY")
+	PermissionAuthorize = CassandraPermission("AUTHORIZE")
+	PermissionDescribe  = CassandraPermission("DESCRIBE")
+)
+
+// CassandraGrantTarget identifies the role and the resource that permissions are granted on.
+type CassandraGrantTarget struct {
+
+	// Role is the name of the Cassandra role that the permissions are granted to.
+	// +kubebuilder:validation:Required
+	Role string `json:"role"`
+
+	// Keyspace is the keyspace on which the permissions are granted.
+	// +kubebuilder:validation:Required
+	Keyspace string `json:"keyspace"`
+
+	// Table is the table of the keyspace on which the permissions are granted. If empty, the permissions are
+	// granted on the whole keyspace.
+	// +optional
+	Table string `json:"table,omitempty"`
+}
+
+// CassandraGrantSpec defines the desired state of CassandraGrant
+type CassandraGrantSpec struct {
+
+	// Cluster is a reference to the K8ssandraCluster, in the same namespace, in which the permissions should be
+	// managed.
+	// +kubebuilder:validation:Required
+	Cluster corev1.LocalObjectReference `json:"cluster"`
+
+	CassandraGrantTarget `json:",inline"`
+
+	// Permissions are the permissions to grant. Permissions that are removed from this list are revoked.
+	// +kubebuilder:validation:MinItems=1
+	Permissions []CassandraPermission `json:"permissions"`
+}
+
+// AppliedCassandraGrant describes permissions that were granted to a role.
+type AppliedCassandraGrant struct {
+	CassandraGrantTarget `json:",inline"`
+
+	Permissions []CassandraPermission `json:"permissions,omitempty"`
+}
+
+type CassandraGrantConditionType string
+
+const (
+	// CassandraGrantReady is true when the desired permissions have been granted.
+	CassandraGrantReady CassandraGrantConditionType = "Ready"
+)
+
+type CassandraGrantCondition struct {
+	Type   CassandraGrantConditionType `json:"type"`
+	Status corev1.ConditionStatus      `json:"status"`
+
+	// LastTransitionTime is the last time the condition transited from one status to another.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message is a human-readable explanation of the current status of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CassandraGrantStatus defines the observed state of CassandraGrant
+type CassandraGrantStatus struct {
+
+	// ObservedGeneration is the most recent generation of the spec that was applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Applied describes the permissions that were last granted. They are revoked when the CassandraGrant is deleted.
+	// +optional
+	Applied *AppliedCassandraGrant `json:"applied,omitempty"`
+
+	// +optional
+	Conditions []CassandraGrantCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.spec.role`
+// +kubebuilder:printcolumn:name="Keyspace",type=string,JSONPath=`.spec.keyspace`
+// +kubebuilder:printcolumn:name="Table",type=string,JSONPath=`.spec.table`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+
+// CassandraGrant is the Schema for the cassandragrants API. It declares permissions of a CQL role on a keyspace or a
+// table of a K8ssandraCluster. Deleting a CassandraGrant revokes the permissions.
+type CassandraGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CassandraGrantSpec   `json:"spec,omitempty"`
+	Status CassandraGrantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CassandraGrantList contains a list of CassandraGrant
+type CassandraGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CassandraGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CassandraGrant{}, &CassandraGrantList{})
+}
+
+func (in *CassandraGrantStatus) GetConditionStatus(conditionType CassandraGrantConditionType) corev1.ConditionStatus {
+	if in != nil {
+		for _, condition := range in.Conditions {
+			if condition.Type == conditionType {
+				return condition.Status
+			}
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+func (in *CassandraGrantStatus) SetCondition(condition CassandraGrantCondition) {
+	for i, c := range in.Conditions {
+		if c.Type == condition.Type {
+			if c.Status == condition.Status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			in.Conditions[i] = condition
+			return
+		}
+	}
+	in.Conditions = append(in.Conditions, condition)
+}
+
+func (in *CassandraGrantStatus) SetReady(ready bool, message string) {
+	now := metav1.Now()
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	in.SetCondition(CassandraGrantCondition{
+		Type:               CassandraGrantReady,
+		Status:             status,
+		LastTransitionTime: &now,
+		Message:            message,
+	})
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CassandraKeyspaceSpec defines the desired state of CassandraKeyspace
+type CassandraKeyspaceSpec struct {
+
+	// Cluster is a reference to the K8ssandraCluster, in the same namespace, in which the keyspace should be managed.
+	// +kubebuilder:validation:Required
+	Cluster corev1.LocalObjectReference `json:"cluster"`
+
+	// Name is the name of the keyspace in Cassandra. If empty, the name of this object is used. Changing the name
+	// does not rename the keyspace: a new keyspace is created and the previous one is left untouched.
+	// +optional
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z0-9_]{1,48}$"
+	Name string `json:"name,omitempty"`
+
+	// Replication maps datacenter names to their desired replication factor. Datacenters of the K8ssandraCluster
+	// that are not listed here get DefaultReplicationFactor replicas. Specify a value of 0 to exclude a datacenter
+	// from replication. External datacenters are only included if they are listed here.
+	// +optional
+	Replication map[string]int `json:"replication,omitempty"`
+
+	// DefaultReplicationFactor is the replication factor applied to the datacenters of the K8ssandraCluster that are
+	// not listed in Replication. It is capped by the size of each datacenter. Defaults to 3.
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	DefaultReplicationFactor *int `json:"defaultReplicationFactor,omitempty"`
+
+	// DurableWrites controls whether the commit log is used for updates on this keyspace. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	DurableWrites *bool `json:"durableWrites,omitempty"`
+}
+
+type CassandraKeyspaceConditionType string
+
+const (
+	// CassandraKeyspaceReady is true when the keyspace exists and its settings match the desired state.
+	CassandraKeyspaceReady CassandraKeyspaceConditionType = "Ready"
+)
+
+type CassandraKeyspaceCondition struct {
+	Type   CassandraKeyspaceConditionType `json:"type"`
+	Status corev1.ConditionStatus         `json:"status"`
+
+	// LastTransitionTime is the last time the condition transited from one status to another.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message is a human-readable explanation of the current status of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CassandraKeyspaceStatus defines the observed state of CassandraKeyspace
+type CassandraKeyspaceStatus struct {
+
+	// ObservedGeneration is the most recent generation of the spec that was applied to the keyspace.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Replication is the replication that was last applied to the keyspace, by datacenter name.
+	// +optional
+	Replication map[string]int `json:"replication,omitempty"`
+
+	// DurableWrites is the durable_writes setting that was last applied to the keyspace.
+	// +optional
+	DurableWrites *bool `json:"durableWrites,omitempty"`
+
+	// +optional
+	Conditions []CassandraKeyspaceCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=cks
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CassandraKeyspace is the Schema for the cassandrakeyspaces API. It declares a keyspace of a K8ssandraCluster along
+// with its per-datacenter replication. Deleting a CassandraKeyspace does not drop the keyspace.
+type CassandraKeyspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CassandraKeyspaceSpec   `json:"spec,omitempty"`
+	Status CassandraKeyspaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CassandraKeyspaceList contains a list of CassandraKeyspace
+type CassandraKeyspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CassandraKeyspace `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CassandraKeyspace{}, &CassandraKeyspaceList{})
+}
+
+// KeyspaceName returns the name of the keyspace in Cassandra.
+func (in *CassandraKeyspace) KeyspaceName() string {
+	if in.Spec.Name != "" {
+		return in.Spec.Name
+	}
+	return in.Name
+}
+
+// IsDurableWrites returns the desired durable_writes setting, true if unset.
+func (in *CassandraKeyspaceSpec) IsDurableWrites() bool {
+	return in.DurableWrites == nil || *in.DurableWrites
+}
+
+func (in *CassandraKeyspaceStatus) GetConditionStatus(conditionType CassandraKeyspaceConditionType) corev1.ConditionStatus {
+	if in != nil {
+		for _, condition := range in.Conditions {
+			if condition.Type == conditionType {
+				return condition.Status
+			}
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+func (in *CassandraKeyspaceStatus) SetCondition(condition CassandraKeyspaceCondition) {
+	for i, c := range in.Conditions {
+		if c.Type == condition.Type {
+			if c.Status == condition.Status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			in.Conditions[i] = condition
+			return
+		}
+	}
+	in.Conditions = append(in.Conditions, condition)
+}
+
+func (in *CassandraKeyspaceStatus) SetReady(ready bool, message string) {
+	now := metav1.Now()
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	in.SetCondition(CassandraKeyspaceCondition{
+		Type:               CassandraKeyspaceReady,
+		Status:             status,
+		LastTransitionTime: &now,
+		Message:            message,
+	})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CassandraRoleSpec defines the desired state of CassandraRole
+type CassandraRoleSpec struct {
+
+	// Cluster is a reference to the K8ssandraCluster, in the same namespace, in which the role should be managed.
+	// +kubebuilder:validation:Required
+	Cluster corev1.LocalObjectReference `json:"cluster"`
+
+	// Name is the name of the role in Cassandra. If empty, the name of this object is used.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// SecretRef is a reference to the Secret, in the same namespace, that holds the password of the role under the
+	// "password" key. If the Secret does not exist, it is created with a random password. The Secret is replicated
+	// to the namespaces and Kubernetes clusters of all the datacenters of the K8ssandraCluster. Changes to the
+	// password are applied to the role.
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+
+	// Login controls whether the role is allowed to log in. Defaults to true.
+	// +optional
+	// +kubebuilder:default=true
+	Login *bool `json:"login,omitempty"`
+
+	// Superuser controls whether the role is a superuser. Defaults to false.
+	// +optional
+	// +kubebuilder:default=false
+	Superuser bool `json:"superuser,omitempty"`
+}
+
+type CassandraRoleConditionType string
+
+const (
+	// CassandraRoleReady is true when the role exists and its settings match the desired state.
+	CassandraRoleReady CassandraRoleConditionType = "Ready"
+)
+
+type CassandraRoleCondition struct {
+	Type   CassandraRoleConditionType `json:"type"`
+	Status corev1.ConditionStatus     `json:"status"`
+
+	// LastTransitionTime is the last time the condition transited from one status to another.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message is a human-readable explanation of the current status of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CassandraRoleStatus defines the observed state of CassandraRole
+type CassandraRoleStatus struct {
+
+	// ObservedGeneration is the most recent generation of the spec that was applied to the role.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Exists is true if the role was found in the cluster the last time it was reconciled.
+	// +optional
+	Exists bool `json:"exists,omitempty"`
+
+	// SecretResourceVersion is the resource version of the Secret that the password was last read from.
+	// +optional
+	SecretResourceVersion string `json:"secretResourceVersion,omitempty"`
+
+	// +optional
+	Conditions []CassandraRoleCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`
+// +kubebuilder:printcolumn:name="Exists",type=boolean,JSONPath=`.status.exists`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CassandraRole is the Schema for the cassandraroles API. It declares a CQL role of a K8ssandraCluster. Deleting a
+// CassandraRole drops the role.
+type CassandraRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CassandraRoleSpec   `json:"spec,omitempty"`
+	Status CassandraRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CassandraRoleList contains a list of CassandraRole
+type CassandraRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CassandraRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CassandraRole{}, &CassandraRoleList{})
+}
+
+// RoleName returns the name of the role in Cassandra.
+func (in *CassandraRole) RoleName() string {
+	if in.Spec.Name != "" {
+		return in.Spec.Name
+	}
+	return in.Name
+}
+
+// CanLogin returns the desired login flag, true if unset.
+func (in *CassandraRoleSpec) CanLogin() bool {
+	return in.Login == nil || *in.Login
+}
+
+func (in *CassandraRoleStatus) GetConditionStatus(conditionType CassandraRoleConditionType) corev1.ConditionStatus {
+	if in != nil {
+		for _, condition := range in.Conditions {
+			if condition.Type == conditionType {
+				return condition.Status
+			}
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+func (in *CassandraRoleStatus) SetCondition(condition CassandraRoleCondition) {
+	for i, c := range in.Conditions {
+		if c.Type == condition.Type {
+			if c.Status == condition.Status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			in.Conditions[i] = condition
+			return
+		}
+	}
+	in.Conditions = append(in.Conditions, condition)
+}
+
+func (in *CassandraRoleStatus) SetReady(ready bool, message string) {
+	now := metav1.Now()
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	in.SetCondition(CassandraRoleCondition{
+		Type:               CassandraRoleReady,
+		Status:             status,
+		LastTransitionTime: &now,
+		Message:            message,
+	})
+}
@@ -18,6 +18,8 @@ const (
 	// currently being added will be updated. Specifying multiple DCs can be useful though
 	// if you add multiple DCs to the cluster at once (Note that the CassandraDatacenters
 	// are still deployed serially).
+	//
+	// In v1alpha2, this is the keyspaceReplication field of the datacenter template.
 	DcReplicationAnnotation = "k8ssandra.io/dc-replication"
 
 	// RebuildSourceDcAnnotation tells the operation the DC from which to stream when
@@ -26,8 +28,60 @@ const (
 	// condition is true.
 	RebuildSourceDcAnnotation = "k8ssandra.io/rebuild-src-dc"
 
+	// DcRebuildSourceAnnotation tells the operator the DC from which to stream when rebuilding each DC, and takes
+	// precedence over RebuildSourceDcAnnotation. The value should be serialized JSON mapping the name of the DCs to
+	// the name of their source DC, e.g., {"dc3": "dc2"}.
+	//
+	// In v1alpha2, this is the rebuildFrom field of the datacenter template.
+	DcRebuildSourceAnnotation = "k8ssandra.io/dc-rebuild-src"
+
+	// RebuildDcAnnotation was set by previous versions of the operator while the datacenter named by its value was
+	// being rebuilt. It is still honored for rebuilds started by those versions, see K8ssandraCluster.IsRebuilding.
+	//
+	// Deprecated: the datacenter being rebuilt is now reported in the rebuild field of the status.
 	RebuildDcAnnotation = "k8ssandra.io/rebuild-dc"
 
+	// ResumeUpgradeAnnotation tells the operator to retry the failed Cassandra version upgrade of the datacenter
+	// named by the annotation value. The annotation is removed once the upgrade has been resumed.
+	ResumeUpgradeAnnotation = "k8ssandra.io/resume-upgrade"
+
+	// PausedAnnotation tells the operator to stop reconciling a K8ssandraCluster, Stargate or Reaper object when set
+	// to "true". The status of a paused object is still updated, but none of the resources it manages are created,
+	// updated or deleted. Pausing a K8ssandraCluster does not pause its Stargate and Reaper objects, nor does it stop
+	// its CassandraDatacenters.
+	PausedAnnotation = "k8ssandra.io/paused"
+
+	// DryRunAnnotation tells the operator to compute the changes it would make to a K8ssandraCluster when set to
+	// "true", without applying them. The changes are reported in the plan field of the status. Removing the
+	// annotation applies them. A K8ssandraCluster in dry-run mode is not cleaned up when it is deleted.
+	DryRunAnnotation = "k8ssandra.io/dry-run"
+
+	// RotateCredentialsAnnotation tells the operator to rotate the credentials that it generated for the superuser,
+	// Reaper and Medusa when set to "true". The annotation is removed once the rotation has started. Rotations
+	// require authentication to be enabled.
+	RotateCredentialsAnnotation = "k8ssandra.io/rotate-credentials"
+
+	// CredentialsRevisionAnnotation is set by the operator on the pod templates of the Cassandra, Reaper and Stargate
+	// pods, and on the rotated secrets, to the revision of the last credentials rotation. Changing it restarts the
+	// pods so that they pick up the new credentials.
+	CredentialsRevisionAnnotation = "k8ssandra.io/credentials-revision"
+
+	// RollingRestartAnnotation tells the operator to restart all the Cassandra nodes of a K8ssandraCluster when set
+	// to "true". The datacenters are restarted one at a time, in the order in which they are declared. The annotation
+	// is removed once the restart has started.
+	RollingRestartAnnotation = "k8ssandra.io/rolling-restart"
+
+	// RestartRevisionAnnotation is set by the operator on the pod template of a CassandraDatacenter to the revision
+	// of the last rolling restart of the datacenter. Changing it makes cass-operator restart the pods, one rack at a
+	// time.
+	RestartRevisionAnnotation = "k8ssandra.io/restart-revision"
+
+	// ReplaceNodeAnnotation tells the operator to replace the dead Cassandra node running in the pod named by the
+	// annotation value. The pod is recreated with an empty volume and the node takes over the token ranges of the dead
+	// node. Once it is replaced, the node is repaired if Reaper is deployed. The annotation is removed once the
+	// replacement has started. Only one node is replaced at a time.
+	ReplaceNodeAnnotation = "k8ssandra.io/replace-node"
+
 	RebuildLabel = "k8ssandra.io/rebuild"
 
 	NameLabel      = "app.kubernetes.io/name"
@@ -17,6 +17,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
@@ -67,6 +71,18 @@ type K8ssandraClusterSpec struct {
 	// Replication settings changes will only apply to system_* keyspaces as well as reaper_db and data_endpoint_auth (Stargate).
 	// +optional
 	ExternalDatacenters []string `json:"externalDatacenters,omitempty"`
+
+	// CredentialsRotation schedules the rotation of the credentials generated by the operator for the superuser,
+	// Reaper and Medusa. A rotation can also be requested at any time with the RotateCredentialsAnnotation.
+	// +optional
+	CredentialsRotation *CredentialsRotationSpec `json:"credentialsRotation,omitempty"`
+}
+
+// CredentialsRotationSpec defines how often the credentials generated by the operator are rotated.
+type CredentialsRotationSpec struct {
+	// Interval is the time between the completion of a rotation and the start of the next one, e.g. "720h". The
+	// first rotation is due Interval after the creation of the K8ssandraCluster.
+	Interval metav1.Duration `json:"interval"`
 }
 
 func (in K8ssandraClusterSpec) IsAuthEnabled() bool {
@@ -75,16 +91,49 @@ func (in K8ssandraClusterSpec) IsAuthEnabled() bool {
 
 // K8ssandraClusterStatus defines the observed state of K8ssandraCluster
 type K8ssandraClusterStatus struct {
+	// ObservedGeneration is the most recent generation of the K8ssandraCluster spec that was fully reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// +optional
 	Conditions []K8ssandraClusterCondition `json:"conditions,omitempty"`
 
-	// Datacenters maps the CassandraDatacenter name to a K8ssandraStatus. The
-	// naming is a bit confusing but the mapping makes sense because we have a
-	// CassandraDatacenter and then define other components like Stargate and Reaper
-	// relative to it. I wanted to inline the field but when I do it won't serialize.
-	//
-	// TODO Figure out how to inline this field
+	// Datacenters maps the CassandraDatacenter name to the status of the datacenter and of the Stargate and Reaper
+	// objects deployed alongside it.
 	Datacenters map[string]K8ssandraStatus `json:"datacenters,omitempty"`
+
+	// Rebuild is set while a datacenter added to the existing cluster is being rebuilt, i.e. while it streams the
+	// data of the other datacenters.
+	// +optional
+	Rebuild *DatacenterRebuildStatus `json:"rebuild,omitempty"`
+
+	// Plan lists the changes that reconciling the K8ssandraCluster would make. It is only computed when the
+	// DryRunAnnotation is set, in which case nothing is applied.
+	// +optional
+	Plan *K8ssandraClusterPlan `json:"plan,omitempty"`
+
+	// CredentialsRotation reports the progress of the last rotation of the credentials generated by the operator.
+	// +optional
+	CredentialsRotation *CredentialsRotationStatus `json:"credentialsRotation,omitempty"`
+
+	// RollingRestart reports the progress of the last rolling restart of the cluster.
+	// +optional
+	RollingRestart *RollingRestartStatus `json:"rollingRestart,omitempty"`
+
+	// Migration reports the progress of the last move of a datacenter to another Kubernetes cluster. A move starts
+	// when the k8sContext of an existing datacenter is changed.
+	// +optional
+	Migration *DatacenterMigrationStatus `json:"migration,omitempty"`
+
+	// NextClusterBackupExpiry is the time at which the next CassandraClusterBackup of the cluster exceeds the maximum
+	// age of the backup retention policy.
+	// +optional
+	NextClusterBackupExpiry *metav1.Time `json:"nextClusterBackupExpiry,omitempty"`
+
+	// NodeReplacement reports the progress of the last replacement of a dead Cassandra node, requested with the
+	// ReplaceNodeAnnotation.
+	// +optional
+	NodeReplacement *NodeReplacementStatus `json:"nodeReplacement,omitempty"`
 }
 
 type K8ssandraClusterConditionType string
@@ -98,11 +147,62 @@ const (
 	// does not change.
 	CassandraInitialized = "CassandraInitialized"
 
+	// ClusterReady is true when the latest spec has been fully applied and all the datacenters, along with their
+	// Stargate and Reaper deployments, are ready.
+	ClusterReady K8ssandraClusterConditionType = "Ready"
+
+	// ClusterProgressing is true while changes are being rolled out, e.g. when datacenters are being created,
+	// updated, rebuilt, upgraded or decommissioned.
+	ClusterProgressing K8ssandraClusterConditionType = "Progressing"
+
+	// ClusterDegraded is true when a datacenter that was ready is no longer ready without any pending change, when
+	// an upgrade failed, when the scale-down of a datacenter is blocked by the replication of its keyspaces, or when
+	// the last reconciliation failed.
+	ClusterDegraded K8ssandraClusterConditionType = "Degraded"
+
+	// ClusterReplicationReady is true when the replication of the system keyspaces, and of the Stargate and Reaper
+	// keyspaces, matches the datacenters of the cluster.
+	ClusterReplicationReady K8ssandraClusterConditionType = "ReplicationReady"
+
+	// ClusterSchemaAgreement is false when nodes were found to disagree on the schema version the last time it was
+	// checked.
+	ClusterSchemaAgreement K8ssandraClusterConditionType = "SchemaAgreement"
+
+	// ClusterRemoteClusterReachable is false when the Kubernetes cluster of one of the datacenters could not be
+	// reached.
+	ClusterRemoteClusterReachable K8ssandraClusterConditionType = "RemoteClusterReachable"
+
+	// ClusterPaused is true when reconciliation was paused with the PausedAnnotation. While paused, only the status
+	// of the K8ssandraCluster is updated.
+	ClusterPaused K8ssandraClusterConditionType = "Paused"
+
 	DecommNone                DecommissionProgress = ""
 	DecommUpdatingReplication DecommissionProgress = "UpdatingReplication"
 	DecommDeleting            DecommissionProgress = "Decommissioning"
 )
 
+type UpgradeProgress string
+
+const (
+	// UpgradePending means that a new Cassandra version was requested for the datacenter, but the upgrade cannot
+	// start yet, for example because another datacenter is being upgraded.
+	UpgradePending UpgradeProgress = "Pending"
+
+	// UpgradeUpgradingNodes means that the new version was pushed to the CassandraDatacenter and that its pods are
+	// being restarted.
+	UpgradeUpgradingNodes UpgradeProgress = "UpgradingNodes"
+
+	// UpgradeUpgradingSSTables means that all the nodes run the new version and that their SSTables are being
+	// rewritten in the new format.
+	UpgradeUpgradingSSTables UpgradeProgress = "UpgradingSSTables"
+
+	UpgradeCompleted UpgradeProgress = "Completed"
+
+	// UpgradeFailed means that the upgrade was refused or that one of its steps failed. Version changes are paused
+	// in all datacenters until the target version is changed or the ResumeUpgradeAnnotation is set.
+	UpgradeFailed UpgradeProgress = "Failed"
+)
+
 type K8ssandraClusterCondition struct {
 	Type   K8ssandraClusterConditionType `json:"type"`
 	Status corev1.ConditionStatus        `json:"status"`
@@ -110,11 +210,425 @@ type K8ssandraClusterCondition struct {
 	// LastTransitionTime is the last time the condition transited from one status to another.
 	// +optional
 	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief CamelCase reason for the condition's last update.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable explanation of the current status of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DatacenterUpgradeStatus describes the progress of the Cassandra version upgrade of a datacenter.
+type DatacenterUpgradeStatus struct {
+	FromVersion string `json:"fromVersion"`
+
+	ToVersion string `json:"toVersion"`
+
+	Progress UpgradeProgress `json:"progress"`
+
+	// StartTime is the time at which the new version was pushed to the CassandraDatacenter.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// SSTablesStartTime is the time at which the upgrade of SSTables started.
+	// +optional
+	SSTablesStartTime *metav1.Time `json:"sstablesStartTime,omitempty"`
+
+	// SSTablesJobs are the ids of the management API jobs that are upgrading SSTables, by pod name.
+	// +optional
+	SSTablesJobs map[string]string `json:"sstablesJobs,omitempty"`
+
+	// SSTablesUpgradedPods lists the pods whose SSTables have been upgraded.
+	// +optional
+	SSTablesUpgradedPods []string `json:"sstablesUpgradedPods,omitempty"`
+
+	// Message explains why the upgrade is pending or failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DatacenterScaleDownStatus describes a reduction of the size of a datacenter that the operator does not apply because
+// some keyspaces have more replicas in the datacenter than it would have nodes. The datacenter keeps its current size
+// until the replication of these keyspaces is reduced or the size change is reverted.
+type DatacenterScaleDownStatus struct {
+	FromSize int32 `json:"fromSize"`
+
+	ToSize int32 `json:"toSize"`
+
+	// Keyspaces lists the keyspaces whose replication factor in the datacenter is greater than ToSize.
+	// +optional
+	Keyspaces []string `json:"keyspaces,omitempty"`
+
+	// Message explains why the size change is not applied.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// MaintenanceOperation is a disruptive operation on a datacenter that only starts within its maintenance window.
+type MaintenanceOperation string
+
+const (
+	// MaintenanceConfigChange is an update of the CassandraDatacenter that restarts its pods.
+	MaintenanceConfigChange MaintenanceOperation = "ConfigChange"
+
+	MaintenanceUpgrade MaintenanceOperation = "Upgrade"
+	MaintenanceRebuild MaintenanceOperation = "Rebuild"
+)
+
+// DatacenterMaintenanceStatus lists the operations on a datacenter that are held until its maintenance window opens.
+type DatacenterMaintenanceStatus struct {
+	// Pending are the operations waiting for the maintenance window.
+	Pending []MaintenanceOperation `json:"pending"`
+
+	// NextWindowTime is the time at which the maintenance window opens next.
+	// +optional
+	NextWindowTime *metav1.Time `json:"nextWindowTime,omitempty"`
+}
+
+type CleanupProgress string
+
+const (
+	// CleanupPending means that the datacenter was scaled up, and that the cleanup waits for the new nodes to join
+	// the datacenter and for cass-operator to start cleaning up the nodes.
+	CleanupPending CleanupProgress = "Pending"
+
+	// CleanupRunning means that cass-operator created its cleanup task.
+	CleanupRunning CleanupProgress = "Running"
+
+	CleanupCompleted CleanupProgress = "Completed"
+
+	// CleanupFailed means that the cleanup task of cass-operator failed on some nodes.
+	CleanupFailed CleanupProgress = "Failed"
+)
+
+// DatacenterCleanupStatus describes the progress of the cleanup of a datacenter after it was scaled up.
+type DatacenterCleanupStatus struct {
+	FromSize int32 `json:"fromSize"`
+
+	ToSize int32 `json:"toSize"`
+
+	Progress CleanupProgress `json:"progress"`
+
+	// ScaleUpTime is the time at which the scale-up was detected. The cleanup waits for cass-operator to finish
+	// scaling up the datacenter after that time.
+	// +optional
+	ScaleUpTime *metav1.Time `json:"scaleUpTime,omitempty"`
+
+	// Task is the name of the CassandraTask that cass-operator created to clean up the nodes of the datacenter.
+	// +optional
+	Task string `json:"task,omitempty"`
+
+	// StartTime is the time at which the cleanup task was found.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains what the cleanup waits for, or why it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DatacenterRebuildStatus identifies the datacenter being rebuilt.
+type DatacenterRebuildStatus struct {
+	Datacenter string `json:"datacenter"`
+
+	// StartTime is the time at which the datacenter was found to need a rebuild.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+type CredentialsRotationProgress string
+
+const (
+	// CredentialsRotationRotatingPasswords means that new passwords are being written to the secrets and applied to
+	// the CQL roles.
+	CredentialsRotationRotatingPasswords CredentialsRotationProgress = "RotatingPasswords"
+
+	// CredentialsRotationReplicatingSecrets means that the new passwords are applied, and that the operator waits
+	// for the ReplicatedSecret to copy the secrets to the Kubernetes clusters of all the datacenters.
+	CredentialsRotationReplicatingSecrets CredentialsRotationProgress = "ReplicatingSecrets"
+
+	// CredentialsRotationRollingComponents means that the Cassandra pods, along with their Medusa containers, and the
+	// Reaper and Stargate deployments are being restarted to pick up the new credentials.
+	CredentialsRotationRollingComponents CredentialsRotationProgress = "RollingComponents"
+
+	CredentialsRotationCompleted CredentialsRotationProgress = "Completed"
+)
+
+// CredentialsRotationStatus describes the progress of a rotation of the credentials generated by the operator.
+type CredentialsRotationStatus struct {
+	// Revision is incremented by each rotation. Once the passwords are rotated, it is set as the value of the
+	// CredentialsRevisionAnnotation on the pod templates of the components that use them, which restarts them.
+	Revision int64 `json:"revision"`
+
+	Progress CredentialsRotationProgress `json:"progress"`
+
+	// Secrets are the names of the secrets whose password is rotated. Secrets provided by the user are not rotated.
+	// +optional
+	Secrets []string `json:"secrets,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+type RollingRestartProgress string
+
+const (
+	// RollingRestartPending means that the datacenter waits for the datacenters declared before it to be restarted.
+	RollingRestartPending RollingRestartProgress = "Pending"
+
+	// RollingRestartRestarting means that the RestartRevisionAnnotation of the pod template of the datacenter was
+	// changed and that cass-operator restarts its pods, one rack at a time.
+	RollingRestartRestarting RollingRestartProgress = "Restarting"
+
+	RollingRestartCompleted RollingRestartProgress = "Completed"
+
+	// RollingRestartSkipped means that the datacenter was not restarted because it is stopped.
+	RollingRestartSkipped RollingRestartProgress = "Skipped"
+)
+
+// RollingRestartStatus describes the progress of a rolling restart of the cluster.
+type RollingRestartStatus struct {
+	// Revision is incremented by each restart.
+	Revision int64 `json:"revision"`
+
+	// Progress is Restarting or Completed.
+	Progress RollingRestartProgress `json:"progress"`
+
+	// Datacenters reports the progress of each datacenter, in the order in which they are restarted.
+	// +optional
+	Datacenters []DatacenterRestartStatus `json:"datacenters,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// DatacenterRestartStatus describes the progress of the restart of a datacenter.
+type DatacenterRestartStatus struct {
+	Name string `json:"name"`
+
+	Progress RollingRestartProgress `json:"progress"`
+
+	// Revision is the revision of the last restart of the datacenter, which is set as the value of the
+	// RestartRevisionAnnotation on its pod template. It is kept by the following restarts until they restart the
+	// datacenter.
+	// +optional
+	Revision int64 `json:"revision,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+type NodeReplacementProgress string
+
+const (
+	// NodeReplacementReplacing means that cass-operator was asked to replace the node, and that the pod is being
+	// recreated and bootstrapped.
+	NodeReplacementReplacing NodeReplacementProgress = "Replacing"
+
+	// NodeReplacementRepairing means that the node was replaced and that Reaper repairs it.
+	NodeReplacementRepairing NodeReplacementProgress = "Repairing"
+
+	NodeReplacementCompleted NodeReplacementProgress = "Completed"
+
+	// NodeReplacementFailed means that the replaced node could not be repaired, or that its datacenter was removed
+	// during the replacement.
+	NodeReplacementFailed NodeReplacementProgress = "Failed"
+)
+
+// NodeReplacementStatus describes the progress of the replacement of a dead Cassandra node.
+type NodeReplacementStatus struct {
+	// Pod is the name of the Cassandra pod whose node is replaced.
+	Pod string `json:"pod"`
+
+	Datacenter string `json:"datacenter"`
+
+	Progress NodeReplacementProgress `json:"progress"`
+
+	// Node is the address of the replacement node, used to repair it.
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// Keyspaces are the keyspaces replicated to the datacenter of the node, which are repaired once it is replaced.
+	// +optional
+	Keyspaces []string `json:"keyspaces,omitempty"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains why the replacement failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type DatacenterMigrationProgress string
+
+const (
+	// MigrationCreatingTemporaryDatacenter means that a temporary datacenter is being created in the target
+	// Kubernetes cluster.
+	MigrationCreatingTemporaryDatacenter DatacenterMigrationProgress = "CreatingTemporaryDatacenter"
+
+	// MigrationRebuildingTemporaryDatacenter means that the keyspaces are being replicated to the temporary datacenter
+	// and that it streams the data of the datacenter being moved.
+	MigrationRebuildingTemporaryDatacenter DatacenterMigrationProgress = "RebuildingTemporaryDatacenter"
+
+	// MigrationDecommissioningSource means that the replicas of the keyspaces are being removed from the datacenter in
+	// the source Kubernetes cluster, which is then decommissioned.
+	MigrationDecommissioningSource DatacenterMigrationProgress = "DecommissioningSource"
+
+	// MigrationCreatingDatacenter means that the datacenter is being created in the target Kubernetes cluster.
+	MigrationCreatingDatacenter DatacenterMigrationProgress = "CreatingDatacenter"
+
+	// MigrationRebuildingDatacenter means that the datacenter streams the data of the temporary datacenter.
+	MigrationRebuildingDatacenter DatacenterMigrationProgress = "RebuildingDatacenter"
+
+	// MigrationDecommissioningTemporaryDatacenter means that the temporary datacenter is being decommissioned.
+	MigrationDecommissioningTemporaryDatacenter DatacenterMigrationProgress = "DecommissioningTemporaryDatacenter"
+
+	MigrationCompleted DatacenterMigrationProgress = "Completed"
+)
+
+// DatacenterMigrationStatus describes the progress of the move of a datacenter to another Kubernetes cluster. Since
+// two CassandraDatacenters cannot share the same name, the data is first streamed to a temporary datacenter in the
+// target Kubernetes cluster, and from there to the datacenter once it is recreated in the target Kubernetes cluster.
+type DatacenterMigrationStatus struct {
+	Datacenter string `json:"datacenter"`
+
+	// SourceContext is the Kubernetes context the datacenter is moved from, empty for the local cluster.
+	// +optional
+	SourceContext string `json:"sourceContext,omitempty"`
+
+	// TargetContext is the Kubernetes context the datacenter is moved to, empty for the local cluster.
+	// +optional
+	TargetContext string `json:"targetContext,omitempty"`
+
+	// TemporaryDatacenter is the name of the CassandraDatacenter that holds the replicas of the datacenter while it is
+	// moved.
+	TemporaryDatacenter string `json:"temporaryDatacenter"`
+
+	Progress DatacenterMigrationProgress `json:"progress"`
+
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message explains what the move is waiting for, e.g. the deletion of a failed rebuild task.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type DatacenterReconcileResult string
+
+const (
+	// DatacenterReconciled means that the current generation of the K8ssandraCluster is applied to the datacenter
+	// and that the datacenter is ready.
+	DatacenterReconciled DatacenterReconcileResult = "Reconciled"
+
+	// DatacenterReconciling means that changes are still being rolled out to the datacenter, or that the operator
+	// waits for the datacenter to become ready.
+	DatacenterReconciling DatacenterReconcileResult = "Reconciling"
+
+	// DatacenterReconcileFailed means that the last reconciliation of the datacenter failed. It is retried.
+	DatacenterReconcileFailed DatacenterReconcileResult = "Failed"
+)
+
+// DatacenterReconciliationStatus is the outcome of the last reconciliation of a datacenter. It is reported when
+// datacenters are reconciled in parallel.
+type DatacenterReconciliationStatus struct {
+	Result DatacenterReconcileResult `json:"result"`
+
+	// Message explains why the datacenter is not reconciled.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ObservedGeneration is the most recent generation of the K8ssandraCluster that was fully applied to the
+	// datacenter.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastReconcileTime is the time at which the datacenter was last reconciled.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+}
+
+type PlannedAction string
+
+const (
+	PlannedActionCreate PlannedAction = "Create"
+	PlannedActionUpdate PlannedAction = "Update"
+	PlannedActionDelete PlannedAction = "Delete"
+)
+
+// K8ssandraClusterPlan describes the changes that reconciling a K8ssandraCluster would make to the objects it
+// manages.
+type K8ssandraClusterPlan struct {
+	// ObservedGeneration is the generation of the K8ssandraCluster the plan was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ComputedTime is the time at which the plan was computed.
+	// +optional
+	ComputedTime *metav1.Time `json:"computedTime,omitempty"`
+
+	// RequiresRollingRestart is true if applying the plan restarts the Cassandra pods of at least one datacenter.
+	RequiresRollingRestart bool `json:"requiresRollingRestart"`
+
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+}
+
+// PlannedChange is a change that would be made to a CassandraDatacenter, Stargate or Reaper object.
+type PlannedChange struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+
+	// K8sContext is the Kubernetes context of the object, empty for the local cluster or when unknown.
+	// +optional
+	K8sContext string `json:"k8sContext,omitempty"`
+
+	Action PlannedAction `json:"action"`
+
+	// RequiresRollingRestart is true if applying the change restarts the pods of the object.
+	// +optional
+	RequiresRollingRestart bool `json:"requiresRollingRestart,omitempty"`
+
+	// Diff lists the spec fields that would change, formatted as "path: actual -> desired". Fields that the
+	// operator does not set, such as defaults, are not listed.
+	// +optional
+	Diff []string `json:"diff,omitempty"`
 }
 
 // K8ssandraStatus defines the observed of a k8ssandra instance
 type K8ssandraStatus struct {
 	DecommissionProgress DecommissionProgress                 `json:"decommissionProgress,omitempty"`
+	Upgrade              *DatacenterUpgradeStatus             `json:"upgrade,omitempty"`
+	ScaleDownBlocked     *DatacenterScaleDownStatus           `json:"scaleDownBlocked,omitempty"`
+	Cleanup              *DatacenterCleanupStatus             `json:"cleanup,omitempty"`
+	Maintenance          *DatacenterMaintenanceStatus         `json:"maintenance,omitempty"`
+	NextBackupExpiry     *metav1.Time                         `json:"nextBackupExpiry,omitempty"`
+	Reconciliation       *DatacenterReconciliationStatus      `json:"reconciliation,omitempty"`
 	Cassandra            *cassdcapi.CassandraDatacenterStatus `json:"cassandra,omitempty"`
 	Stargate             *stargateapi.StargateStatus          `json:"stargate,omitempty"`
 	Reaper               *reaperapi.ReaperStatus              `json:"reaper,omitempty"`
@@ -123,6 +637,9 @@ type K8ssandraStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=k8ssandraclusters,shortName=k8c;k8cs
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // K8ssandraCluster is the Schema for the k8ssandraclusters API. The K8ssandraCluster CRD name is also the name of the
 // Cassandra cluster (which corresponds to cluster_name in cassandra.yaml).
@@ -167,6 +684,77 @@ func (in *K8ssandraCluster) HasStoppedDatacenters() bool {
 	return false
 }
 
+// IsRebuilding returns true if the given datacenter is being rebuilt. The RebuildDcAnnotation set by previous
+// versions of the operator is still honored.
+func (in *K8ssandraCluster) IsRebuilding(dcName string) bool {
+	if in.Status.Rebuild != nil && in.Status.Rebuild.Datacenter == dcName {
+		return true
+	}
+	value, found := in.Annotations[RebuildDcAnnotation]
+	return found && value == dcName
+}
+
+// GetRebuildSource returns the name of the datacenter to stream from when rebuilding the given datacenter, from the
+// DcRebuildSourceAnnotation or else from the RebuildSourceDcAnnotation. It returns false if neither is set.
+func (in *K8ssandraCluster) GetRebuildSource(dcName string) (string, bool, error) {
+	if value, found := in.Annotations[DcRebuildSourceAnnotation]; found {
+		sources := make(map[string]string)
+		if err := json.Unmarshal([]byte(value), &sources); err != nil {
+			return "", false, fmt.Errorf("invalid %s annotation: %v", DcRebuildSourceAnnotation, err)
+		}
+		if source, found := sources[dcName]; found {
+			return source, true, nil
+		}
+	}
+	source, found := in.Annotations[RebuildSourceDcAnnotation]
+	return source, found, nil
+}
+
+// IsMigrating returns true if the given datacenter is being moved to another Kubernetes cluster.
+func (in *K8ssandraCluster) IsMigrating(dcName string) bool {
+	migration := in.Status.Migration
+	return migration != nil && migration.Datacenter == dcName && migration.Progress != MigrationCompleted
+}
+
+// IsTemporaryDatacenter returns true if the given datacenter is the temporary datacenter of a move in progress. It is
+// not part of the spec, but must not be decommissioned like the datacenters removed from it.
+func (in *K8ssandraCluster) IsTemporaryDatacenter(dcName string) bool {
+	migration := in.Status.Migration
+	return migration != nil && migration.TemporaryDatacenter == dcName && migration.Progress != MigrationCompleted
+}
+
+// CredentialsRevision returns the revision of the last credentials rotation whose passwords were applied and
+// replicated, to be set as the value of the CredentialsRevisionAnnotation on the pod templates of the components. An
+// empty string is returned if the credentials were never rotated.
+func (in *K8ssandraCluster) CredentialsRevision() string {
+	rotation := in.Status.CredentialsRotation
+	if rotation == nil {
+		return ""
+	}
+	revision := rotation.Revision
+	switch rotation.Progress {
+	case CredentialsRotationRotatingPasswords, CredentialsRotationReplicatingSecrets:
+		revision--
+	}
+	if revision <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(revision, 10)
+}
+
+// RestartRevision returns the revision of the last rolling restart of the given datacenter, to be set as the value of
+// the RestartRevisionAnnotation on its pod template. An empty string is returned if the datacenter was never restarted.
+func (in *K8ssandraCluster) RestartRevision(dcName string) string {
+	if restart := in.Status.RollingRestart; restart != nil {
+		for _, dcStatus := range restart.Datacenters {
+			if dcStatus.Name == dcName && dcStatus.Revision > 0 {
+				return strconv.FormatInt(dcStatus.Revision, 10)
+			}
+		}
+	}
+	return ""
+}
+
 func (in *K8ssandraCluster) GetInitializedDatacenters() []CassandraDatacenterTemplate {
 	datacenters := make([]CassandraDatacenterTemplate, 0)
 	if in != nil && in.Spec.Cassandra != nil {
@@ -240,6 +828,22 @@ type CassandraClusterTemplate struct {
 	// +optional
 	Datacenters []CassandraDatacenterTemplate `json:"datacenters,omitempty"`
 
+	// ParallelDatacenterReconciliation makes the operator apply changes to the existing datacenters concurrently
+	// instead of one after the other, so that a datacenter that is slow to become ready, or whose Kubernetes cluster
+	// is unreachable, does not hold back the others. New datacenters are still created one at a time in the order in
+	// which they are listed, and replication changes, rebuilds and version upgrades are still performed one
+	// datacenter at a time. The outcome for each datacenter is reported in its status. The default is false.
+	// +optional
+	ParallelDatacenterReconciliation bool `json:"parallelDatacenterReconciliation,omitempty"`
+
+	// MaintenanceWindow restricts the disruptive operations on the datacenters to a recurring time window: the
+	// configuration changes that restart the Cassandra pods, version upgrades and rebuilds are held until
+	// the window opens, and reported in the maintenance field of the datacenter status meanwhile. Operations requested
+	// explicitly, such as rolling restarts, node replacements and credentials rotations, are not held. Each datacenter
+	// can override it. When not set, operations start right away.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
 	// Telemetry defines the desired state for telemetry resources in this K8ssandraCluster.
 	// If telemetry configurations are defined, telemetry resources will be deployed to integrate with
 	// a user-provided monitoring solution (at present, only support for Prometheus is available).
@@ -357,6 +961,14 @@ type CassandraDatacenterTemplate struct {
 	// Tolerations applied to every Cassandra pod.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// MaintenanceWindow overrides the maintenance window of the cluster for this datacenter.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// BackupRetention overrides the backup retention policy of Medusa for this datacenter.
+	// +optional
+	BackupRetention *medusaapi.BackupRetentionPolicy `json:"backupRetention,omitempty"`
 }
 
 type EmbeddedObjectMeta struct {
@@ -381,6 +993,15 @@ func (s *K8ssandraClusterStatus) GetConditionStatus(conditionType K8ssandraClust
 	return corev1.ConditionUnknown
 }
 
+func (s *K8ssandraClusterStatus) GetCondition(conditionType K8ssandraClusterConditionType) *K8ssandraClusterCondition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == conditionType {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
 func (s *K8ssandraClusterStatus) SetCondition(condition K8ssandraClusterCondition) {
 	for i, c := range s.Conditions {
 		if c.Type == condition.Type {
@@ -391,6 +1012,24 @@ func (s *K8ssandraClusterStatus) SetCondition(condition K8ssandraClusterConditio
 	s.Conditions = append(s.Conditions, condition)
 }
 
+// SetConditionStatus sets the status, reason and message of a condition. LastTransitionTime is only updated when the
+// status changes.
+func (s *K8ssandraClusterStatus) SetConditionStatus(conditionType K8ssandraClusterConditionType, status corev1.ConditionStatus, reason, message string) {
+	condition := K8ssandraClusterCondition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+	if existing := s.GetCondition(conditionType); existing != nil && existing.Status == status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	} else {
+		now := metav1.Now()
+		condition.LastTransitionTime = &now
+	}
+	s.SetCondition(condition)
+}
+
 func init() {
 	SchemeBuilder.Register(&K8ssandraCluster{}, &K8ssandraClusterList{})
 }
@@ -2,13 +2,86 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestK8ssandraCluster(t *testing.T) {
 	t.Run("HasStargates", testK8ssandraClusterHasStargates)
+	t.Run("SetConditionStatus", testK8ssandraClusterSetConditionStatus)
+	t.Run("CredentialsRevision", testK8ssandraClusterCredentialsRevision)
+	t.Run("RestartRevision", testK8ssandraClusterRestartRevision)
+	t.Run("GetRebuildSource", testK8ssandraClusterGetRebuildSource)
+}
+
+func testK8ssandraClusterGetRebuildSource(t *testing.T) {
+	kc := &K8ssandraCluster{}
+	_, found, err := kc.GetRebuildSource("dc2")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	kc.Annotations = map[string]string{RebuildSourceDcAnnotation: "dc1"}
+	source, found, err := kc.GetRebuildSource("dc2")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "dc1", source)
+
+	kc.Annotations[DcRebuildSourceAnnotation] = `{"dc3": "dc2"}`
+	source, found, err = kc.GetRebuildSource("dc3")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "dc2", source)
+	source, _, err = kc.GetRebuildSource("dc2")
+	require.NoError(t, err)
+	assert.Equal(t, "dc1", source, "datacenters missing from the JSON annotation should fall back to the other one")
+
+	kc.Annotations[DcRebuildSourceAnnotation] = "dc2"
+	_, _, err = kc.GetRebuildSource("dc3")
+	assert.Error(t, err)
+}
+
+func testK8ssandraClusterRestartRevision(t *testing.T) {
+	kc := &K8ssandraCluster{}
+	assert.Equal(t, "", kc.RestartRevision("dc1"))
+
+	kc.Status.RollingRestart = &RollingRestartStatus{
+		Revision: 2,
+		Progress: RollingRestartRestarting,
+		Datacenters: []DatacenterRestartStatus{
+			{Name: "dc1", Progress: RollingRestartRestarting, Revision: 2},
+			{Name: "dc2", Progress: RollingRestartPending, Revision: 1},
+			{Name: "dc3", Progress: RollingRestartPending},
+		},
+	}
+	assert.Equal(t, "2", kc.RestartRevision("dc1"))
+	assert.Equal(t, "1", kc.RestartRevision("dc2"), "the revision of the previous restart must be kept")
+	assert.Equal(t, "", kc.RestartRevision("dc3"))
+	assert.Equal(t, "", kc.RestartRevision("dc4"))
+}
+
+func testK8ssandraClusterCredentialsRevision(t *testing.T) {
+	kc := &K8ssandraCluster{}
+	assert.Equal(t, "", kc.CredentialsRevision())
+
+	kc.Status.CredentialsRotation = &CredentialsRotationStatus{Revision: 1, Progress: CredentialsRotationRotatingPasswords}
+	assert.Equal(t, "", kc.CredentialsRevision(), "the components must not restart before the passwords are rotated")
+
+	kc.Status.CredentialsRotation.Progress = CredentialsRotationReplicatingSecrets
+	assert.Equal(t, "", kc.CredentialsRevision())
+
+	kc.Status.CredentialsRotation.Progress = CredentialsRotationRollingComponents
+	assert.Equal(t, "1", kc.CredentialsRevision())
+
+	kc.Status.CredentialsRotation.Progress = CredentialsRotationCompleted
+	assert.Equal(t, "1", kc.CredentialsRevision())
+
+	kc.Status.CredentialsRotation = &CredentialsRotationStatus{Revision: 2, Progress: CredentialsRotationRotatingPasswords}
+	assert.Equal(t, "1", kc.CredentialsRevision())
 }
 
 func testK8ssandraClusterHasStargates(t *testing.T) {
@@ -54,3 +127,31 @@ func testK8ssandraClusterHasStargates(t *testing.T) {
 		assert.True(t, kc.HasStargates())
 	})
 }
+
+func testK8ssandraClusterSetConditionStatus(t *testing.T) {
+	status := &K8ssandraClusterStatus{}
+	assert.Equal(t, corev1.ConditionUnknown, status.GetConditionStatus(ClusterReady))
+
+	status.SetConditionStatus(ClusterReady, corev1.ConditionFalse, "Reconciling", "Reconciling generation 1")
+	condition := status.GetCondition(ClusterReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, "Reconciling", condition.Reason)
+	assert.Equal(t, "Reconciling generation 1", condition.Message)
+	require.NotNil(t, condition.LastTransitionTime)
+
+	t.Log("same status keeps the transition time")
+	transitionTime := metav1.NewTime(condition.LastTransitionTime.Add(-time.Hour))
+	condition.LastTransitionTime = &transitionTime
+	status.SetConditionStatus(ClusterReady, corev1.ConditionFalse, "WaitingForDatacenter", "Waiting for dc1")
+	condition = status.GetCondition(ClusterReady)
+	assert.Equal(t, "WaitingForDatacenter", condition.Reason)
+	assert.Equal(t, transitionTime, *condition.LastTransitionTime)
+
+	t.Log("new status updates the transition time")
+	status.SetConditionStatus(ClusterReady, corev1.ConditionTrue, "Reconciled", "")
+	condition = status.GetCondition(ClusterReady)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.True(t, condition.LastTransitionTime.After(transitionTime.Time))
+	assert.Len(t, status.Conditions, 1)
+}
@@ -18,9 +18,16 @@ package v1alpha1
 
 import (
 	"fmt"
+	"regexp"
 
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	cassimages "github.com/k8ssandra/cass-operator/pkg/images"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -28,13 +35,40 @@ import (
 )
 
 var (
-	clientCache        *clientcache.ClientCache
-	ErrNumTokens       = fmt.Errorf("num_tokens value can't be changed")
-	ErrReaperKeyspace  = fmt.Errorf("reaper keyspace can not be changed")
-	ErrNoStorageConfig = fmt.Errorf("storageConfig must be defined at cluster level or dc level")
-	ErrNoResourcesSet  = fmt.Errorf("softPodAntiAffinity requires Resources to be set")
+	clientCache          *clientcache.ClientCache
+	ErrNumTokens         = fmt.Errorf("num_tokens value can't be changed")
+	ErrReaperKeyspace    = fmt.Errorf("reaper keyspace can not be changed")
+	ErrNoStorageConfig   = fmt.Errorf("storageConfig must be defined at cluster level or dc level")
+	ErrNoResourcesSet    = fmt.Errorf("softPodAntiAffinity requires Resources to be set")
+	ErrDatacenterName    = fmt.Errorf("datacenter names must be unique in a cluster")
+	ErrExternalDcName    = fmt.Errorf("externalDatacenters can not contain the name of a datacenter managed by the cluster")
+	ErrK8sContext        = fmt.Errorf("k8sContext of a datacenter can not be changed while it is being moved")
+	ErrDatacenterRename  = fmt.Errorf("datacenters can not be renamed, add and remove datacenters in separate updates")
+	ErrRebuildingDc      = fmt.Errorf("a datacenter can not be removed while it is being rebuilt")
+	ErrMigratingDc       = fmt.Errorf("a datacenter can not be removed while it is being moved")
+	ErrRotationInterval  = fmt.Errorf("credentialsRotation.interval must be positive")
+	ErrMaintenanceWindow = fmt.Errorf("invalid maintenance window")
 )
 
+// DefaultJmxInitImage is the image of the init container that enables JMX remote authentication when
+// JmxInitContainerImage is not set.
+var DefaultJmxInitImage = images.Image{
+	Registry:   images.DefaultRegistry,
+	Repository: images.DockerOfficialRepository,
+	Name:       "busybox",
+	Tag:        "1.34.1",
+	// When changing the default version above, please also change the kubebuilder markers in
+	// apis/k8ssandra/v1alpha1/k8ssandracluster_types.go accordingly.
+}
+
+var defaultServerImagePattern = regexp.MustCompile("^" + regexp.QuoteMeta(cassimages.DefaultCassandraRepository) + `:\d+\.\d+\.\d+$`)
+
+// DefaultServerImage returns the management-api image of the given Cassandra version, e.g.
+// k8ssandra/cass-management-api:4.0.1 for 4.0.1.
+func DefaultServerImage(serverVersion string) string {
+	return fmt.Sprintf("%s:%s", cassimages.DefaultCassandraRepository, serverVersion)
+}
+
 // log is for logging in this package.
 var webhookLog = logf.Log.WithName("k8ssandracluster-webhook")
 
@@ -45,12 +79,72 @@ func (r *K8ssandraCluster) SetupWebhookWithManager(mgr ctrl.Manager, cCache *cli
 		Complete()
 }
 
+//+kubebuilder:webhook:path=/mutate-k8ssandra-io-v1alpha1-k8ssandracluster,mutating=true,failurePolicy=fail,sideEffects=None,groups=k8ssandra.io,resources=k8ssandraclusters,verbs=create;update,versions=v1alpha1,name=mk8ssandracluster.kb.io,admissionReviewVersions=v1
+
 var _ webhook.Defaulter = &K8ssandraCluster{}
 
-// Default implements webhook.Defaulter so a webhook will be registered for the type
+// Default implements webhook.Defaulter so a webhook will be registered for the type. It materializes the defaults the
+// operator would otherwise compute at reconcile time, so that the effective configuration is visible in the stored
+// object and does not change when a later operator version changes its built-in defaults.
 func (r *K8ssandraCluster) Default() {
 	webhookLog.Info("K8ssandraCluster default values", "K8ssandraCluster", r.Name)
 
+	if r.Spec.Cassandra != nil {
+		r.defaultCassandra()
+	}
+
+	if r.Spec.Stargate != nil {
+		r.Spec.Stargate.SetDefaults()
+	}
+
+	if r.Spec.Reaper != nil && r.Spec.Reaper.Keyspace == "" {
+		r.Spec.Reaper.Keyspace = reaperapi.DefaultKeyspace
+	}
+
+	if r.Spec.Medusa != nil {
+		r.Spec.Medusa.ContainerImage = r.Spec.Medusa.ContainerImage.ApplyDefaults(medusaapi.DefaultContainerImage)
+	}
+}
+
+func (r *K8ssandraCluster) defaultCassandra() {
+	cassandra := r.Spec.Cassandra
+	cassandra.JmxInitContainerImage = cassandra.JmxInitContainerImage.ApplyDefaults(DefaultJmxInitImage)
+	cassandra.Telemetry = cassandra.Telemetry.Default()
+
+	for i := range cassandra.Datacenters {
+		dc := &cassandra.Datacenters[i]
+		r.defaultServerImage(dc)
+
+		// The dc-level image and telemetry are left unset when they are not set, so that the cluster-level settings
+		// keep applying to the dc.
+		if dc.JmxInitContainerImage != nil {
+			dc.JmxInitContainerImage = dc.JmxInitContainerImage.ApplyDefaults(DefaultJmxInitImage)
+		}
+		if dc.Stargate != nil {
+			dc.Stargate.SetDefaults()
+		}
+	}
+}
+
+// defaultServerImage sets the server image of the dc to the image cass-operator would choose for its server version
+// when no server image is set at the dc or cluster level. A server image previously set this way is kept in sync
+// with the server version, so that version upgrades keep working, and is removed if a cluster-level server image is
+// set.
+func (r *K8ssandraCluster) defaultServerImage(dc *CassandraDatacenterTemplate) {
+	if dc.ServerImage != "" && !defaultServerImagePattern.MatchString(dc.ServerImage) {
+		return
+	}
+
+	serverVersion := dc.ServerVersion
+	if serverVersion == "" {
+		serverVersion = r.Spec.Cassandra.ServerVersion
+	}
+
+	if r.Spec.Cassandra.ServerImage != "" || serverVersion == "" {
+		dc.ServerImage = ""
+	} else {
+		dc.ServerImage = DefaultServerImage(serverVersion)
+	}
 }
 
 //+kubebuilder:webhook:path=/validate-k8ssandra-io-v1alpha1-k8ssandracluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=k8ssandra.io,resources=k8ssandraclusters,verbs=create;update,versions=v1alpha1,name=vk8ssandracluster.kb.io,admissionReviewVersions=v1
@@ -65,6 +159,27 @@ func (r *K8ssandraCluster) ValidateCreate() error {
 }
 
 func (r *K8ssandraCluster) validateK8ssandraCluster() error {
+	if err := r.validateDatacenterNames(); err != nil {
+		return err
+	}
+
+	if r.Spec.CredentialsRotation != nil && r.Spec.CredentialsRotation.Interval.Duration <= 0 {
+		return ErrRotationInterval
+	}
+
+	if window := r.Spec.Cassandra.MaintenanceWindow; window != nil {
+		if err := window.Validate(); err != nil {
+			return errors.Wrap(ErrMaintenanceWindow, err.Error())
+		}
+	}
+	for _, dc := range r.Spec.Cassandra.Datacenters {
+		if dc.MaintenanceWindow != nil {
+			if err := dc.MaintenanceWindow.Validate(); err != nil {
+				return errors.Wrap(ErrMaintenanceWindow, fmt.Sprintf("datacenter %s: %v", dc.Meta.Name, err))
+			}
+		}
+	}
+
 	hasClusterStorageConfig := r.Spec.Cassandra.StorageConfig != nil
 	// Verify given k8s-contexts are correct
 	for _, dc := range r.Spec.Cassandra.Datacenters {
@@ -89,7 +204,28 @@ func (r *K8ssandraCluster) validateK8ssandraCluster() error {
 	return nil
 }
 
-// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+// validateDatacenterNames verifies that each datacenter name is used only once, either by a managed datacenter or in
+// ExternalDatacenters.
+func (r *K8ssandraCluster) validateDatacenterNames() error {
+	dcNames := make(map[string]bool)
+	for _, dc := range r.Spec.Cassandra.Datacenters {
+		if dcNames[dc.Meta.Name] {
+			return errors.Wrapf(ErrDatacenterName, "datacenter %s is defined more than once", dc.Meta.Name)
+		}
+		dcNames[dc.Meta.Name] = true
+	}
+
+	for _, dcName := range r.Spec.ExternalDatacenters {
+		if dcNames[dcName] {
+			return errors.Wrapf(ErrExternalDcName, "datacenter %s", dcName)
+		}
+	}
+
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type. A change of the k8sContext
+// of a datacenter moves the datacenter, and is only rejected while the datacenter is already being moved.
 func (r *K8ssandraCluster) ValidateUpdate(old runtime.Object) error {
 	webhookLog.Info("validate K8ssandraCluster update", "K8ssandraCluster", r.Name)
 
@@ -119,7 +255,7 @@ func (r *K8ssandraCluster) ValidateUpdate(old runtime.Object) error {
 			// Changing num_tokens is not allowed
 			if newCassConfig == nil {
 				return ErrNumTokens
-			} else if newCassConfig.CassandraYaml.NumTokens != oldCassConfig.CassandraYaml.NumTokens {
+			} else if newCassConfig.CassandraYaml.NumTokens == nil || *newCassConfig.CassandraYaml.NumTokens != *oldCassConfig.CassandraYaml.NumTokens {
 				return ErrNumTokens
 			}
 		}
@@ -135,17 +271,96 @@ func (r *K8ssandraCluster) ValidateUpdate(old runtime.Object) error {
 		}
 	}
 
-	// Some of these could be extracted in the cass-operator to reusable methods, do not copy code here.
-	// Also, reusing methods from cass-operator allows to follow updates to features if they change in cass-operator,
-	// such as allowing rack modifications or expanding PVCs.
+	return r.validateDatacenterUpdates(oldCluster)
+}
+
+// validateDatacenterUpdates verifies the changes made to the datacenters of the cluster. Datacenters are matched by
+// name, and the changes to existing datacenters are validated with the same rules that cass-operator applies to
+// CassandraDatacenter updates, so that an update is not accepted here only to be rejected by cass-operator later.
+// Changing the k8sContext of a datacenter moves it to another Kubernetes cluster. A move cannot be redirected or
+// cancelled once started, so changing the k8sContext is only rejected while the datacenter is being moved.
+func (r *K8ssandraCluster) validateDatacenterUpdates(oldCluster *K8ssandraCluster) error {
+	newDcs := make(map[string]CassandraDatacenterTemplate)
+	for _, dc := range r.Spec.Cassandra.Datacenters {
+		newDcs[dc.Meta.Name] = dc
+	}
+
+	removed := make([]string, 0)
+	for _, oldDc := range oldCluster.Spec.Cassandra.Datacenters {
+		newDc, found := newDcs[oldDc.Meta.Name]
+		if !found {
+			removed = append(removed, oldDc.Meta.Name)
+			continue
+		}
+
+		if newDc.K8sContext != oldDc.K8sContext && oldCluster.IsMigrating(oldDc.Meta.Name) {
+			return errors.Wrapf(ErrK8sContext, "datacenter %s: attempted to change k8sContext from '%s' to '%s'",
+				oldDc.Meta.Name, oldDc.K8sContext, newDc.K8sContext)
+		}
+
+		oldCassDc := oldCluster.toCassandraDatacenter(&oldDc)
+		newCassDc := r.toCassandraDatacenter(&newDc)
+		if err := cassdcapi.ValidateDatacenterFieldChanges(*oldCassDc, *newCassDc); err != nil {
+			return errors.Wrapf(err, "datacenter %s", oldDc.Meta.Name)
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	// A datacenter that is both added and removed in the same update cannot be told apart from a renamed one.
+	if added := len(r.Spec.Cassandra.Datacenters) - (len(oldCluster.Spec.Cassandra.Datacenters) - len(removed)); added > 0 {
+		return errors.Wrapf(ErrDatacenterRename, "removed datacenters: %v", removed)
+	}
 
-	// TODO SoftPodAntiAffinity is not allowed to be modified
-	// TODO StorageConfig can not be modified (not Cluster or DC level) in existing datacenters
-	// TODO Racks can only be added and only at the end of the list - no other operation is allowed to racks
+	for _, dcName := range removed {
+		if oldCluster.IsRebuilding(dcName) {
+			return errors.Wrapf(ErrRebuildingDc, "datacenter %s", dcName)
+		}
+		if oldCluster.IsMigrating(dcName) {
+			return errors.Wrapf(ErrMigratingDc, "datacenter %s", dcName)
+		}
+	}
 
 	return nil
 }
 
+// toCassandraDatacenter returns a CassandraDatacenter carrying the properties of the dc template that cass-operator
+// validates on updates. Cluster-level properties are used when they are not set on the dc template, as is done when
+// the CassandraDatacenter is generated.
+func (r *K8ssandraCluster) toCassandraDatacenter(template *CassandraDatacenterTemplate) *cassdcapi.CassandraDatacenter {
+	dc := &cassdcapi.CassandraDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: template.Meta.Namespace,
+			Name:      template.Meta.Name,
+		},
+		Spec: cassdcapi.CassandraDatacenterSpec{
+			ClusterName: r.Name,
+			Size:        template.Size,
+			Racks:       template.Racks,
+		},
+	}
+
+	if len(dc.Spec.Racks) == 0 {
+		dc.Spec.Racks = r.Spec.Cassandra.Racks
+	}
+
+	if template.StorageConfig != nil {
+		dc.Spec.StorageConfig = *template.StorageConfig
+	} else if r.Spec.Cassandra.StorageConfig != nil {
+		dc.Spec.StorageConfig = *r.Spec.Cassandra.StorageConfig
+	}
+
+	if template.SoftPodAntiAffinity != nil {
+		dc.Spec.AllowMultipleNodesPerWorker = *template.SoftPodAntiAffinity
+	} else if r.Spec.Cassandra.SoftPodAntiAffinity != nil {
+		dc.Spec.AllowMultipleNodesPerWorker = *r.Spec.Cassandra.SoftPodAntiAffinity
+	}
+
+	return dc
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *K8ssandraCluster) ValidateDelete() error {
 	webhookLog.Info("validate K8ssandraCluster delete", "name", r.Name)
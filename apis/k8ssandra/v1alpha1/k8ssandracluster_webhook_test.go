@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"path/filepath"
@@ -34,6 +35,7 @@ import (
 
 	//+kubebuilder:scaffold:imports
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -42,7 +44,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
 )
 
 var cfg *rest.Config
@@ -263,3 +268,232 @@ func createMinimalClusterObj(name, namespace string) *K8ssandraCluster {
 		},
 	}
 }
+
+func TestValidateDatacenterNames(t *testing.T) {
+	cluster := createMinimalClusterObj("names-test", "names-namespace")
+	cluster.Spec.Cassandra.Datacenters[0].Meta.Name = "dc1"
+	require.NoError(t, cluster.validateDatacenterNames())
+
+	cluster.Spec.Cassandra.Datacenters = append(cluster.Spec.Cassandra.Datacenters, CassandraDatacenterTemplate{
+		Meta: EmbeddedObjectMeta{Name: "dc1"},
+		Size: 1,
+	})
+	require.ErrorIs(t, cluster.validateDatacenterNames(), ErrDatacenterName)
+
+	cluster.Spec.Cassandra.Datacenters[1].Meta.Name = "dc2"
+	cluster.Spec.ExternalDatacenters = []string{"dc3", "dc2"}
+	require.ErrorIs(t, cluster.validateDatacenterNames(), ErrExternalDcName)
+
+	cluster.Spec.ExternalDatacenters = []string{"dc3"}
+	require.NoError(t, cluster.validateDatacenterNames())
+}
+
+func TestValidateDatacenterUpdates(t *testing.T) {
+	newCluster := func() *K8ssandraCluster {
+		cluster := createMinimalClusterObj("updates-test", "updates-namespace")
+		cluster.Spec.Cassandra.Datacenters[0].Meta.Name = "dc1"
+		cluster.Spec.Cassandra.Datacenters = append(cluster.Spec.Cassandra.Datacenters, CassandraDatacenterTemplate{
+			Meta:       EmbeddedObjectMeta{Name: "dc2"},
+			K8sContext: "envtest",
+			Size:       3,
+			Racks:      []v1beta1.Rack{{Name: "rack1"}, {Name: "rack2"}, {Name: "rack3"}},
+		})
+		return cluster
+	}
+
+	softPodAntiAffinity := true
+	storageClass := "fast"
+
+	tests := []struct {
+		name   string
+		update func(cluster *K8ssandraCluster)
+		err    error
+	}{
+		{
+			name: "scale up",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.Datacenters[1].Size = 6
+			},
+		},
+		{
+			name: "move storage config to dc level",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.Datacenters[0].StorageConfig = cluster.Spec.Cassandra.StorageConfig
+				cluster.Spec.Cassandra.Datacenters[1].StorageConfig = cluster.Spec.Cassandra.StorageConfig
+				cluster.Spec.Cassandra.StorageConfig = nil
+			},
+		},
+		{
+			name: "change storage config",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.StorageConfig = &v1beta1.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &storageClass},
+				}
+			},
+			err: fmt.Errorf("datacenter dc1: CassandraDatacenter write rejected, attempted to change storageConfig"),
+		},
+		{
+			name: "change soft pod anti-affinity",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.SoftPodAntiAffinity = &softPodAntiAffinity
+			},
+			err: fmt.Errorf("datacenter dc1: CassandraDatacenter write rejected, attempted to change allowMultipleNodesPerWorker"),
+		},
+		{
+			name: "append rack",
+			update: func(cluster *K8ssandraCluster) {
+				dc := &cluster.Spec.Cassandra.Datacenters[1]
+				dc.Racks = append(dc.Racks, v1beta1.Rack{Name: "rack4"})
+				dc.Size = 4
+			},
+		},
+		{
+			name: "remove rack",
+			update: func(cluster *K8ssandraCluster) {
+				dc := &cluster.Spec.Cassandra.Datacenters[1]
+				dc.Racks = dc.Racks[1:]
+			},
+			err: fmt.Errorf("datacenter dc2: CassandraDatacenter write rejected, attempted to remove rack"),
+		},
+		{
+			name: "insert rack",
+			update: func(cluster *K8ssandraCluster) {
+				dc := &cluster.Spec.Cassandra.Datacenters[1]
+				dc.Racks = append([]v1beta1.Rack{{Name: "rack0"}}, dc.Racks...)
+				dc.Size = 4
+			},
+			err: fmt.Errorf("datacenter dc2: CassandraDatacenter write rejected, attempted to change rack name from 'rack1' to 'rack0'"),
+		},
+		{
+			name: "change k8s context",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.Datacenters[1].K8sContext = "other"
+			},
+		},
+		{
+			name: "add dc",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.Datacenters = append(cluster.Spec.Cassandra.Datacenters, CassandraDatacenterTemplate{
+					Meta: EmbeddedObjectMeta{Name: "dc3"},
+					Size: 3,
+				})
+			},
+		},
+		{
+			name: "remove dc",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.Datacenters = cluster.Spec.Cassandra.Datacenters[:1]
+			},
+		},
+		{
+			name: "rename dc",
+			update: func(cluster *K8ssandraCluster) {
+				cluster.Spec.Cassandra.Datacenters[1].Meta.Name = "dc3"
+			},
+			err: ErrDatacenterRename,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldCluster := newCluster()
+			cluster := oldCluster.DeepCopy()
+			tc.update(cluster)
+
+			err := cluster.validateDatacenterUpdates(oldCluster)
+			switch {
+			case tc.err == nil:
+				require.NoError(t, err)
+			case errors.Is(err, tc.err):
+			default:
+				require.EqualError(t, err, tc.err.Error())
+			}
+		})
+	}
+
+	t.Run("remove rebuilding dc", func(t *testing.T) {
+		oldCluster := newCluster()
+		oldCluster.Annotations = map[string]string{RebuildDcAnnotation: "dc2"}
+		cluster := oldCluster.DeepCopy()
+		cluster.Spec.Cassandra.Datacenters = cluster.Spec.Cassandra.Datacenters[:1]
+		require.ErrorIs(t, cluster.validateDatacenterUpdates(oldCluster), ErrRebuildingDc)
+
+		oldCluster.Annotations[RebuildDcAnnotation] = "dc1"
+		require.NoError(t, cluster.validateDatacenterUpdates(oldCluster))
+	})
+
+	t.Run("update migrating dc", func(t *testing.T) {
+		oldCluster := newCluster()
+		oldCluster.Status.Migration = &DatacenterMigrationStatus{
+			Datacenter:          "dc2",
+			TargetContext:       "other",
+			TemporaryDatacenter: "dc2-migration",
+			Progress:            MigrationRebuildingTemporaryDatacenter,
+		}
+		cluster := oldCluster.DeepCopy()
+		cluster.Spec.Cassandra.Datacenters[1].K8sContext = "another"
+		require.ErrorIs(t, cluster.validateDatacenterUpdates(oldCluster), ErrK8sContext)
+
+		cluster = oldCluster.DeepCopy()
+		cluster.Spec.Cassandra.Datacenters = cluster.Spec.Cassandra.Datacenters[:1]
+		require.ErrorIs(t, cluster.validateDatacenterUpdates(oldCluster), ErrMigratingDc)
+
+		oldCluster.Status.Migration.Progress = MigrationCompleted
+		require.NoError(t, cluster.validateDatacenterUpdates(oldCluster))
+	})
+}
+
+func TestK8ssandraClusterDefault(t *testing.T) {
+	heapSize := resource.MustParse("1Gi")
+	cluster := createMinimalClusterObj("default-test", "default-namespace")
+	cluster.Spec.Cassandra.ServerVersion = "4.0.1"
+	cluster.Spec.Cassandra.Datacenters[0].Meta.Name = "dc1"
+	cluster.Spec.Cassandra.Datacenters = append(cluster.Spec.Cassandra.Datacenters,
+		CassandraDatacenterTemplate{
+			Meta:          EmbeddedObjectMeta{Name: "dc2"},
+			ServerVersion: "3.11.11",
+			Stargate: &stargateapi.StargateDatacenterTemplate{
+				StargateClusterTemplate: stargateapi.StargateClusterTemplate{
+					StargateTemplate: stargateapi.StargateTemplate{HeapSize: &heapSize},
+				},
+			},
+		},
+		CassandraDatacenterTemplate{
+			Meta:        EmbeddedObjectMeta{Name: "dc3"},
+			ServerImage: "my-registry/cassandra:4.0.1",
+		},
+	)
+	cluster.Spec.Stargate = &stargateapi.StargateClusterTemplate{}
+	cluster.Spec.Reaper = &reaperapi.ReaperClusterTemplate{}
+	cluster.Spec.Medusa = &medusaapi.MedusaClusterTemplate{
+		ContainerImage: &images.Image{Tag: "0.12.0"},
+	}
+
+	cluster.Default()
+
+	require.Equal(t, "k8ssandra/cass-management-api:4.0.1", cluster.Spec.Cassandra.Datacenters[0].ServerImage)
+	require.Equal(t, "k8ssandra/cass-management-api:3.11.11", cluster.Spec.Cassandra.Datacenters[1].ServerImage)
+	require.Equal(t, "my-registry/cassandra:4.0.1", cluster.Spec.Cassandra.Datacenters[2].ServerImage)
+	require.Equal(t, DefaultJmxInitImage.ApplyDefaults(DefaultJmxInitImage), cluster.Spec.Cassandra.JmxInitContainerImage)
+	require.Nil(t, cluster.Spec.Cassandra.Datacenters[0].JmxInitContainerImage)
+	require.False(t, cluster.Spec.Cassandra.Telemetry.Prometheus.Enabled)
+	require.Nil(t, cluster.Spec.Cassandra.Datacenters[0].Telemetry)
+	require.Equal(t, stargateapi.DefaultResources(stargateapi.DefaultHeapSize), *cluster.Spec.Stargate.Resources)
+	require.Equal(t, stargateapi.DefaultResources(heapSize), *cluster.Spec.Cassandra.Datacenters[1].Stargate.Resources)
+	require.Equal(t, reaperapi.DefaultKeyspace, cluster.Spec.Reaper.Keyspace)
+	require.Equal(t, "docker.io/k8ssandra/medusa:0.12.0", cluster.Spec.Medusa.ContainerImage.String())
+
+	// Upgrading the cluster updates the images derived from the server version only
+	cluster.Spec.Cassandra.ServerVersion = "4.0.3"
+	cluster.Default()
+	require.Equal(t, "k8ssandra/cass-management-api:4.0.3", cluster.Spec.Cassandra.Datacenters[0].ServerImage)
+	require.Equal(t, "k8ssandra/cass-management-api:3.11.11", cluster.Spec.Cassandra.Datacenters[1].ServerImage)
+	require.Equal(t, "my-registry/cassandra:4.0.1", cluster.Spec.Cassandra.Datacenters[2].ServerImage)
+
+	// A cluster-level server image replaces the derived images
+	cluster.Spec.Cassandra.ServerImage = "my-registry/cassandra:4.0.3"
+	cluster.Default()
+	require.Empty(t, cluster.Spec.Cassandra.Datacenters[0].ServerImage)
+	require.Empty(t, cluster.Spec.Cassandra.Datacenters[1].ServerImage)
+	require.Equal(t, "my-registry/cassandra:4.0.1", cluster.Spec.Cassandra.Datacenters[2].ServerImage)
+}
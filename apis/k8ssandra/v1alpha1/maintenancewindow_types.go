@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceWindow is a recurring time window in which the operator is allowed to start disruptive operations.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression with five fields (minute, hour, day of month, month and day of week) giving the
+	// times at which the window opens, e.g. "0 2 * * sat" for every Saturday at 2am.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open, e.g. "4h".
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone in which Schedule is evaluated, e.g. "Europe/Paris". The default is
+	// UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Validate checks that the schedule, the duration and the time zone of the window are valid.
+func (in *MaintenanceWindow) Validate() error {
+	if _, err := cron.Parse(in.Schedule); err != nil {
+		return err
+	}
+	if in.Duration.Duration <= 0 {
+		return fmt.Errorf("the duration of a maintenance window must be positive")
+	}
+	if _, err := time.LoadLocation(in.TimeZone); err != nil {
+		return fmt.Errorf("invalid time zone %q: %v", in.TimeZone, err)
+	}
+	return nil
+}
+
+// IsOpen returns true if now is within the window. Otherwise, the time at which the window opens next is returned
+// as well. A nil window is always open.
+func (in *MaintenanceWindow) IsOpen(now time.Time) (bool, time.Time, error) {
+	if in == nil {
+		return true, time.Time{}, nil
+	}
+	schedule, err := cron.Parse(in.Schedule)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	location, err := time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	now = now.In(location)
+
+	// The window is open if it opened at most Duration before now
+	if opening := schedule.Next(now.Add(-in.Duration.Duration)); !opening.IsZero() && !opening.After(now) {
+		return true, time.Time{}, nil
+	}
+	return false, schedule.Next(now), nil
+}
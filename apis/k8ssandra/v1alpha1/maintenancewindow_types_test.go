@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	t.Run("Validate", testMaintenanceWindowValidate)
+	t.Run("IsOpen", testMaintenanceWindowIsOpen)
+}
+
+func testMaintenanceWindowValidate(t *testing.T) {
+	window := &MaintenanceWindow{Schedule: "0 2 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Paris"}
+	assert.NoError(t, window.Validate())
+
+	invalid := window.DeepCopy()
+	invalid.Schedule = "0 2 * *"
+	assert.Error(t, invalid.Validate())
+
+	invalid = window.DeepCopy()
+	invalid.Duration = metav1.Duration{}
+	assert.Error(t, invalid.Validate())
+
+	invalid = window.DeepCopy()
+	invalid.TimeZone = "Europe/Nowhere"
+	assert.Error(t, invalid.Validate())
+}
+
+func testMaintenanceWindowIsOpen(t *testing.T) {
+	var window *MaintenanceWindow
+	open, _, err := window.IsOpen(time.Now())
+	require.NoError(t, err)
+	assert.True(t, open, "a nil window is always open")
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	// Saturdays from 2am to 6am, Paris time
+	window = &MaintenanceWindow{Schedule: "0 2 * * sat", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Paris"}
+
+	open, next, err := window.IsOpen(time.Date(2022, 3, 5, 0, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, open)
+	assert.True(t, time.Date(2022, 3, 5, 2, 0, 0, 0, paris).Equal(next), "unexpected next opening %v", next)
+
+	open, _, err = window.IsOpen(time.Date(2022, 3, 5, 1, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, open)
+
+	open, _, err = window.IsOpen(time.Date(2022, 3, 5, 4, 59, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, open)
+
+	open, next, err = window.IsOpen(time.Date(2022, 3, 5, 5, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.False(t, open)
+	assert.True(t, time.Date(2022, 3, 12, 2, 0, 0, 0, paris).Equal(next), "unexpected next opening %v", next)
+}
@@ -32,6 +32,27 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedCassandraGrant) DeepCopyInto(out *AppliedCassandraGrant) {
+	*out = *in
+	out.CassandraGrantTarget = in.CassandraGrantTarget
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]CassandraPermission, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedCassandraGrant.
+func (in *AppliedCassandraGrant) DeepCopy() *AppliedCassandraGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedCassandraGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditLogOptions) DeepCopyInto(out *AuditLogOptions) {
 	*out = *in
@@ -145,6 +166,11 @@ func (in *CassandraClusterTemplate) DeepCopyInto(out *CassandraClusterTemplate)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
 	if in.Telemetry != nil {
 		in, out := &in.Telemetry, &out.Telemetry
 		*out = new(telemetryv1alpha1.TelemetrySpec)
@@ -274,6 +300,16 @@ func (in *CassandraDatacenterTemplate) DeepCopyInto(out *CassandraDatacenterTemp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.BackupRetention != nil {
+		in, out := &in.BackupRetention, &out.BackupRetention
+		*out = new(medusav1alpha1.BackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraDatacenterTemplate.
@@ -286,6 +322,415 @@ func (in *CassandraDatacenterTemplate) DeepCopy() *CassandraDatacenterTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraGrant) DeepCopyInto(out *CassandraGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraGrant.
+func (in *CassandraGrant) DeepCopy() *CassandraGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraGrantCondition) DeepCopyInto(out *CassandraGrantCondition) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraGrantCondition.
+func (in *CassandraGrantCondition) DeepCopy() *CassandraGrantCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraGrantCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraGrantList) DeepCopyInto(out *CassandraGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CassandraGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraGrantList.
+func (in *CassandraGrantList) DeepCopy() *CassandraGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraGrantSpec) DeepCopyInto(out *CassandraGrantSpec) {
+	*out = *in
+	out.Cluster = in.Cluster
+	out.CassandraGrantTarget = in.CassandraGrantTarget
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]CassandraPermission, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraGrantSpec.
+func (in *CassandraGrantSpec) DeepCopy() *CassandraGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraGrantStatus) DeepCopyInto(out *CassandraGrantStatus) {
+	*out = *in
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(AppliedCassandraGrant)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CassandraGrantCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraGrantStatus.
+func (in *CassandraGrantStatus) DeepCopy() *CassandraGrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraGrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraGrantTarget) DeepCopyInto(out *CassandraGrantTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraGrantTarget.
+func (in *CassandraGrantTarget) DeepCopy() *CassandraGrantTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraGrantTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraKeyspace) DeepCopyInto(out *CassandraKeyspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraKeyspace.
+func (in *CassandraKeyspace) DeepCopy() *CassandraKeyspace {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraKeyspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraKeyspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraKeyspaceCondition) DeepCopyInto(out *CassandraKeyspaceCondition) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraKeyspaceCondition.
+func (in *CassandraKeyspaceCondition) DeepCopy() *CassandraKeyspaceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraKeyspaceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraKeyspaceList) DeepCopyInto(out *CassandraKeyspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CassandraKeyspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraKeyspaceList.
+func (in *CassandraKeyspaceList) DeepCopy() *CassandraKeyspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraKeyspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraKeyspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraKeyspaceSpec) DeepCopyInto(out *CassandraKeyspaceSpec) {
+	*out = *in
+	out.Cluster = in.Cluster
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefaultReplicationFactor != nil {
+		in, out := &in.DefaultReplicationFactor, &out.DefaultReplicationFactor
+		*out = new(int)
+		**out = **in
+	}
+	if in.DurableWrites != nil {
+		in, out := &in.DurableWrites, &out.DurableWrites
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraKeyspaceSpec.
+func (in *CassandraKeyspaceSpec) DeepCopy() *CassandraKeyspaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraKeyspaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraKeyspaceStatus) DeepCopyInto(out *CassandraKeyspaceStatus) {
+	*out = *in
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DurableWrites != nil {
+		in, out := &in.DurableWrites, &out.DurableWrites
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CassandraKeyspaceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraKeyspaceStatus.
+func (in *CassandraKeyspaceStatus) DeepCopy() *CassandraKeyspaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraKeyspaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraRole) DeepCopyInto(out *CassandraRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraRole.
+func (in *CassandraRole) DeepCopy() *CassandraRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraRoleCondition) DeepCopyInto(out *CassandraRoleCondition) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraRoleCondition.
+func (in *CassandraRoleCondition) DeepCopy() *CassandraRoleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraRoleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraRoleList) DeepCopyInto(out *CassandraRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CassandraRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraRoleList.
+func (in *CassandraRoleList) DeepCopy() *CassandraRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraRoleSpec) DeepCopyInto(out *CassandraRoleSpec) {
+	*out = *in
+	out.Cluster = in.Cluster
+	out.SecretRef = in.SecretRef
+	if in.Login != nil {
+		in, out := &in.Login, &out.Login
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraRoleSpec.
+func (in *CassandraRoleSpec) DeepCopy() *CassandraRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraRoleStatus) DeepCopyInto(out *CassandraRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CassandraRoleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraRoleStatus.
+func (in *CassandraRoleStatus) DeepCopy() *CassandraRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 	*out = *in
@@ -374,11 +819,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(ParameterizedClass)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BatchSizeFailThreshold != nil {
+		in, out := &in.BatchSizeFailThreshold, &out.BatchSizeFailThreshold
+		*out = new(string)
+		**out = **in
+	}
 	if in.BatchSizeFailThresholdInKb != nil {
 		in, out := &in.BatchSizeFailThresholdInKb, &out.BatchSizeFailThresholdInKb
 		*out = new(int)
 		**out = **in
 	}
+	if in.BatchSizeWarnThreshold != nil {
+		in, out := &in.BatchSizeWarnThreshold, &out.BatchSizeWarnThreshold
+		*out = new(string)
+		**out = **in
+	}
 	if in.BatchSizeWarnThresholdInKb != nil {
 		in, out := &in.BatchSizeWarnThresholdInKb, &out.BatchSizeWarnThresholdInKb
 		*out = new(int)
@@ -404,6 +859,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CasContentionTimeout != nil {
+		in, out := &in.CasContentionTimeout, &out.CasContentionTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.CasContentionTimeoutInMs != nil {
 		in, out := &in.CasContentionTimeoutInMs, &out.CasContentionTimeoutInMs
 		*out = new(int)
@@ -454,6 +914,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.ColumnIndexSize != nil {
+		in, out := &in.ColumnIndexSize, &out.ColumnIndexSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.ColumnIndexSizeInKb != nil {
 		in, out := &in.ColumnIndexSizeInKb, &out.ColumnIndexSizeInKb
 		*out = new(int)
@@ -474,6 +939,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CommitlogSegmentSize != nil {
+		in, out := &in.CommitlogSegmentSize, &out.CommitlogSegmentSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.CommitlogSegmentSizeInMb != nil {
 		in, out := &in.CommitlogSegmentSizeInMb, &out.CommitlogSegmentSizeInMb
 		*out = new(int)
@@ -489,16 +959,31 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CommitlogSyncGroupWindow != nil {
+		in, out := &in.CommitlogSyncGroupWindow, &out.CommitlogSyncGroupWindow
+		*out = new(string)
+		**out = **in
+	}
 	if in.CommitlogSyncGroupWindowInMs != nil {
 		in, out := &in.CommitlogSyncGroupWindowInMs, &out.CommitlogSyncGroupWindowInMs
 		*out = new(int)
 		**out = **in
 	}
+	if in.CommitlogSyncPeriod != nil {
+		in, out := &in.CommitlogSyncPeriod, &out.CommitlogSyncPeriod
+		*out = new(string)
+		**out = **in
+	}
 	if in.CommitlogSyncPeriodInMs != nil {
 		in, out := &in.CommitlogSyncPeriodInMs, &out.CommitlogSyncPeriodInMs
 		*out = new(int)
 		**out = **in
 	}
+	if in.CommitlogTotalSpace != nil {
+		in, out := &in.CommitlogTotalSpace, &out.CommitlogTotalSpace
+		*out = new(string)
+		**out = **in
+	}
 	if in.CommitlogTotalSpaceInMb != nil {
 		in, out := &in.CommitlogTotalSpaceInMb, &out.CommitlogTotalSpaceInMb
 		*out = new(int)
@@ -509,6 +994,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CompactionThroughput != nil {
+		in, out := &in.CompactionThroughput, &out.CompactionThroughput
+		*out = new(string)
+		**out = **in
+	}
 	if in.CompactionThroughputMbPerSec != nil {
 		in, out := &in.CompactionThroughputMbPerSec, &out.CompactionThroughputMbPerSec
 		*out = new(int)
@@ -579,11 +1069,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CounterCacheSize != nil {
+		in, out := &in.CounterCacheSize, &out.CounterCacheSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.CounterCacheSizeInMb != nil {
 		in, out := &in.CounterCacheSizeInMb, &out.CounterCacheSizeInMb
 		*out = new(int)
 		**out = **in
 	}
+	if in.CounterWriteRequestTimeout != nil {
+		in, out := &in.CounterWriteRequestTimeout, &out.CounterWriteRequestTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.CounterWriteRequestTimeoutInMs != nil {
 		in, out := &in.CounterWriteRequestTimeoutInMs, &out.CounterWriteRequestTimeoutInMs
 		*out = new(int)
@@ -594,11 +1094,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CredentialsUpdateInterval != nil {
+		in, out := &in.CredentialsUpdateInterval, &out.CredentialsUpdateInterval
+		*out = new(string)
+		**out = **in
+	}
 	if in.CredentialsUpdateIntervalInMs != nil {
 		in, out := &in.CredentialsUpdateIntervalInMs, &out.CredentialsUpdateIntervalInMs
 		*out = new(int)
 		**out = **in
 	}
+	if in.CredentialsValidity != nil {
+		in, out := &in.CredentialsValidity, &out.CredentialsValidity
+		*out = new(string)
+		**out = **in
+	}
 	if in.CredentialsValidityInMs != nil {
 		in, out := &in.CredentialsValidityInMs, &out.CredentialsValidityInMs
 		*out = new(int)
@@ -664,6 +1174,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DropCompactStorageEnabled != nil {
+		in, out := &in.DropCompactStorageEnabled, &out.DropCompactStorageEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DynamicSnitch != nil {
 		in, out := &in.DynamicSnitch, &out.DynamicSnitch
 		*out = new(bool)
@@ -759,6 +1274,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FileCacheSize != nil {
+		in, out := &in.FileCacheSize, &out.FileCacheSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.FileCacheSizeInMb != nil {
 		in, out := &in.FileCacheSizeInMb, &out.FileCacheSizeInMb
 		*out = new(int)
@@ -774,11 +1294,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(FullQueryLoggerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GcLogThreshold != nil {
+		in, out := &in.GcLogThreshold, &out.GcLogThreshold
+		*out = new(string)
+		**out = **in
+	}
 	if in.GcLogThresholdInMs != nil {
 		in, out := &in.GcLogThresholdInMs, &out.GcLogThresholdInMs
 		*out = new(int)
 		**out = **in
 	}
+	if in.GcWarnThreshold != nil {
+		in, out := &in.GcWarnThreshold, &out.GcWarnThreshold
+		*out = new(string)
+		**out = **in
+	}
 	if in.GcWarnThresholdInMs != nil {
 		in, out := &in.GcWarnThresholdInMs, &out.GcWarnThresholdInMs
 		*out = new(int)
@@ -803,6 +1333,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HintedHandoffThrottle != nil {
+		in, out := &in.HintedHandoffThrottle, &out.HintedHandoffThrottle
+		*out = new(string)
+		**out = **in
+	}
 	if in.HintedHandoffThrottleInKb != nil {
 		in, out := &in.HintedHandoffThrottleInKb, &out.HintedHandoffThrottleInKb
 		*out = new(int)
@@ -813,6 +1348,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(ParameterizedClass)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HintsFlushPeriod != nil {
+		in, out := &in.HintsFlushPeriod, &out.HintsFlushPeriod
+		*out = new(string)
+		**out = **in
+	}
 	if in.HintsFlushPeriodInMs != nil {
 		in, out := &in.HintsFlushPeriodInMs, &out.HintsFlushPeriodInMs
 		*out = new(int)
@@ -953,6 +1493,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.KeyCacheSize != nil {
+		in, out := &in.KeyCacheSize, &out.KeyCacheSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.KeyCacheSizeInMb != nil {
 		in, out := &in.KeyCacheSizeInMb, &out.KeyCacheSizeInMb
 		*out = new(int)
@@ -963,11 +1508,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MaterializedViewsEnabled != nil {
+		in, out := &in.MaterializedViewsEnabled, &out.MaterializedViewsEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.MaxConcurrentAutomaticSstableUpgrades != nil {
 		in, out := &in.MaxConcurrentAutomaticSstableUpgrades, &out.MaxConcurrentAutomaticSstableUpgrades
 		*out = new(int)
 		**out = **in
 	}
+	if in.MaxHintWindow != nil {
+		in, out := &in.MaxHintWindow, &out.MaxHintWindow
+		*out = new(string)
+		**out = **in
+	}
 	if in.MaxHintWindowInMs != nil {
 		in, out := &in.MaxHintWindowInMs, &out.MaxHintWindowInMs
 		*out = new(int)
@@ -978,6 +1533,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MaxHintsFileSize != nil {
+		in, out := &in.MaxHintsFileSize, &out.MaxHintsFileSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.MaxHintsFileSizeInMb != nil {
 		in, out := &in.MaxHintsFileSizeInMb, &out.MaxHintsFileSizeInMb
 		*out = new(int)
@@ -1013,11 +1573,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MemtableHeapSpace != nil {
+		in, out := &in.MemtableHeapSpace, &out.MemtableHeapSpace
+		*out = new(string)
+		**out = **in
+	}
 	if in.MemtableHeapSpaceInMb != nil {
 		in, out := &in.MemtableHeapSpaceInMb, &out.MemtableHeapSpaceInMb
 		*out = new(int)
 		**out = **in
 	}
+	if in.MemtableOffheapSpace != nil {
+		in, out := &in.MemtableOffheapSpace, &out.MemtableOffheapSpace
+		*out = new(string)
+		**out = **in
+	}
 	if in.MemtableOffheapSpaceInMb != nil {
 		in, out := &in.MemtableOffheapSpaceInMb, &out.MemtableOffheapSpaceInMb
 		*out = new(int)
@@ -1043,6 +1613,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NativeTransportIdleTimeout != nil {
+		in, out := &in.NativeTransportIdleTimeout, &out.NativeTransportIdleTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.NativeTransportIdleTimeoutInMs != nil {
 		in, out := &in.NativeTransportIdleTimeoutInMs, &out.NativeTransportIdleTimeoutInMs
 		*out = new(int)
@@ -1068,6 +1643,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.NativeTransportMaxFrameSize != nil {
+		in, out := &in.NativeTransportMaxFrameSize, &out.NativeTransportMaxFrameSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.NativeTransportMaxFrameSizeInMb != nil {
 		in, out := &in.NativeTransportMaxFrameSizeInMb, &out.NativeTransportMaxFrameSizeInMb
 		*out = new(int)
@@ -1148,11 +1728,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.PermissionsUpdateInterval != nil {
+		in, out := &in.PermissionsUpdateInterval, &out.PermissionsUpdateInterval
+		*out = new(string)
+		**out = **in
+	}
 	if in.PermissionsUpdateIntervalInMs != nil {
 		in, out := &in.PermissionsUpdateIntervalInMs, &out.PermissionsUpdateIntervalInMs
 		*out = new(int)
 		**out = **in
 	}
+	if in.PermissionsValidity != nil {
+		in, out := &in.PermissionsValidity, &out.PermissionsValidity
+		*out = new(string)
+		**out = **in
+	}
 	if in.PermissionsValidityInMs != nil {
 		in, out := &in.PermissionsValidityInMs, &out.PermissionsValidityInMs
 		*out = new(int)
@@ -1168,6 +1758,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RangeRequestTimeout != nil {
+		in, out := &in.RangeRequestTimeout, &out.RangeRequestTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.RangeRequestTimeoutInMs != nil {
 		in, out := &in.RangeRequestTimeoutInMs, &out.RangeRequestTimeoutInMs
 		*out = new(int)
@@ -1178,6 +1773,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReadRequestTimeout != nil {
+		in, out := &in.ReadRequestTimeout, &out.ReadRequestTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.ReadRequestTimeoutInMs != nil {
 		in, out := &in.ReadRequestTimeoutInMs, &out.ReadRequestTimeoutInMs
 		*out = new(int)
@@ -1243,6 +1843,11 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(RequestSchedulerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.RequestTimeoutInMs != nil {
 		in, out := &in.RequestTimeoutInMs, &out.RequestTimeoutInMs
 		*out = new(int)
@@ -1258,11 +1863,21 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RolesUpdateInterval != nil {
+		in, out := &in.RolesUpdateInterval, &out.RolesUpdateInterval
+		*out = new(string)
+		**out = **in
+	}
 	if in.RolesUpdateIntervalInMs != nil {
 		in, out := &in.RolesUpdateIntervalInMs, &out.RolesUpdateIntervalInMs
 		*out = new(int)
 		**out = **in
 	}
+	if in.RolesValidity != nil {
+		in, out := &in.RolesValidity, &out.RolesValidity
+		*out = new(string)
+		**out = **in
+	}
 	if in.RolesValidityInMs != nil {
 		in, out := &in.RolesValidityInMs, &out.RolesValidityInMs
 		*out = new(int)
@@ -1283,16 +1898,36 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.RowCacheSize != nil {
+		in, out := &in.RowCacheSize, &out.RowCacheSize
+		*out = new(string)
+		**out = **in
+	}
 	if in.RowCacheSizeInMb != nil {
 		in, out := &in.RowCacheSizeInMb, &out.RowCacheSizeInMb
 		*out = new(int)
 		**out = **in
 	}
+	if in.SasiIndexesEnabled != nil {
+		in, out := &in.SasiIndexesEnabled, &out.SasiIndexesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScriptedUserDefinedFunctionsEnabled != nil {
+		in, out := &in.ScriptedUserDefinedFunctionsEnabled, &out.ScriptedUserDefinedFunctionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ServerEncryptionOptions != nil {
 		in, out := &in.ServerEncryptionOptions, &out.ServerEncryptionOptions
 		*out = new(encryption.ServerEncryptionOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SlowQueryLogTimeout != nil {
+		in, out := &in.SlowQueryLogTimeout, &out.SlowQueryLogTimeout
+		*out = new(string)
+		**out = **in
+	}
 	if in.SlowQueryLogTimeoutInMs != nil {
 		in, out := &in.SlowQueryLogTimeoutInMs, &out.SlowQueryLogTimeoutInMs
 		*out = new(int)
@@ -1373,99 +2008,362 @@ func (in *CassandraYaml) DeepCopyInto(out *CassandraYaml) {
 		*out = new(int)
 		**out = **in
 	}
-	if in.TombstoneWarnThreshold != nil {
-		in, out := &in.TombstoneWarnThreshold, &out.TombstoneWarnThreshold
-		*out = new(int)
-		**out = **in
+	if in.TombstoneWarnThreshold != nil {
+		in, out := &in.TombstoneWarnThreshold, &out.TombstoneWarnThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.TracetypeQueryTtl != nil {
+		in, out := &in.TracetypeQueryTtl, &out.TracetypeQueryTtl
+		*out = new(int)
+		**out = **in
+	}
+	if in.TracetypeRepairTtl != nil {
+		in, out := &in.TracetypeRepairTtl, &out.TracetypeRepairTtl
+		*out = new(int)
+		**out = **in
+	}
+	if in.TrackWarnings != nil {
+		in, out := &in.TrackWarnings, &out.TrackWarnings
+		*out = new(TrackWarnings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransientReplicationEnabled != nil {
+		in, out := &in.TransientReplicationEnabled, &out.TransientReplicationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TraverseAuthFromRoot != nil {
+		in, out := &in.TraverseAuthFromRoot, &out.TraverseAuthFromRoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrickleFsync != nil {
+		in, out := &in.TrickleFsync, &out.TrickleFsync
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrickleFsyncIntervalInKb != nil {
+		in, out := &in.TrickleFsyncIntervalInKb, &out.TrickleFsyncIntervalInKb
+		*out = new(int)
+		**out = **in
+	}
+	if in.TruncateRequestTimeout != nil {
+		in, out := &in.TruncateRequestTimeout, &out.TruncateRequestTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.TruncateRequestTimeoutInMs != nil {
+		in, out := &in.TruncateRequestTimeoutInMs, &out.TruncateRequestTimeoutInMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.UnloggedBatchAcrossPartitionsWarnThreshold != nil {
+		in, out := &in.UnloggedBatchAcrossPartitionsWarnThreshold, &out.UnloggedBatchAcrossPartitionsWarnThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.UseDeterministicTableId != nil {
+		in, out := &in.UseDeterministicTableId, &out.UseDeterministicTableId
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseOffheapMerkleTrees != nil {
+		in, out := &in.UseOffheapMerkleTrees, &out.UseOffheapMerkleTrees
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserDefinedFunctionFailTimeout != nil {
+		in, out := &in.UserDefinedFunctionFailTimeout, &out.UserDefinedFunctionFailTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserDefinedFunctionWarnTimeout != nil {
+		in, out := &in.UserDefinedFunctionWarnTimeout, &out.UserDefinedFunctionWarnTimeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserDefinedFunctionsEnabled != nil {
+		in, out := &in.UserDefinedFunctionsEnabled, &out.UserDefinedFunctionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserDefinedFunctionsThreadsEnabled != nil {
+		in, out := &in.UserDefinedFunctionsThreadsEnabled, &out.UserDefinedFunctionsThreadsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserFunctionTimeoutPolicy != nil {
+		in, out := &in.UserFunctionTimeoutPolicy, &out.UserFunctionTimeoutPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValidationPreviewPurgeHeadStartInSec != nil {
+		in, out := &in.ValidationPreviewPurgeHeadStartInSec, &out.ValidationPreviewPurgeHeadStartInSec
+		*out = new(int)
+		**out = **in
+	}
+	if in.WindowsTimerInterval != nil {
+		in, out := &in.WindowsTimerInterval, &out.WindowsTimerInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.WriteRequestTimeout != nil {
+		in, out := &in.WriteRequestTimeout, &out.WriteRequestTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.WriteRequestTimeoutInMs != nil {
+		in, out := &in.WriteRequestTimeoutInMs, &out.WriteRequestTimeoutInMs
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraYaml.
+func (in *CassandraYaml) DeepCopy() *CassandraYaml {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraYaml)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsRotationSpec) DeepCopyInto(out *CredentialsRotationSpec) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsRotationSpec.
+func (in *CredentialsRotationSpec) DeepCopy() *CredentialsRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsRotationStatus) DeepCopyInto(out *CredentialsRotationStatus) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsRotationStatus.
+func (in *CredentialsRotationStatus) DeepCopy() *CredentialsRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterCleanupStatus) DeepCopyInto(out *DatacenterCleanupStatus) {
+	*out = *in
+	if in.ScaleUpTime != nil {
+		in, out := &in.ScaleUpTime, &out.ScaleUpTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterCleanupStatus.
+func (in *DatacenterCleanupStatus) DeepCopy() *DatacenterCleanupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterCleanupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterMaintenanceStatus) DeepCopyInto(out *DatacenterMaintenanceStatus) {
+	*out = *in
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]MaintenanceOperation, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextWindowTime != nil {
+		in, out := &in.NextWindowTime, &out.NextWindowTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterMaintenanceStatus.
+func (in *DatacenterMaintenanceStatus) DeepCopy() *DatacenterMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterMigrationStatus) DeepCopyInto(out *DatacenterMigrationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.TracetypeQueryTtl != nil {
-		in, out := &in.TracetypeQueryTtl, &out.TracetypeQueryTtl
-		*out = new(int)
-		**out = **in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.TracetypeRepairTtl != nil {
-		in, out := &in.TracetypeRepairTtl, &out.TracetypeRepairTtl
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterMigrationStatus.
+func (in *DatacenterMigrationStatus) DeepCopy() *DatacenterMigrationStatus {
+	if in == nil {
+		return nil
 	}
-	if in.TrackWarnings != nil {
-		in, out := &in.TrackWarnings, &out.TrackWarnings
-		*out = new(TrackWarnings)
-		(*in).DeepCopyInto(*out)
+	out := new(DatacenterMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterRebuildStatus) DeepCopyInto(out *DatacenterRebuildStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.TraverseAuthFromRoot != nil {
-		in, out := &in.TraverseAuthFromRoot, &out.TraverseAuthFromRoot
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterRebuildStatus.
+func (in *DatacenterRebuildStatus) DeepCopy() *DatacenterRebuildStatus {
+	if in == nil {
+		return nil
 	}
-	if in.TrickleFsync != nil {
-		in, out := &in.TrickleFsync, &out.TrickleFsync
-		*out = new(bool)
-		**out = **in
+	out := new(DatacenterRebuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterReconciliationStatus) DeepCopyInto(out *DatacenterReconciliationStatus) {
+	*out = *in
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
 	}
-	if in.TrickleFsyncIntervalInKb != nil {
-		in, out := &in.TrickleFsyncIntervalInKb, &out.TrickleFsyncIntervalInKb
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterReconciliationStatus.
+func (in *DatacenterReconciliationStatus) DeepCopy() *DatacenterReconciliationStatus {
+	if in == nil {
+		return nil
 	}
-	if in.TruncateRequestTimeoutInMs != nil {
-		in, out := &in.TruncateRequestTimeoutInMs, &out.TruncateRequestTimeoutInMs
-		*out = new(int)
-		**out = **in
+	out := new(DatacenterReconciliationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterRestartStatus) DeepCopyInto(out *DatacenterRestartStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.UnloggedBatchAcrossPartitionsWarnThreshold != nil {
-		in, out := &in.UnloggedBatchAcrossPartitionsWarnThreshold, &out.UnloggedBatchAcrossPartitionsWarnThreshold
-		*out = new(int)
-		**out = **in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.UseDeterministicTableId != nil {
-		in, out := &in.UseDeterministicTableId, &out.UseDeterministicTableId
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterRestartStatus.
+func (in *DatacenterRestartStatus) DeepCopy() *DatacenterRestartStatus {
+	if in == nil {
+		return nil
 	}
-	if in.UseOffheapMerkleTrees != nil {
-		in, out := &in.UseOffheapMerkleTrees, &out.UseOffheapMerkleTrees
-		*out = new(bool)
-		**out = **in
+	out := new(DatacenterRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterScaleDownStatus) DeepCopyInto(out *DatacenterScaleDownStatus) {
+	*out = *in
+	if in.Keyspaces != nil {
+		in, out := &in.Keyspaces, &out.Keyspaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.UserDefinedFunctionFailTimeout != nil {
-		in, out := &in.UserDefinedFunctionFailTimeout, &out.UserDefinedFunctionFailTimeout
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterScaleDownStatus.
+func (in *DatacenterScaleDownStatus) DeepCopy() *DatacenterScaleDownStatus {
+	if in == nil {
+		return nil
 	}
-	if in.UserDefinedFunctionWarnTimeout != nil {
-		in, out := &in.UserDefinedFunctionWarnTimeout, &out.UserDefinedFunctionWarnTimeout
-		*out = new(int)
-		**out = **in
+	out := new(DatacenterScaleDownStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterUpgradeStatus) DeepCopyInto(out *DatacenterUpgradeStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.UserFunctionTimeoutPolicy != nil {
-		in, out := &in.UserFunctionTimeoutPolicy, &out.UserFunctionTimeoutPolicy
-		*out = new(string)
-		**out = **in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ValidationPreviewPurgeHeadStartInSec != nil {
-		in, out := &in.ValidationPreviewPurgeHeadStartInSec, &out.ValidationPreviewPurgeHeadStartInSec
-		*out = new(int)
-		**out = **in
+	if in.SSTablesStartTime != nil {
+		in, out := &in.SSTablesStartTime, &out.SSTablesStartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.WindowsTimerInterval != nil {
-		in, out := &in.WindowsTimerInterval, &out.WindowsTimerInterval
-		*out = new(int)
-		**out = **in
+	if in.SSTablesJobs != nil {
+		in, out := &in.SSTablesJobs, &out.SSTablesJobs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.WriteRequestTimeoutInMs != nil {
-		in, out := &in.WriteRequestTimeoutInMs, &out.WriteRequestTimeoutInMs
-		*out = new(int)
-		**out = **in
+	if in.SSTablesUpgradedPods != nil {
+		in, out := &in.SSTablesUpgradedPods, &out.SSTablesUpgradedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraYaml.
-func (in *CassandraYaml) DeepCopy() *CassandraYaml {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterUpgradeStatus.
+func (in *DatacenterUpgradeStatus) DeepCopy() *DatacenterUpgradeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CassandraYaml)
+	out := new(DatacenterUpgradeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1672,6 +2570,32 @@ func (in *K8ssandraClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterPlan) DeepCopyInto(out *K8ssandraClusterPlan) {
+	*out = *in
+	if in.ComputedTime != nil {
+		in, out := &in.ComputedTime, &out.ComputedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterPlan.
+func (in *K8ssandraClusterPlan) DeepCopy() *K8ssandraClusterPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8ssandraClusterSpec) DeepCopyInto(out *K8ssandraClusterSpec) {
 	*out = *in
@@ -1705,6 +2629,11 @@ func (in *K8ssandraClusterSpec) DeepCopyInto(out *K8ssandraClusterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CredentialsRotation != nil {
+		in, out := &in.CredentialsRotation, &out.CredentialsRotation
+		*out = new(CredentialsRotationSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterSpec.
@@ -1734,6 +2663,40 @@ func (in *K8ssandraClusterStatus) DeepCopyInto(out *K8ssandraClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(DatacenterRebuildStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(K8ssandraClusterPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRotation != nil {
+		in, out := &in.CredentialsRotation, &out.CredentialsRotation
+		*out = new(CredentialsRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingRestart != nil {
+		in, out := &in.RollingRestart, &out.RollingRestart
+		*out = new(RollingRestartStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(DatacenterMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextClusterBackupExpiry != nil {
+		in, out := &in.NextClusterBackupExpiry, &out.NextClusterBackupExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.NodeReplacement != nil {
+		in, out := &in.NodeReplacement, &out.NodeReplacement
+		*out = new(NodeReplacementStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterStatus.
@@ -1749,6 +2712,35 @@ func (in *K8ssandraClusterStatus) DeepCopy() *K8ssandraClusterStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8ssandraStatus) DeepCopyInto(out *K8ssandraStatus) {
 	*out = *in
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(DatacenterUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDownBlocked != nil {
+		in, out := &in.ScaleDownBlocked, &out.ScaleDownBlocked
+		*out = new(DatacenterScaleDownStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(DatacenterCleanupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(DatacenterMaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextBackupExpiry != nil {
+		in, out := &in.NextBackupExpiry, &out.NextBackupExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(DatacenterReconciliationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Cassandra != nil {
 		in, out := &in.Cassandra, &out.Cassandra
 		*out = new(v1beta1.CassandraDatacenterStatus)
@@ -1776,6 +2768,50 @@ func (in *K8ssandraStatus) DeepCopy() *K8ssandraStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReplacementStatus) DeepCopyInto(out *NodeReplacementStatus) {
+	*out = *in
+	if in.Keyspaces != nil {
+		in, out := &in.Keyspaces, &out.Keyspaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReplacementStatus.
+func (in *NodeReplacementStatus) DeepCopy() *NodeReplacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReplacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterizedClass) DeepCopyInto(out *ParameterizedClass) {
 	*out = *in
@@ -1802,6 +2838,26 @@ func (in *ParameterizedClass) DeepCopy() *ParameterizedClass {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicaFilteringProtectionOptions) DeepCopyInto(out *ReplicaFilteringProtectionOptions) {
 	*out = *in
@@ -1863,6 +2919,36 @@ func (in *RequestSchedulerOptions) DeepCopy() *RequestSchedulerOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingRestartStatus) DeepCopyInto(out *RollingRestartStatus) {
+	*out = *in
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]DatacenterRestartStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingRestartStatus.
+func (in *RollingRestartStatus) DeepCopy() *RollingRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetGroups) DeepCopyInto(out *SubnetGroups) {
 	*out = *in
@@ -0,0 +1,254 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var _ conversion.Convertible = &K8ssandraCluster{}
+
+// ConvertTo converts this K8ssandraCluster to the hub version (v1alpha1).
+func (src *K8ssandraCluster) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.K8ssandraCluster)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub %T", dstRaw)
+	}
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = v1alpha1.K8ssandraClusterSpec{
+		Auth:                src.Spec.Auth,
+		Stargate:            src.Spec.Stargate,
+		Reaper:              src.Spec.Reaper,
+		Medusa:              src.Spec.Medusa,
+		CredentialsRotation: src.Spec.CredentialsRotation,
+	}
+	if cassandra := src.Spec.Cassandra; cassandra != nil {
+		dst.Spec.Cassandra = &v1alpha1.CassandraClusterTemplate{
+			SuperuserSecretRef:               cassandra.SuperuserSecretRef,
+			ServerImage:                      cassandra.ServerImage,
+			ServerVersion:                    cassandra.ServerVersion,
+			JmxInitContainerImage:            cassandra.JmxInitContainerImage,
+			Resources:                        cassandra.Resources,
+			CassandraConfig:                  cassandra.CassandraConfig,
+			StorageConfig:                    cassandra.StorageConfig,
+			Networking:                       cassandra.Networking,
+			Racks:                            cassandra.Racks,
+			ParallelDatacenterReconciliation: cassandra.ParallelDatacenterReconciliation,
+			MaintenanceWindow:                cassandra.MaintenanceWindow,
+			Telemetry:                        cassandra.Telemetry,
+			MgmtAPIHeap:                      cassandra.MgmtAPIHeap,
+			SoftPodAntiAffinity:              cassandra.SoftPodAntiAffinity,
+			Tolerations:                      cassandra.Tolerations,
+			ServerEncryptionStores:           cassandra.ServerEncryptionStores,
+			ClientEncryptionStores:           cassandra.ClientEncryptionStores,
+		}
+		if cassandra.External != nil {
+			dst.Spec.ExternalDatacenters = cassandra.External.Datacenters
+			dst.Spec.Cassandra.AdditionalSeeds = cassandra.External.Seeds
+		}
+		if err := convertDatacentersTo(cassandra.Datacenters, dst); err != nil {
+			return err
+		}
+	}
+	dst.Status = v1alpha1.K8ssandraClusterStatus{
+		ObservedGeneration:      src.Status.ObservedGeneration,
+		Conditions:              src.Status.Conditions,
+		Rebuild:                 src.Status.Rebuild,
+		Plan:                    src.Status.Plan,
+		CredentialsRotation:     src.Status.CredentialsRotation,
+		RollingRestart:          src.Status.RollingRestart,
+		Migration:               src.Status.Migration,
+		NextClusterBackupExpiry: src.Status.NextClusterBackupExpiry,
+		NodeReplacement:         src.Status.NodeReplacement,
+	}
+	if len(src.Status.Datacenters) > 0 {
+		dst.Status.Datacenters = make(map[string]v1alpha1.K8ssandraStatus, len(src.Status.Datacenters))
+		for _, dcStatus := range src.Status.Datacenters {
+			dst.Status.Datacenters[dcStatus.Name] = dcStatus.K8ssandraStatus
+		}
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1alpha1) to this version.
+func (dst *K8ssandraCluster) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.K8ssandraCluster)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub %T", srcRaw)
+	}
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = K8ssandraClusterSpec{
+		Auth:                src.Spec.Auth,
+		Stargate:            src.Spec.Stargate,
+		Reaper:              src.Spec.Reaper,
+		Medusa:              src.Spec.Medusa,
+		CredentialsRotation: src.Spec.CredentialsRotation,
+	}
+	if cassandra := src.Spec.Cassandra; cassandra != nil {
+		dst.Spec.Cassandra = &CassandraClusterTemplate{
+			SuperuserSecretRef:               cassandra.SuperuserSecretRef,
+			ServerImage:                      cassandra.ServerImage,
+			ServerVersion:                    cassandra.ServerVersion,
+			JmxInitContainerImage:            cassandra.JmxInitContainerImage,
+			Resources:                        cassandra.Resources,
+			CassandraConfig:                  cassandra.CassandraConfig,
+			StorageConfig:                    cassandra.StorageConfig,
+			Networking:                       cassandra.Networking,
+			Racks:                            cassandra.Racks,
+			ParallelDatacenterReconciliation: cassandra.ParallelDatacenterReconciliation,
+			MaintenanceWindow:                cassandra.MaintenanceWindow,
+			Telemetry:                        cassandra.Telemetry,
+			MgmtAPIHeap:                      cassandra.MgmtAPIHeap,
+			SoftPodAntiAffinity:              cassandra.SoftPodAntiAffinity,
+			Tolerations:                      cassandra.Tolerations,
+			ServerEncryptionStores:           cassandra.ServerEncryptionStores,
+			ClientEncryptionStores:           cassandra.ClientEncryptionStores,
+		}
+		if err := convertDatacentersFrom(cassandra.Datacenters, dst); err != nil {
+			return err
+		}
+		if len(cassandra.AdditionalSeeds) > 0 || len(src.Spec.ExternalDatacenters) > 0 {
+			dst.Spec.Cassandra.External = &ExternalClusterTemplate{
+				Datacenters: src.Spec.ExternalDatacenters,
+				Seeds:       cassandra.AdditionalSeeds,
+			}
+		}
+	} else if len(src.Spec.ExternalDatacenters) > 0 {
+		dst.Spec.Cassandra = &CassandraClusterTemplate{
+			External: &ExternalClusterTemplate{Datacenters: src.Spec.ExternalDatacenters},
+		}
+	}
+	dst.Status = K8ssandraClusterStatus{
+		ObservedGeneration:      src.Status.ObservedGeneration,
+		Conditions:              src.Status.Conditions,
+		Rebuild:                 src.Status.Rebuild,
+		Plan:                    src.Status.Plan,
+		CredentialsRotation:     src.Status.CredentialsRotation,
+		RollingRestart:          src.Status.RollingRestart,
+		Migration:               src.Status.Migration,
+		NextClusterBackupExpiry: src.Status.NextClusterBackupExpiry,
+		NodeReplacement:         src.Status.NodeReplacement,
+	}
+	for dcName, dcStatus := range src.Status.Datacenters {
+		dst.Status.Datacenters = append(dst.Status.Datacenters, DatacenterStatus{Name: dcName, K8ssandraStatus: dcStatus})
+	}
+	sort.Slice(dst.Status.Datacenters, func(i, j int) bool {
+		return dst.Status.Datacenters[i].Name < dst.Status.Datacenters[j].Name
+	})
+
+	return nil
+}
+
+// convertDatacentersTo sets the datacenters of the hub cluster, and moves the keyspaceReplication and rebuildFrom
+// fields of the datacenters to the DcReplicationAnnotation and DcRebuildSourceAnnotation of the hub cluster. The
+// fields take precedence over the entries of the annotations for the same datacenter.
+func convertDatacentersTo(dcs []CassandraDatacenterTemplate, dst *v1alpha1.K8ssandraCluster) error {
+	replication := make(map[string]map[string]int)
+	if err := getJsonAnnotation(dst, v1alpha1.DcReplicationAnnotation, &replication); err != nil {
+		return err
+	}
+	rebuildSources := make(map[string]string)
+	if err := getJsonAnnotation(dst, v1alpha1.DcRebuildSourceAnnotation, &rebuildSources); err != nil {
+		return err
+	}
+
+	for _, dc := range dcs {
+		dst.Spec.Cassandra.Datacenters = append(dst.Spec.Cassandra.Datacenters, dc.CassandraDatacenterTemplate)
+		if len(dc.KeyspaceReplication) > 0 {
+			replication[dc.Meta.Name] = dc.KeyspaceReplication
+		}
+		if dc.RebuildFrom != "" {
+			rebuildSources[dc.Meta.Name] = dc.RebuildFrom
+		}
+	}
+
+	if err := setJsonAnnotation(dst, v1alpha1.DcReplicationAnnotation, replication); err != nil {
+		return err
+	}
+	return setJsonAnnotation(dst, v1alpha1.DcRebuildSourceAnnotation, rebuildSources)
+}
+
+// convertDatacentersFrom sets the datacenters of this cluster from the hub datacenters, and moves the entries of the
+// DcReplicationAnnotation and DcRebuildSourceAnnotation of this cluster to the keyspaceReplication and rebuildFrom
+// fields of their datacenter. The entries for datacenters that are not in the spec stay in the annotations.
+func convertDatacentersFrom(dcs []v1alpha1.CassandraDatacenterTemplate, dst *K8ssandraCluster) error {
+	replication := make(map[string]map[string]int)
+	if err := getJsonAnnotation(dst, v1alpha1.DcReplicationAnnotation, &replication); err != nil {
+		return err
+	}
+	rebuildSources := make(map[string]string)
+	if err := getJsonAnnotation(dst, v1alpha1.DcRebuildSourceAnnotation, &rebuildSources); err != nil {
+		return err
+	}
+
+	for _, dc := range dcs {
+		dst.Spec.Cassandra.Datacenters = append(dst.Spec.Cassandra.Datacenters, CassandraDatacenterTemplate{
+			CassandraDatacenterTemplate: dc,
+			KeyspaceReplication:         replication[dc.Meta.Name],
+			RebuildFrom:                 rebuildSources[dc.Meta.Name],
+		})
+		delete(replication, dc.Meta.Name)
+		delete(rebuildSources, dc.Meta.Name)
+	}
+
+	if err := setJsonAnnotation(dst, v1alpha1.DcReplicationAnnotation, replication); err != nil {
+		return err
+	}
+	return setJsonAnnotation(dst, v1alpha1.DcRebuildSourceAnnotation, rebuildSources)
+}
+
+// getJsonAnnotation unmarshals the value of the annotation into v. It leaves v unchanged if the annotation is not
+// set.
+func getJsonAnnotation(obj metav1.Object, annotation string, v interface{}) error {
+	value, found := obj.GetAnnotations()[annotation]
+	if !found {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return fmt.Errorf("invalid %s annotation: %v", annotation, err)
+	}
+	return nil
+}
+
+// setJsonAnnotation sets the annotation to the JSON encoding of the map m, or removes it if m is empty.
+func setJsonAnnotation(obj metav1.Object, annotation string, m interface{}) error {
+	value, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if string(value) == "{}" {
+		delete(annotations, annotation)
+		obj.SetAnnotations(annotations)
+		return nil
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[annotation] = string(value)
+	obj.SetAnnotations(annotations)
+	return nil
+}
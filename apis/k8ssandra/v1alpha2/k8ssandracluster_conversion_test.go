@@ -0,0 +1,175 @@
+package v1alpha2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestK8ssandraClusterConversion(t *testing.T) {
+	t.Run("ConvertFrom", testConvertFrom)
+	t.Run("ConvertTo", testConvertTo)
+	t.Run("RoundTrip", testConversionRoundTrip)
+}
+
+func newHubCluster() *v1alpha1.K8ssandraCluster {
+	auth := false
+	return &v1alpha1.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test",
+			Annotations: map[string]string{
+				v1alpha1.RebuildDcAnnotation:       "dc2",
+				v1alpha1.DcReplicationAnnotation:   `{"dc2":{"ks1":3,"ks2":0},"dc3":{"ks1":1}}`,
+				v1alpha1.DcRebuildSourceAnnotation: `{"dc2":"dc1"}`,
+			},
+		},
+		Spec: v1alpha1.K8ssandraClusterSpec{
+			Auth: &auth,
+			Cassandra: &v1alpha1.CassandraClusterTemplate{
+				ServerVersion:   "4.0.1",
+				AdditionalSeeds: []string{"10.0.0.1", "10.0.0.2"},
+				Datacenters: []v1alpha1.CassandraDatacenterTemplate{
+					{Meta: v1alpha1.EmbeddedObjectMeta{Name: "dc1"}, Size: 3},
+					{Meta: v1alpha1.EmbeddedObjectMeta{Name: "dc2"}, Size: 3},
+				},
+				MaintenanceWindow: &v1alpha1.MaintenanceWindow{
+					Schedule: "0 2 * * sat",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+			Reaper:              &reaperapi.ReaperClusterTemplate{},
+			ExternalDatacenters: []string{"legacy"},
+			CredentialsRotation: &v1alpha1.CredentialsRotationSpec{Interval: metav1.Duration{Duration: 720 * time.Hour}},
+		},
+		Status: v1alpha1.K8ssandraClusterStatus{
+			ObservedGeneration: 2,
+			Conditions: []v1alpha1.K8ssandraClusterCondition{
+				{Type: v1alpha1.CassandraInitialized, Status: corev1.ConditionTrue},
+			},
+			Datacenters: map[string]v1alpha1.K8ssandraStatus{
+				"dc2": {DecommissionProgress: v1alpha1.DecommDeleting},
+				"dc1": {},
+			},
+		},
+	}
+}
+
+func testConvertFrom(t *testing.T) {
+	src := newHubCluster()
+	dst := &K8ssandraCluster{}
+	require.NoError(t, dst.ConvertFrom(src))
+
+	assert.Equal(t, src.Name, dst.Name)
+	assert.Equal(t, map[string]string{
+		v1alpha1.RebuildDcAnnotation:     "dc2",
+		v1alpha1.DcReplicationAnnotation: `{"dc3":{"ks1":1}}`,
+	}, dst.Annotations, "the annotation entries of the datacenters in the spec should be moved to their fields")
+	assert.Equal(t, `{"dc2":"dc1"}`, src.Annotations[v1alpha1.DcRebuildSourceAnnotation], "the hub cluster should not be modified")
+	assert.Equal(t, src.Spec.Auth, dst.Spec.Auth)
+	assert.Equal(t, src.Spec.Reaper, dst.Spec.Reaper)
+	assert.Equal(t, src.Spec.CredentialsRotation, dst.Spec.CredentialsRotation)
+	require.NotNil(t, dst.Spec.Cassandra)
+	assert.Equal(t, "4.0.1", dst.Spec.Cassandra.ServerVersion)
+	assert.Equal(t, []CassandraDatacenterTemplate{
+		{CassandraDatacenterTemplate: src.Spec.Cassandra.Datacenters[0]},
+		{
+			CassandraDatacenterTemplate: src.Spec.Cassandra.Datacenters[1],
+			KeyspaceReplication:         map[string]int{"ks1": 3, "ks2": 0},
+			RebuildFrom:                 "dc1",
+		},
+	}, dst.Spec.Cassandra.Datacenters)
+	assert.Equal(t, src.Spec.Cassandra.MaintenanceWindow, dst.Spec.Cassandra.MaintenanceWindow)
+	assert.Equal(t, &ExternalClusterTemplate{
+		Datacenters: []string{"legacy"},
+		Seeds:       []string{"10.0.0.1", "10.0.0.2"},
+	}, dst.Spec.Cassandra.External)
+	assert.Equal(t, src.Status.ObservedGeneration, dst.Status.ObservedGeneration)
+	assert.Equal(t, src.Status.Conditions, dst.Status.Conditions)
+	assert.Equal(t, []DatacenterStatus{
+		{Name: "dc1"},
+		{Name: "dc2", K8ssandraStatus: v1alpha1.K8ssandraStatus{DecommissionProgress: v1alpha1.DecommDeleting}},
+	}, dst.Status.Datacenters)
+}
+
+func testConvertTo(t *testing.T) {
+	src := &K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "test",
+			Annotations: map[string]string{v1alpha1.DcReplicationAnnotation: `{"dc2":{"ks1":1},"dc3":{"ks1":1}}`},
+		},
+		Spec: K8ssandraClusterSpec{
+			Cassandra: &CassandraClusterTemplate{
+				ServerVersion: "3.11.11",
+				External:      &ExternalClusterTemplate{Seeds: []string{"10.0.0.1"}},
+				Datacenters: []CassandraDatacenterTemplate{
+					{CassandraDatacenterTemplate: v1alpha1.CassandraDatacenterTemplate{Meta: v1alpha1.EmbeddedObjectMeta{Name: "dc1"}, Size: 1}},
+					{
+						CassandraDatacenterTemplate: v1alpha1.CassandraDatacenterTemplate{Meta: v1alpha1.EmbeddedObjectMeta{Name: "dc2"}, Size: 1},
+						KeyspaceReplication:         map[string]int{"ks1": 3},
+						RebuildFrom:                 "dc1",
+					},
+				},
+			},
+		},
+		Status: K8ssandraClusterStatus{
+			Datacenters: []DatacenterStatus{{Name: "dc1", K8ssandraStatus: v1alpha1.K8ssandraStatus{DecommissionProgress: v1alpha1.DecommDeleting}}},
+		},
+	}
+	dst := &v1alpha1.K8ssandraCluster{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, src.Name, dst.Name)
+	assert.Equal(t, map[string]string{
+		v1alpha1.DcReplicationAnnotation:   `{"dc2":{"ks1":3},"dc3":{"ks1":1}}`,
+		v1alpha1.DcRebuildSourceAnnotation: `{"dc2":"dc1"}`,
+	}, dst.Annotations, "the datacenter fields should take precedence over the annotation entries")
+	require.NotNil(t, dst.Spec.Cassandra)
+	assert.Equal(t, "3.11.11", dst.Spec.Cassandra.ServerVersion)
+	assert.Equal(t, []string{"10.0.0.1"}, dst.Spec.Cassandra.AdditionalSeeds)
+	assert.Empty(t, dst.Spec.ExternalDatacenters)
+	assert.Equal(t, []v1alpha1.CassandraDatacenterTemplate{
+		src.Spec.Cassandra.Datacenters[0].CassandraDatacenterTemplate,
+		src.Spec.Cassandra.Datacenters[1].CassandraDatacenterTemplate,
+	}, dst.Spec.Cassandra.Datacenters)
+	assert.Equal(t, map[string]v1alpha1.K8ssandraStatus{"dc1": {DecommissionProgress: v1alpha1.DecommDeleting}}, dst.Status.Datacenters)
+}
+
+func testConversionRoundTrip(t *testing.T) {
+	t.Run("with external datacenters", func(t *testing.T) {
+		src := newHubCluster()
+		spoke := &K8ssandraCluster{}
+		require.NoError(t, spoke.ConvertFrom(src))
+		dst := &v1alpha1.K8ssandraCluster{}
+		require.NoError(t, spoke.ConvertTo(dst))
+		assert.Equal(t, src, dst)
+	})
+	t.Run("without external datacenters", func(t *testing.T) {
+		src := newHubCluster()
+		src.Spec.ExternalDatacenters = nil
+		src.Spec.Cassandra.AdditionalSeeds = nil
+		spoke := &K8ssandraCluster{}
+		require.NoError(t, spoke.ConvertFrom(src))
+		assert.Nil(t, spoke.Spec.Cassandra.External)
+		dst := &v1alpha1.K8ssandraCluster{}
+		require.NoError(t, spoke.ConvertTo(dst))
+		assert.Equal(t, src, dst)
+	})
+	t.Run("without cassandra", func(t *testing.T) {
+		src := newHubCluster()
+		src.Spec.Cassandra = nil
+		src.Spec.ExternalDatacenters = nil
+		spoke := &K8ssandraCluster{}
+		require.NoError(t, spoke.ConvertFrom(src))
+		dst := &v1alpha1.K8ssandraCluster{}
+		require.NoError(t, spoke.ConvertTo(dst))
+		assert.Equal(t, src, dst)
+	})
+}
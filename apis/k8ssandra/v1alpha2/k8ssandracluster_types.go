@@ -0,0 +1,291 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	"github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	telemetryapi "github.com/k8ssandra/k8ssandra-operator/apis/telemetry/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/encryption"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// K8ssandraClusterSpec defines the desired state of K8ssandraCluster
+type K8ssandraClusterSpec struct {
+
+	// Whether to enable authentication in this cluster. The default is true; it is highly recommended to always leave
+	// authentication turned on. When enabled, authentication will be enforced not only on Cassandra nodes, but also on
+	// Reaper, Medusa and Stargate nodes, if any.
+	// +optional
+	// +kubebuilder:default=true
+	Auth *bool `json:"auth,omitempty"`
+
+	// Cassandra is a specification of the Cassandra cluster. This includes everything from
+	// the number of datacenters, the k8s cluster where each DC should be deployed, node
+	// affinity (via racks), individual C* node settings, JVM settings, and more.
+	Cassandra *CassandraClusterTemplate `json:"cassandra,omitempty"`
+
+	// Stargate defines the desired deployment characteristics for Stargate in this K8ssandraCluster.
+	// If this is non-nil, Stargate will be deployed on every Cassandra datacenter in this K8ssandraCluster.
+	// +optional
+	Stargate *stargateapi.StargateClusterTemplate `json:"stargate,omitempty"`
+
+	// Reaper defines the desired deployment characteristics for Reaper in this K8ssandraCluster.
+	// If this is non-nil, Reaper will be deployed on every Cassandra datacenter in this K8ssandraCluster.
+	// +optional
+	Reaper *reaperapi.ReaperClusterTemplate `json:"reaper,omitempty"`
+
+	// Medusa defines the desired deployment characteristics for Medusa in this K8ssandraCluster.
+	// If this is non-nil, Medusa will be deployed in every Cassandra pod in this K8ssandraCluster.
+	// +optional
+	Medusa *medusaapi.MedusaClusterTemplate `json:"medusa,omitempty"`
+
+	// CredentialsRotation schedules the rotation of the credentials generated by the operator for the superuser,
+	// Reaper and Medusa. A rotation can also be requested at any time with the k8ssandra.io/rotate-credentials
+	// annotation.
+	// +optional
+	CredentialsRotation *v1alpha1.CredentialsRotationSpec `json:"credentialsRotation,omitempty"`
+}
+
+// K8ssandraClusterStatus defines the observed state of K8ssandraCluster
+type K8ssandraClusterStatus struct {
+	// ObservedGeneration is the most recent generation of the K8ssandraCluster spec that was fully reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	Conditions []v1alpha1.K8ssandraClusterCondition `json:"conditions,omitempty"`
+
+	// Datacenters lists the status of each CassandraDatacenter and of the Stargate and Reaper objects deployed
+	// alongside it.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Datacenters []DatacenterStatus `json:"datacenters,omitempty"`
+
+	// Rebuild is set while a datacenter added to the existing cluster is being rebuilt, i.e. while it streams the
+	// data of the other datacenters.
+	// +optional
+	Rebuild *v1alpha1.DatacenterRebuildStatus `json:"rebuild,omitempty"`
+
+	// Plan lists the changes that reconciling the K8ssandraCluster would make. It is only computed when the
+	// k8ssandra.io/dry-run annotation is set, in which case nothing is applied.
+	// +optional
+	Plan *v1alpha1.K8ssandraClusterPlan `json:"plan,omitempty"`
+
+	// CredentialsRotation reports the progress of the last rotation of the credentials generated by the operator.
+	// +optional
+	CredentialsRotation *v1alpha1.CredentialsRotationStatus `json:"credentialsRotation,omitempty"`
+
+	// RollingRestart reports the progress of the last rolling restart of the cluster.
+	// +optional
+	RollingRestart *v1alpha1.RollingRestartStatus `json:"rollingRestart,omitempty"`
+
+	// Migration reports the progress of the last move of a datacenter to another Kubernetes cluster. A move starts
+	// when the k8sContext of an existing datacenter is changed.
+	// +optional
+	Migration *v1alpha1.DatacenterMigrationStatus `json:"migration,omitempty"`
+
+	// NextClusterBackupExpiry is the time at which the next CassandraClusterBackup of the cluster exceeds the maximum
+	// age of the backup retention policy.
+	// +optional
+	NextClusterBackupExpiry *metav1.Time `json:"nextClusterBackupExpiry,omitempty"`
+
+	// NodeReplacement reports the progress of the last replacement of a dead Cassandra node, requested with the
+	// k8ssandra.io/replace-node annotation.
+	// +optional
+	NodeReplacement *v1alpha1.NodeReplacementStatus `json:"nodeReplacement,omitempty"`
+}
+
+// DatacenterStatus is the status of a CassandraDatacenter and of the Stargate and Reaper objects deployed alongside
+// it.
+type DatacenterStatus struct {
+	// Name is the name of the CassandraDatacenter.
+	Name string `json:"name"`
+
+	v1alpha1.K8ssandraStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=k8ssandraclusters,shortName=k8c;k8cs
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// K8ssandraCluster is the Schema for the k8ssandraclusters API. The K8ssandraCluster CRD name is also the name of the
+// Cassandra cluster (which corresponds to cluster_name in cassandra.yaml).
+type K8ssandraCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   K8ssandraClusterSpec   `json:"spec,omitempty"`
+	Status K8ssandraClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// K8ssandraClusterList contains a list of K8ssandraCluster
+type K8ssandraClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []K8ssandraCluster `json:"items"`
+}
+
+type CassandraClusterTemplate struct {
+
+	// The reference to the superuser secret to use for Cassandra. If unspecified, a default secret will be generated
+	// with a random password; the generated secret name will be "<cluster_name>-superuser" where <cluster_name> is the
+	// K8ssandraCluster CRD name.
+	// +optional
+	SuperuserSecretRef corev1.LocalObjectReference `json:"superuserSecretRef,omitempty"`
+
+	// ServerImage is the image for the cassandra container. Note that this should be a
+	// management-api image. If left empty the operator will choose a default image based
+	// on ServerVersion.
+	// +optional
+	ServerImage string `json:"serverImage,omitempty"`
+
+	// ServerVersion is the Cassandra version.
+	// +kubebuilder:validation:Pattern=(3\.11\.\d+)|(4\.0\.\d+)
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// The image to use in each Cassandra pod for the (short-lived) init container that enables JMX remote
+	// authentication on Cassandra pods. This is only useful when authentication is enabled in the cluster.
+	// The default is "busybox:1.34.1".
+	// +optional
+	// +kubebuilder:default={name:"busybox",tag:"1.34.1"}
+	JmxInitContainerImage *images.Image `json:"jmxInitContainerImage,omitempty"`
+
+	// Resources is the cpu and memory resources for the cassandra container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// CassandraConfig is configuration settings that are applied to cassandra.yaml and
+	// jvm-options for 3.11.x or jvm-server-options for 4.x.
+	// +optional
+	CassandraConfig *v1alpha1.CassandraConfig `json:"config,omitempty"`
+
+	// StorageConfig is the persistent storage requirements for each Cassandra pod. This
+	// includes everything under /var/lib/cassandra, namely the commit log and data
+	// directories.
+	// +optional
+	StorageConfig *cassdcapi.StorageConfig `json:"storageConfig,omitempty"`
+
+	// Networking enables host networking and configures a NodePort ports.
+	// +optional
+	Networking *cassdcapi.NetworkingConfig `json:"networking,omitempty"`
+
+	// Racks is a list of named racks. Note that racks are used to create node affinity.
+	// +optional
+	Racks []cassdcapi.Rack `json:"racks,omitempty"`
+
+	// Datacenters a list of the DCs in the cluster.
+	// +optional
+	Datacenters []CassandraDatacenterTemplate `json:"datacenters,omitempty"`
+
+	// ParallelDatacenterReconciliation makes the operator apply changes to the existing datacenters concurrently
+	// instead of one after the other, so that a datacenter that is slow to become ready, or whose Kubernetes cluster
+	// is unreachable, does not hold back the others. New datacenters are still created one at a time in the order in
+	// which they are listed, and replication changes, rebuilds and version upgrades are still performed one
+	// datacenter at a time. The outcome for each datacenter is reported in its status. The default is false.
+	// +optional
+	ParallelDatacenterReconciliation bool `json:"parallelDatacenterReconciliation,omitempty"`
+
+	// MaintenanceWindow restricts the disruptive operations on the datacenters to a recurring time window: the
+	// configuration changes that restart the Cassandra pods, version upgrades and rebuilds are held until
+	// the window opens. Each datacenter can override it.
+	// +optional
+	MaintenanceWindow *v1alpha1.MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Telemetry defines the desired state for telemetry resources in this K8ssandraCluster.
+	// If telemetry configurations are defined, telemetry resources will be deployed to integrate with
+	// a user-provided monitoring solution (at present, only support for Prometheus is available).
+	// +optional
+	Telemetry *telemetryapi.TelemetrySpec `json:"telemetry,omitempty"`
+
+	// MgmtAPIHeap defines the amount of memory devoted to the management
+	// api heap.
+	// +optional
+	MgmtAPIHeap *resource.Quantity `json:"mgmtAPIHeap,omitempty"`
+
+	// External describes the parts of the Cassandra cluster that are not managed by k8ssandra-operator, typically
+	// during a migration from an existing cluster.
+	// +optional
+	External *ExternalClusterTemplate `json:"external,omitempty"`
+
+	// SoftPodAntiAffinity sets whether multiple Cassandra instances can be scheduled on the same node.
+	// This should normally be false to ensure cluster resilience but may be set true for test/dev scenarios to minimise
+	// the number of nodes required.
+	SoftPodAntiAffinity *bool `json:"softPodAntiAffinity,omitempty"`
+
+	// Tolerations applied to every Cassandra pod.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Internode encryption stores which are used by Cassandra and Stargate.
+	// +optional
+	ServerEncryptionStores *encryption.Stores `json:"serverEncryptionStores,omitempty"`
+
+	// Client encryption stores which are used by Cassandra and Reaper.
+	// +optional
+	ClientEncryptionStores *encryption.Stores `json:"clientEncryptionStores,omitempty"`
+}
+
+// CassandraDatacenterTemplate adds to the v1alpha1 datacenter template the settings that v1alpha1 takes from
+// annotations of the K8ssandraCluster.
+type CassandraDatacenterTemplate struct {
+	v1alpha1.CassandraDatacenterTemplate `json:",inline"`
+
+	// KeyspaceReplication is the replication factor of each user keyspace in this datacenter, applied when the
+	// datacenter is added to an existing cluster, before it is rebuilt. When set, all the user keyspaces must be
+	// listed; use 0 for the keyspaces that should not be replicated to this datacenter. Keyspaces managed by
+	// CassandraKeyspace objects are ignored. In v1alpha1, this is set with the k8ssandra.io/dc-replication annotation.
+	// +optional
+	KeyspaceReplication map[string]int `json:"keyspaceReplication,omitempty"`
+
+	// RebuildFrom is the name of the datacenter to stream data from when this datacenter is added to an existing
+	// cluster. It must be a ready datacenter of the cluster. When not set, the first ready datacenter is used. In
+	// v1alpha1, this is set with the k8ssandra.io/dc-rebuild-src annotation.
+	// +optional
+	RebuildFrom string `json:"rebuildFrom,omitempty"`
+}
+
+// ExternalClusterTemplate describes the datacenters and nodes of the Cassandra cluster that live outside of the
+// K8ssandraCluster.
+type ExternalClusterTemplate struct {
+	// Datacenters lists the external datacenters. The operator includes them when it alters the replication of the
+	// system_* keyspaces, reaper_db and data_endpoint_auth (Stargate), so that their replicas are not removed.
+	// +optional
+	Datacenters []string `json:"datacenters,omitempty"`
+
+	// Seeds specifies Cassandra node IPs, or resolvable hostnames, of the external datacenters. They are added to the
+	// seeds that the operator manages for its own datacenters and must not refer to them.
+	// +optional
+	Seeds []string `json:"seeds,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&K8ssandraCluster{}, &K8ssandraClusterList{})
+}
@@ -0,0 +1,362 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	k8ssandrav1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusav1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	reaperv1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	telemetryv1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/telemetry/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/encryption"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
+	"k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraClusterTemplate) DeepCopyInto(out *CassandraClusterTemplate) {
+	*out = *in
+	out.SuperuserSecretRef = in.SuperuserSecretRef
+	if in.JmxInitContainerImage != nil {
+		in, out := &in.JmxInitContainerImage, &out.JmxInitContainerImage
+		*out = new(images.Image)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CassandraConfig != nil {
+		in, out := &in.CassandraConfig, &out.CassandraConfig
+		*out = new(k8ssandrav1alpha1.CassandraConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageConfig != nil {
+		in, out := &in.StorageConfig, &out.StorageConfig
+		*out = new(v1beta1.StorageConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(v1beta1.NetworkingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]v1beta1.Rack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]CassandraDatacenterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(k8ssandrav1alpha1.MaintenanceWindow)
+		**out = **in
+	}
+	if in.Telemetry != nil {
+		in, out := &in.Telemetry, &out.Telemetry
+		*out = new(telemetryv1alpha1.TelemetrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MgmtAPIHeap != nil {
+		in, out := &in.MgmtAPIHeap, &out.MgmtAPIHeap
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalClusterTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoftPodAntiAffinity != nil {
+		in, out := &in.SoftPodAntiAffinity, &out.SoftPodAntiAffinity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServerEncryptionStores != nil {
+		in, out := &in.ServerEncryptionStores, &out.ServerEncryptionStores
+		*out = new(encryption.Stores)
+		**out = **in
+	}
+	if in.ClientEncryptionStores != nil {
+		in, out := &in.ClientEncryptionStores, &out.ClientEncryptionStores
+		*out = new(encryption.Stores)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraClusterTemplate.
+func (in *CassandraClusterTemplate) DeepCopy() *CassandraClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraDatacenterTemplate) DeepCopyInto(out *CassandraDatacenterTemplate) {
+	*out = *in
+	in.CassandraDatacenterTemplate.DeepCopyInto(&out.CassandraDatacenterTemplate)
+	if in.KeyspaceReplication != nil {
+		in, out := &in.KeyspaceReplication, &out.KeyspaceReplication
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraDatacenterTemplate.
+func (in *CassandraDatacenterTemplate) DeepCopy() *CassandraDatacenterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraDatacenterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterStatus) DeepCopyInto(out *DatacenterStatus) {
+	*out = *in
+	in.K8ssandraStatus.DeepCopyInto(&out.K8ssandraStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterStatus.
+func (in *DatacenterStatus) DeepCopy() *DatacenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalClusterTemplate) DeepCopyInto(out *ExternalClusterTemplate) {
+	*out = *in
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalClusterTemplate.
+func (in *ExternalClusterTemplate) DeepCopy() *ExternalClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraCluster) DeepCopyInto(out *K8ssandraCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraCluster.
+func (in *K8ssandraCluster) DeepCopy() *K8ssandraCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *K8ssandraCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterList) DeepCopyInto(out *K8ssandraClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]K8ssandraCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterList.
+func (in *K8ssandraClusterList) DeepCopy() *K8ssandraClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *K8ssandraClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterSpec) DeepCopyInto(out *K8ssandraClusterSpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cassandra != nil {
+		in, out := &in.Cassandra, &out.Cassandra
+		*out = new(CassandraClusterTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stargate != nil {
+		in, out := &in.Stargate, &out.Stargate
+		*out = new(v1alpha1.StargateClusterTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reaper != nil {
+		in, out := &in.Reaper, &out.Reaper
+		*out = new(reaperv1alpha1.ReaperClusterTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Medusa != nil {
+		in, out := &in.Medusa, &out.Medusa
+		*out = new(medusav1alpha1.MedusaClusterTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRotation != nil {
+		in, out := &in.CredentialsRotation, &out.CredentialsRotation
+		*out = new(k8ssandrav1alpha1.CredentialsRotationSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterSpec.
+func (in *K8ssandraClusterSpec) DeepCopy() *K8ssandraClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterStatus) DeepCopyInto(out *K8ssandraClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]k8ssandrav1alpha1.K8ssandraClusterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make([]DatacenterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(k8ssandrav1alpha1.DatacenterRebuildStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(k8ssandrav1alpha1.K8ssandraClusterPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRotation != nil {
+		in, out := &in.CredentialsRotation, &out.CredentialsRotation
+		*out = new(k8ssandrav1alpha1.CredentialsRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingRestart != nil {
+		in, out := &in.RollingRestart, &out.RollingRestart
+		*out = new(k8ssandrav1alpha1.RollingRestartStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(k8ssandrav1alpha1.DatacenterMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextClusterBackupExpiry != nil {
+		in, out := &in.NextClusterBackupExpiry, &out.NextClusterBackupExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.NodeReplacement != nil {
+		in, out := &in.NodeReplacement, &out.NodeReplacement
+		*out = new(k8ssandrav1alpha1.NodeReplacementStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterStatus.
+func (in *K8ssandraClusterStatus) DeepCopy() *K8ssandraClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -32,6 +33,19 @@ const (
 	DifferentialBackup BackupType = "differential"
 )
 
+// BackupSyncedLabel is set on the CassandraBackups that were created from the backups found in the storage bucket of
+// Medusa, rather than by running a backup. These backups are read-only: they can be restored, and deleting them does
+// not delete the backup from the bucket.
+const BackupSyncedLabel = "medusa.k8ssandra.io/synced"
+
+// BackupSummaryStatus tells whether all the nodes of a backup were backed up.
+type BackupSummaryStatus string
+
+const (
+	BackupComplete   BackupSummaryStatus = "Complete"
+	BackupIncomplete BackupSummaryStatus = "Incomplete"
+)
+
 // CassandraBackupSpec defines the desired state of CassandraBackup
 type CassandraBackupSpec struct {
 	// The name of the backup.
@@ -45,6 +59,11 @@ type CassandraBackupSpec struct {
 	// +kubebuilder:validation:Enum=differential;full;
 	// +kubebuilder:default:=differential
 	Type BackupType `json:"backupType,omitempty"`
+
+	// Timeout is how long the backup may run on each pod. The pods that have not finished by then are marked as
+	// failed. The default is 24 hours.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 type CassandraDatacenterTemplateSpec struct {
@@ -68,6 +87,74 @@ type CassandraBackupStatus struct {
 	Finished []string `json:"finished,omitempty"`
 
 	Failed []string `json:"failed,omitempty"`
+
+	// Conditions are set once the backup has finished. The Failed condition is true if the backup failed on at least
+	// one pod.
+	// +optional
+	Conditions []CassandraBackupCondition `json:"conditions,omitempty"`
+
+	// Summary describes the backup as it is in the storage bucket. It is only set on the backups that were
+	// synchronized from the bucket.
+	// +optional
+	Summary *BackupSummary `json:"summary,omitempty"`
+}
+
+type CassandraBackupConditionType string
+
+const (
+	// BackupFailed is a terminal condition: it is set when the backup finishes, to true if it failed on any pod.
+	BackupFailed CassandraBackupConditionType = "Failed"
+)
+
+type CassandraBackupCondition struct {
+	Type   CassandraBackupConditionType `json:"type"`
+	Status corev1.ConditionStatus       `json:"status"`
+
+	// LastTransitionTime is the last time the condition transited from one status to another.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message is a human-readable explanation of the current status of the condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupSummary describes a backup as reported by Medusa.
+type BackupSummary struct {
+	// TotalNodes is the number of nodes that the backup should contain.
+	TotalNodes int32 `json:"totalNodes,omitempty"`
+
+	// FinishedNodes is the number of nodes whose backup has finished.
+	FinishedNodes int32 `json:"finishedNodes,omitempty"`
+
+	// Status is Complete when all the nodes have been backed up, Incomplete otherwise.
+	Status BackupSummaryStatus `json:"status,omitempty"`
+
+	// Nodes are the Cassandra nodes included in the backup.
+	// +optional
+	Nodes []BackupNode `json:"nodes,omitempty"`
+}
+
+// BackupNode is a Cassandra node included in a backup.
+type BackupNode struct {
+	Host string `json:"host,omitempty"`
+
+	Datacenter string `json:"datacenter,omitempty"`
+
+	Rack string `json:"rack,omitempty"`
+}
+
+// IsSynced returns true if the backup was synchronized from the storage bucket of Medusa, see BackupSyncedLabel.
+func (in *CassandraBackup) IsSynced() bool {
+	_, found := in.Labels[BackupSyncedLabel]
+	return found
+}
+
+// IsClusterBackup returns true if the backup was created for a CassandraClusterBackup, see ClusterBackupNameLabel. Its
+// data in the storage bucket is shared with the other datacenters of the cluster backup.
+func (in *CassandraBackup) IsClusterBackup() bool {
+	_, found := in.Labels[ClusterBackupNameLabel]
+	return found
 }
 
 // +kubebuilder:object:root=true
@@ -94,3 +181,42 @@ type CassandraBackupList struct {
 func init() {
 	SchemeBuilder.Register(&CassandraBackup{}, &CassandraBackupList{})
 }
+
+func (in *CassandraBackupStatus) GetConditionStatus(conditionType CassandraBackupConditionType) corev1.ConditionStatus {
+	if in != nil {
+		for _, condition := range in.Conditions {
+			if condition.Type == conditionType {
+				return condition.Status
+			}
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+func (in *CassandraBackupStatus) SetCondition(condition CassandraBackupCondition) {
+	for i, c := range in.Conditions {
+		if c.Type == condition.Type {
+			if c.Status == condition.Status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			in.Conditions[i] = condition
+			return
+		}
+	}
+	in.Conditions = append(in.Conditions, condition)
+}
+
+// SetFailed sets the terminal Failed condition of the backup.
+func (in *CassandraBackupStatus) SetFailed(failed bool, message string) {
+	now := metav1.Now()
+	status := corev1.ConditionFalse
+	if failed {
+		status = corev1.ConditionTrue
+	}
+	in.SetCondition(CassandraBackupCondition{
+		Type:               BackupFailed,
+		Status:             status,
+		LastTransitionTime: &now,
+		Message:            message,
+	})
+}
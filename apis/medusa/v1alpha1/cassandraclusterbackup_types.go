@@ -0,0 +1,173 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The labels set on the CassandraBackups created for a CassandraClusterBackup, whose values are the name and namespace
+// of the CassandraClusterBackup. The CassandraBackups can live in other namespaces and Kubernetes clusters.
+const (
+	ClusterBackupNameLabel      = "medusa.k8ssandra.io/cluster-backup-name"
+	ClusterBackupNamespaceLabel = "medusa.k8ssandra.io/cluster-backup-namespace"
+)
+
+// CassandraClusterBackupSpec defines the desired state of CassandraClusterBackup
+type CassandraClusterBackupSpec struct {
+	// Cluster is a reference to the K8ssandraCluster, in the same namespace, to back up. All of its datacenters are
+	// backed up.
+	// +kubebuilder:validation:Required
+	Cluster corev1.LocalObjectReference `json:"cluster"`
+
+	// Name is the name of the backup in the storage bucket, the same in all the datacenters. If empty, the name of
+	// this object is used.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// The type of the backup: "full" or "differential"
+	// +kubebuilder:validation:Enum=differential;full;
+	// +kubebuilder:default:=differential
+	Type BackupType `json:"backupType,omitempty"`
+
+	// Timeout is how long the backup may run on each pod, see CassandraBackupSpec.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// DatacenterBackupStatus is the progress of a CassandraClusterBackup in one datacenter.
+type DatacenterBackupStatus struct {
+	// K8sContext is the Kubernetes cluster of the datacenter.
+	// +optional
+	K8sContext string `json:"k8sContext,omitempty"`
+
+	// Namespace is the namespace of the datacenter, where its CassandraBackup is created.
+	Namespace string `json:"namespace"`
+
+	// Backup is the name of the CassandraBackup of the datacenter.
+	Backup string `json:"backup"`
+
+	// +optional
+	FinishTime metav1.Time `json:"finishTime,omitempty"`
+
+	// +optional
+	InProgress []string `json:"inProgress,omitempty"`
+
+	// +optional
+	Finished []string `json:"finished,omitempty"`
+
+	// +optional
+	Failed []string `json:"failed,omitempty"`
+}
+
+// CassandraClusterBackupStatus defines the observed state of CassandraClusterBackup
+type CassandraClusterBackupStatus struct {
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty"`
+
+	// FinishTime is set once the backup has finished in all the datacenters.
+	// +optional
+	FinishTime metav1.Time `json:"finishTime,omitempty"`
+
+	// Datacenters is the progress of the backup in each datacenter, by datacenter name. The datacenters are those
+	// of the K8ssandraCluster when the backup started.
+	// +optional
+	Datacenters map[string]DatacenterBackupStatus `json:"datacenters,omitempty"`
+
+	// Conditions are set once the backup has finished. The Failed condition is true if the backup failed on at least
+	// one pod of any datacenter.
+	// +optional
+	Conditions []CassandraBackupCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster.name`
+// +kubebuilder:printcolumn:name="Started",type="date",JSONPath=`.status.startTime`
+// +kubebuilder:printcolumn:name="Finished",type="date",JSONPath=`.status.finishTime`
+// +kubebuilder:printcolumn:name="Failed",type=string,JSONPath=`.status.conditions[?(@.type=="Failed")].status`
+
+// CassandraClusterBackup is the Schema for the cassandraclusterbackups API. It backs up all the datacenters of a
+// K8ssandraCluster under a single backup name, by creating a CassandraBackup for each of them.
+type CassandraClusterBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CassandraClusterBackupSpec   `json:"spec,omitempty"`
+	Status CassandraClusterBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CassandraClusterBackupList contains a list of CassandraClusterBackup
+type CassandraClusterBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CassandraClusterBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CassandraClusterBackup{}, &CassandraClusterBackupList{})
+}
+
+// BackupName returns the name of the backup in the storage bucket.
+func (in *CassandraClusterBackup) BackupName() string {
+	if in.Spec.Name != "" {
+		return in.Spec.Name
+	}
+	return in.Name
+}
+
+func (in *CassandraClusterBackupStatus) GetConditionStatus(conditionType CassandraBackupConditionType) corev1.ConditionStatus {
+	if in != nil {
+		for _, condition := range in.Conditions {
+			if condition.Type == conditionType {
+				return condition.Status
+			}
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+func (in *CassandraClusterBackupStatus) SetCondition(condition CassandraBackupCondition) {
+	for i, c := range in.Conditions {
+		if c.Type == condition.Type {
+			if c.Status == condition.Status {
+				condition.LastTransitionTime = c.LastTransitionTime
+			}
+			in.Conditions[i] = condition
+			return
+		}
+	}
+	in.Conditions = append(in.Conditions, condition)
+}
+
+// SetFailed sets the terminal Failed condition of the cluster backup.
+func (in *CassandraClusterBackupStatus) SetFailed(failed bool, message string) {
+	now := metav1.Now()
+	status := corev1.ConditionFalse
+	if failed {
+		status = corev1.ConditionTrue
+	}
+	in.SetCondition(CassandraBackupCondition{
+		Type:               BackupFailed,
+		Status:             status,
+		LastTransitionTime: &now,
+		Message:            message,
+	})
+}
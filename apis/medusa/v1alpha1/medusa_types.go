@@ -20,11 +20,26 @@ import (
 	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+const (
+	DefaultImageRepository = "k8ssandra"
+	DefaultImageName       = "medusa"
+	DefaultVersion         = "0.11.3"
+)
+
+// DefaultContainerImage is the image used for Medusa containers when ContainerImage is not set.
+var DefaultContainerImage = images.Image{
+	Registry:   images.DefaultRegistry,
+	Repository: DefaultImageRepository,
+	Name:       DefaultImageName,
+	Tag:        DefaultVersion,
+}
+
 type Storage struct {
 	// The storage backend to use for the backups.
 	// +kubebuilder:validation:Enum=local;google_storage;azure_blobs;s3;s3_compatible;s3_rgw;ibm_storage
@@ -143,4 +158,24 @@ type MedusaClusterTemplate struct {
 
 	// Provides all storage backend related properties for backups.
 	StorageProperties Storage `json:"storageProperties,omitempty"`
+
+	// BackupRetention is enforced by the operator on the CassandraBackups of each datacenter and on the
+	// CassandraClusterBackups of the cluster: the backups beyond the policy are deleted along with their data in the
+	// storage backend. Datacenters can override it for their own CassandraBackups.
+	// +optional
+	BackupRetention *BackupRetentionPolicy `json:"backupRetention,omitempty"`
+}
+
+// BackupRetentionPolicy tells which finished CassandraBackups of a datacenter, or CassandraClusterBackups of a cluster,
+// are kept. The most recent one is always
+// kept, and a backup is deleted as soon as one of the limits is exceeded.
+type BackupRetentionPolicy struct {
+	// MaxBackupCount is the number of backups that are kept. 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBackupCount int32 `json:"maxBackupCount,omitempty"`
+
+	// MaxBackupAge is how long a backup is kept after it started, e.g. "720h". Unset means unlimited.
+	// +optional
+	MaxBackupAge *metav1.Duration `json:"maxBackupAge,omitempty"`
 }
@@ -0,0 +1,115 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupScheduleLabel is set on the CassandraBackups created by a MedusaBackupSchedule, its value is the name of the
+// schedule.
+const BackupScheduleLabel = "medusa.k8ssandra.io/backup-schedule"
+
+// ConcurrencyPolicy tells what to do when a scheduled backup is due while the previous one is still running.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent creates the backup regardless of the backups that are still running.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent delays the backup until the previous backups of the schedule have finished.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+)
+
+// MedusaBackupScheduleSpec defines the desired state of MedusaBackupSchedule
+type MedusaBackupScheduleSpec struct {
+	// CronSchedule is a cron expression with five fields (minute, hour, day of month, month and day of week) giving
+	// the times at which backups are created, e.g. "0 1 * * *" for every day at 1am.
+	CronSchedule string `json:"cronSchedule"`
+
+	// TimeZone is the IANA name of the time zone in which CronSchedule is evaluated, e.g. "Europe/Paris". The default
+	// is UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Disabled suspends the schedule. When it is enabled again, a backup is created right away if one was due in
+	// the meantime.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// BackupSpec is the spec of the CassandraBackups created by the schedule. Its name is ignored: each backup is
+	// named after the schedule and the time it was due.
+	BackupSpec CassandraBackupSpec `json:"backupSpec"`
+
+	// ConcurrencyPolicy tells what to do when a backup is due while a previous backup of the schedule is still
+	// running: "Forbid" waits for it to finish, "Allow" starts the new backup anyway.
+	// +kubebuilder:validation:Enum=Allow;Forbid
+	// +kubebuilder:default:=Forbid
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// BackupsHistoryLimit is the number of backups created by the schedule that are listed in its status. The
+	// CassandraBackups themselves are not deleted.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=10
+	// +optional
+	BackupsHistoryLimit *int32 `json:"backupsHistoryLimit,omitempty"`
+}
+
+// MedusaBackupScheduleStatus defines the observed state of MedusaBackupSchedule
+type MedusaBackupScheduleStatus struct {
+	// LastExecution is the time at which the schedule last created a backup.
+	// +optional
+	LastExecution *metav1.Time `json:"lastExecution,omitempty"`
+
+	// NextSchedule is the time at which the next backup is due. It is unset while the schedule is disabled.
+	// +optional
+	NextSchedule *metav1.Time `json:"nextSchedule,omitempty"`
+
+	// Backups are the names of the most recent CassandraBackups created by the schedule, the newest first.
+	// +optional
+	Backups []string `json:"backups,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="DC",type=string,JSONPath=`.spec.backupSpec.cassandraDatacenter`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.cronSchedule`
+// +kubebuilder:printcolumn:name="Last",type="date",JSONPath=`.status.lastExecution`
+// +kubebuilder:printcolumn:name="Next",type="date",JSONPath=`.status.nextSchedule`
+
+// MedusaBackupSchedule is the Schema for the medusabackupschedules API
+type MedusaBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MedusaBackupScheduleSpec   `json:"spec,omitempty"`
+	Status MedusaBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MedusaBackupScheduleList contains a list of MedusaBackupSchedule
+type MedusaBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MedusaBackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MedusaBackupSchedule{}, &MedusaBackupScheduleList{})
+}
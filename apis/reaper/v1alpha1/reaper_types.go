@@ -229,6 +229,24 @@ type ReaperSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	SkipSchemaMigration bool `json:"skipSchemaMigration,omitempty"`
+
+	// NodeRepair requests a repair of the token ranges of a node, e.g. after it was replaced. It is set by the
+	// K8ssandraCluster controller, and its progress is reported in the nodeRepair field of the status.
+	// +optional
+	NodeRepair *NodeRepairRequest `json:"nodeRepair,omitempty"`
+}
+
+// NodeRepairRequest identifies the node to repair and the keyspaces to repair it for. One repair run is created for
+// each keyspace.
+type NodeRepairRequest struct {
+	// Node is the address of the node, as known to Reaper.
+	Node string `json:"node"`
+
+	Keyspaces []string `json:"keyspaces"`
+
+	// Cause is recorded in the repair runs, e.g. "replacement of pod cluster1-dc1-default-sts-0".
+	// +optional
+	Cause string `json:"cause,omitempty"`
 }
 
 // ReaperProgress is a word summarizing the state of a Reaper resource.
@@ -251,6 +269,9 @@ type ReaperConditionType string
 
 const (
 	ReaperReady ReaperConditionType = "Ready"
+
+	// ReaperPaused is true when reconciliation was paused with the k8ssandra.io/paused annotation.
+	ReaperPaused ReaperConditionType = "Paused"
 )
 
 type ReaperCondition struct {
@@ -272,6 +293,34 @@ type ReaperStatus struct {
 
 	// +optional
 	Conditions []ReaperCondition `json:"conditions,omitempty"`
+
+	// NodeRepair reports the progress of the last node repair requested in the spec.
+	// +optional
+	NodeRepair *NodeRepairStatus `json:"nodeRepair,omitempty"`
+}
+
+type NodeRepairProgress string
+
+const (
+	NodeRepairRunning   = NodeRepairProgress("Running")
+	NodeRepairCompleted = NodeRepairProgress("Completed")
+	// NodeRepairFailed means that at least one of the repair runs ended in the ERROR, ABORTED or DELETED state.
+	NodeRepairFailed = NodeRepairProgress("Failed")
+)
+
+// NodeRepairStatus describes the progress of the repair of a node.
+type NodeRepairStatus struct {
+	Node string `json:"node"`
+
+	Progress NodeRepairProgress `json:"progress"`
+
+	// RepairRuns maps the repaired keyspaces to the id of their Reaper repair run.
+	// +optional
+	RepairRuns map[string]string `json:"repairRuns,omitempty"`
+
+	// Message explains why the repair failed.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (in *ReaperStatus) GetConditionStatus(conditionType ReaperConditionType) corev1.ConditionStatus {
@@ -317,6 +366,24 @@ func (in *ReaperStatus) SetNotReady() {
 	})
 }
 
+// SetPaused sets the ReaperPaused condition. Returns true if the condition changed.
+func (in *ReaperStatus) SetPaused(paused bool) bool {
+	status := corev1.ConditionFalse
+	if paused {
+		status = corev1.ConditionTrue
+	}
+	if in.GetConditionStatus(ReaperPaused) == status {
+		return false
+	}
+	now := metav1.Now()
+	in.SetCondition(ReaperCondition{
+		Type:               ReaperPaused,
+		Status:             status,
+		LastTransitionTime: &now,
+	})
+	return true
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="DC",type=string,JSONPath=`.spec.datacenterRef.name`
@@ -67,6 +67,48 @@ func (in *CassandraDatacenterRef) DeepCopy() *CassandraDatacenterRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRepairRequest) DeepCopyInto(out *NodeRepairRequest) {
+	*out = *in
+	if in.Keyspaces != nil {
+		in, out := &in.Keyspaces, &out.Keyspaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRepairRequest.
+func (in *NodeRepairRequest) DeepCopy() *NodeRepairRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRepairRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRepairStatus) DeepCopyInto(out *NodeRepairStatus) {
+	*out = *in
+	if in.RepairRuns != nil {
+		in, out := &in.RepairRuns, &out.RepairRuns
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRepairStatus.
+func (in *NodeRepairStatus) DeepCopy() *NodeRepairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRepairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Reaper) DeepCopyInto(out *Reaper) {
 	*out = *in
@@ -171,6 +213,11 @@ func (in *ReaperSpec) DeepCopyInto(out *ReaperSpec) {
 		*out = new(encryption.Stores)
 		**out = **in
 	}
+	if in.NodeRepair != nil {
+		in, out := &in.NodeRepair, &out.NodeRepair
+		*out = new(NodeRepairRequest)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReaperSpec.
@@ -193,6 +240,11 @@ func (in *ReaperStatus) DeepCopyInto(out *ReaperStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeRepair != nil {
+		in, out := &in.NodeRepair, &out.NodeRepair
+		*out = new(NodeRepairStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReaperStatus.
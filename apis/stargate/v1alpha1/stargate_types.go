@@ -37,6 +37,33 @@ const (
 	StargateDeploymentLabel = "k8ssandra.io/stargate-deployment"
 )
 
+var (
+	// DefaultHeapSize is the JVM heap size of Stargate pods when HeapSize is not set.
+	DefaultHeapSize = resource.MustParse("256Mi")
+
+	defaultCpuRequest = resource.MustParse("200m")
+	defaultCpuLimit   = resource.MustParse("1000m")
+)
+
+// DefaultResources returns the resource requirements of Stargate pods when Resources is not set. The memory request
+// and limit are respectively twice and four times the given heap size.
+func DefaultResources(heapSize resource.Quantity) corev1.ResourceRequirements {
+	memoryRequest := heapSize.DeepCopy()
+	memoryRequest.Add(memoryRequest) // heap x2
+	memoryLimit := memoryRequest.DeepCopy()
+	memoryLimit.Add(memoryLimit) // heap x4
+	return corev1.ResourceRequirements{
+		Requests: map[corev1.ResourceName]resource.Quantity{
+			corev1.ResourceCPU:    defaultCpuRequest.DeepCopy(),
+			corev1.ResourceMemory: memoryRequest,
+		},
+		Limits: map[corev1.ResourceName]resource.Quantity{
+			corev1.ResourceCPU:    defaultCpuLimit.DeepCopy(),
+			corev1.ResourceMemory: memoryLimit,
+		},
+	}
+}
+
 // StargateTemplate defines a template for deploying Stargate.
 type StargateTemplate struct {
 
@@ -110,6 +137,21 @@ type StargateTemplate struct {
 	Telemetry *telemetryapi.TelemetrySpec `json:"telemetry,omitempty"`
 }
 
+// SetDefaults sets the heap size, resources and telemetry of this template to the values that are used when they are
+// not set. The default resources are derived from the heap size; once set, they are not changed by a later change of
+// the heap size.
+func (in *StargateTemplate) SetDefaults() {
+	if in.HeapSize == nil {
+		heapSize := DefaultHeapSize.DeepCopy()
+		in.HeapSize = &heapSize
+	}
+	if in.Resources == nil {
+		resources := DefaultResources(*in.HeapSize)
+		in.Resources = &resources
+	}
+	in.Telemetry = in.Telemetry.Default()
+}
+
 // StargateClusterTemplate defines global rules to apply to all Stargate pods in all datacenters in the cluster.
 // These rules will be merged with rules defined at datacenter level in a StargateDatacenterTemplate; dc-level rules
 // have precedence over cluster-level ones.
@@ -146,6 +188,14 @@ func (in *StargateDatacenterTemplate) Coalesce(clusterTemplate *StargateClusterT
 	}
 }
 
+// SetDefaults sets the defaults of this template and of its rack templates, see StargateTemplate.SetDefaults.
+func (in *StargateDatacenterTemplate) SetDefaults() {
+	in.StargateTemplate.SetDefaults()
+	for i := range in.Racks {
+		in.Racks[i].SetDefaults()
+	}
+}
+
 // StargateRackTemplate defines custom rules for Stargate pods in a given rack.
 // These rules will be merged with rules defined at datacenter level in a StargateDatacenterTemplate; rack-level rules
 // have precedence over datacenter-level ones.
@@ -267,6 +317,9 @@ type StargateConditionType string
 
 const (
 	StargateReady StargateConditionType = "Ready"
+
+	// StargatePaused is true when reconciliation was paused with the k8ssandra.io/paused annotation.
+	StargatePaused StargateConditionType = "Paused"
 )
 
 type StargateCondition struct {
@@ -337,6 +390,24 @@ func (in *StargateStatus) SetCondition(condition StargateCondition) {
 	in.Conditions = append(in.Conditions, condition)
 }
 
+// SetPaused sets the StargatePaused condition. Returns true if the condition changed.
+func (in *StargateStatus) SetPaused(paused bool) bool {
+	status := corev1.ConditionFalse
+	if paused {
+		status = corev1.ConditionTrue
+	}
+	if in.GetConditionStatus(StargatePaused) == status {
+		return false
+	}
+	now := metav1.Now()
+	in.SetCondition(StargateCondition{
+		Type:               StargatePaused,
+		Status:             status,
+		LastTransitionTime: &now,
+	})
+	return true
+}
+
 func (in *Stargate) GetRackTemplate(name string) *StargateRackTemplate {
 	for _, rack := range in.Spec.Racks {
 		if rack.Name == name {
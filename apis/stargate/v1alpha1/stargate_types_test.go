@@ -24,6 +24,7 @@ func TestStargateStatus(t *testing.T) {
 
 func TestStargateDatacenterTemplate(t *testing.T) {
 	t.Run("Coalesce", testStargateDatacenterTemplateCoalesce)
+	t.Run("SetDefaults", testStargateDatacenterTemplateSetDefaults)
 }
 
 func TestStargateRackTemplate(t *testing.T) {
@@ -241,3 +242,43 @@ func testStargateRackTemplateCoalesce(t *testing.T) {
 		assert.Equal(t, &rackTemplate.StargateTemplate, actual)
 	})
 }
+
+func testStargateDatacenterTemplateSetDefaults(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		template := &StargateDatacenterTemplate{}
+		template.SetDefaults()
+		assert.Equal(t, &quantity256Mi, template.HeapSize)
+		assert.Equal(t, "512Mi", template.Resources.Requests.Memory().String())
+		assert.Equal(t, "1Gi", template.Resources.Limits.Memory().String())
+		assert.Equal(t, "200m", template.Resources.Requests.Cpu().String())
+		assert.Equal(t, "1", template.Resources.Limits.Cpu().String())
+		assert.False(t, template.Telemetry.Prometheus.Enabled)
+	})
+	t.Run("heap size changed", func(t *testing.T) {
+		template := &StargateDatacenterTemplate{}
+		template.SetDefaults()
+		template.HeapSize = &quantity512Mi
+		template.SetDefaults()
+		assert.Equal(t, DefaultResources(quantity256Mi), *template.Resources, "resources that are set are kept")
+	})
+	t.Run("custom resources", func(t *testing.T) {
+		resources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: quantity512Mi},
+		}
+		template := &StargateDatacenterTemplate{
+			StargateClusterTemplate: StargateClusterTemplate{
+				StargateTemplate: StargateTemplate{Resources: resources.DeepCopy(), HeapSize: &quantity512Mi},
+			},
+		}
+		template.SetDefaults()
+		assert.Equal(t, resources, *template.Resources)
+	})
+	t.Run("racks", func(t *testing.T) {
+		template := &StargateDatacenterTemplate{
+			Racks: []StargateRackTemplate{{Name: "rack1", StargateTemplate: StargateTemplate{HeapSize: &quantity512Mi}}},
+		}
+		template.SetDefaults()
+		assert.Equal(t, DefaultResources(quantity256Mi), *template.Resources)
+		assert.Equal(t, DefaultResources(quantity512Mi), *template.Racks[0].Resources)
+	})
+}
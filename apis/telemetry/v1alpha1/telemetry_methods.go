@@ -47,3 +47,16 @@ func (a *PrometheusTelemetrySpec) Merge(b *PrometheusTelemetrySpec) *PrometheusT
 	out.Enabled = b.Enabled
 	return &out
 }
+
+// Default returns a copy of this spec in which the Prometheus integration is explicitly enabled or disabled. A nil
+// spec or a nil Prometheus spec are defaulted to a disabled integration.
+func (a *TelemetrySpec) Default() *TelemetrySpec {
+	out := a.DeepCopy()
+	if out == nil {
+		out = &TelemetrySpec{}
+	}
+	if out.Prometheus == nil {
+		out.Prometheus = &PrometheusTelemetrySpec{Enabled: false}
+	}
+	return out
+}
@@ -74,3 +74,15 @@ func TestPrometheusTelemetrySpec_Merge_AFalseBTrue(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 }
+
+func TestTelemetrySpec_Default(t *testing.T) {
+	disabled := &TelemetrySpec{Prometheus: &PrometheusTelemetrySpec{Enabled: false}}
+	assert.Equal(t, disabled, (*TelemetrySpec)(nil).Default())
+	assert.Equal(t, disabled, (&TelemetrySpec{}).Default())
+
+	enabled := &TelemetrySpec{Prometheus: &PrometheusTelemetrySpec{
+		Enabled:      true,
+		CommonLabels: map[string]string{"label": "value"},
+	}}
+	assert.Equal(t, enabled, enabled.Default())
+}
@@ -7,7 +7,8 @@ type TelemetrySpec struct {
 }
 type PrometheusTelemetrySpec struct {
 	// Enable the creation of Prometheus serviceMonitors for this resource (Cassandra or Stargate).
-	Enabled bool `json:"enabled,omitempty"` // A bool flag required here to disambiguate when e.g. the cluster should have telemetry turned on but one DC should have it explicitly turned off.
+	// +optional
+	Enabled bool `json:"enabled"` // A bool flag required here to disambiguate when e.g. the cluster should have telemetry turned on but one DC should have it explicitly turned off.
 	// CommonLabels are applied to all serviceMonitors created.
 	// +optional
 	CommonLabels map[string]string `json:"commonLabels,omitempty"`
@@ -178,6 +178,7 @@ func (r *ClientConfigReconciler) InitClientConfigs(ctx context.Context, mgr ctrl
 		}
 
 		r.ClientCache.AddClient(cCfg.GetContextName(), c.GetClient())
+		r.ClientCache.AddRecorder(cCfg.GetContextName(), c.GetEventRecorderFor("k8ssandra-operator"))
 
 		err = mgr.Add(c)
 		if err != nil {
@@ -222,6 +222,7 @@ func withUserKeyspaces(ctx context.Context, t *testing.T, f *framework.Framework
 	userKeyspaces := []string{"ks1", "ks2"}
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -276,6 +277,7 @@ func schemaDisagreementOnSystemKeyspaces(ctx context.Context, t *testing.T, f *f
 	schemaDisagreementErr := kerrors.NewSchemaDisagreementError("system keyspace check failed")
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(schemaDisagreementErr)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -321,6 +323,7 @@ func configureSrcDcForRebuild(ctx context.Context, t *testing.T, f *framework.Fr
 	updatedReplication := map[string]int{"dc1": 3, "dc2": 3, "dc3": 3}
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -384,6 +387,7 @@ func withStargateAndReaper(ctx context.Context, t *testing.T, f *framework.Frame
 	updatedReplication := map[string]int{"dc1": 3, "dc2": 3}
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -500,6 +504,7 @@ func schemaDisagreementOnStargate(ctx context.Context, t *testing.T, f *framewor
 	schemaDisagreementErr := kerrors.NewSchemaDisagreementError("system keyspace check failed")
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -600,6 +605,7 @@ func failSystemKeyspaceUpdate(ctx context.Context, t *testing.T, f *framework.Fr
 	replicationCheckErr := fmt.Errorf("failed to check replication")
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(replicationCheckErr)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -645,6 +651,7 @@ func failUserKeyspaceUpdate(ctx context.Context, t *testing.T, f *framework.Fram
 	replicationCheckErr := fmt.Errorf("failed to check replication")
 
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", replication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_auth", updatedReplication).Return(nil)
 	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, "system_distributed", replication).Return(nil)
@@ -857,3 +864,45 @@ func createCassandraDatacenter(ctx context.Context, t *testing.T, f *framework.F
 	err = f.Create(ctx, dcKey, dc)
 	require.NoError(t, err, "failed to create cassandradatacenter")
 }
+
+func TestGetSourceDatacenterName(t *testing.T) {
+	ready := &cassdcapi.CassandraDatacenterStatus{
+		Conditions: []cassdcapi.DatacenterCondition{{Type: cassdcapi.DatacenterReady, Status: corev1.ConditionTrue}},
+	}
+	newCluster := func() *api.K8ssandraCluster {
+		return &api.K8ssandraCluster{
+			Spec: api.K8ssandraClusterSpec{
+				Cassandra: &api.CassandraClusterTemplate{
+					Datacenters: []api.CassandraDatacenterTemplate{
+						{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+						{Meta: api.EmbeddedObjectMeta{Name: "dc2"}},
+						{Meta: api.EmbeddedObjectMeta{Name: "dc3"}},
+					},
+				},
+			},
+			Status: api.K8ssandraClusterStatus{
+				Datacenters: map[string]api.K8ssandraStatus{"dc1": {Cassandra: ready}, "dc2": {Cassandra: ready}},
+			},
+		}
+	}
+	dc3 := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Name: "dc3"}}
+
+	kc := newCluster()
+	srcDc, err := getSourceDatacenterName(dc3, kc)
+	require.NoError(t, err)
+	assert.Equal(t, "dc1", srcDc)
+
+	kc.Annotations = map[string]string{api.RebuildSourceDcAnnotation: "dc2"}
+	srcDc, err = getSourceDatacenterName(dc3, kc)
+	require.NoError(t, err)
+	assert.Equal(t, "dc2", srcDc)
+
+	kc.Annotations[api.DcRebuildSourceAnnotation] = `{"dc2": "dc1", "dc3": "dc1"}`
+	srcDc, err = getSourceDatacenterName(dc3, kc)
+	require.NoError(t, err)
+	assert.Equal(t, "dc1", srcDc)
+
+	kc.Annotations[api.DcRebuildSourceAnnotation] = `{"dc3": "dc3"}`
+	_, err = getSourceDatacenterName(dc3, kc)
+	assert.Error(t, err)
+}
@@ -51,12 +51,12 @@ func (r *K8ssandraClusterReconciler) reconcileCassandraDCTelemetry(
 	}
 	// Determine if we want a cleanup or a resource update.
 	switch {
-	case mergedSpec == nil || mergedSpec.Prometheus == nil:
-		logger.Info("Telemetry not present for CassDC, will delete resources", "mergedSpec", mergedSpec)
+	case mergedSpec == nil || mergedSpec.Prometheus == nil || !mergedSpec.Prometheus.Enabled:
+		logger.Info("Telemetry not enabled for CassDC, will delete resources", "mergedSpec", mergedSpec)
 		if err := cfg.CleanupResources(ctx, remoteClient); err != nil {
 			return result.Error(err)
 		}
-	case mergedSpec.Prometheus.Enabled:
+	default:
 		logger.Info("Prometheus config found", "mergedSpec", mergedSpec)
 		desiredSM, err := cfg.NewCassServiceMonitor()
 		if err != nil {
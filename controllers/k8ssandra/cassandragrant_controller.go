@@ -0,0 +1,249 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	cassandraGrantFinalizer = "cassandragrant.k8ssandra.io/finalizer"
+)
+
+// CassandraGrantReconciler reconciles a CassandraGrant object
+type CassandraGrantReconciler struct {
+	*config.ReconcilerConfig
+	client.Client
+	Scheme        *runtime.Scheme
+	ClientCache   *clientcache.ClientCache
+	ManagementApi cassandra.ManagementApiFactory
+	Recorder      record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandragrants,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandragrants/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandragrants/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+func (r *CassandraGrantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("CassandraGrant", req.NamespacedName)
+
+	grant := &api.CassandraGrant{}
+	if err := r.Get(ctx, req.NamespacedName, grant); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	grant = grant.DeepCopy()
+	patch := client.MergeFromWithOptions(grant.DeepCopy())
+	recResult := r.reconcile(ctx, grant, logger)
+	if err := resultError(recResult); err != nil {
+		r.Recorder.Event(grant, corev1.EventTypeWarning, eventReasonReconcileFailed, err.Error())
+	}
+	if grant.GetDeletionTimestamp() == nil {
+		if patchErr := r.Status().Patch(ctx, grant, patch); patchErr != nil {
+			logger.Error(patchErr, "Failed to update CassandraGrant status")
+		}
+	}
+	return recResult.Output()
+}
+
+func (r *CassandraGrantReconciler) reconcile(ctx context.Context, grant *api.CassandraGrant, logger logr.Logger) result.ReconcileResult {
+	kcKey := types.NamespacedName{Namespace: grant.Namespace, Name: grant.Spec.Cluster.Name}
+	kc := &api.K8ssandraCluster{}
+	if err := r.Get(ctx, kcKey, kc); err != nil {
+		if !errors.IsNotFound(err) {
+			return result.Error(err)
+		}
+		kc = nil
+	}
+
+	if grant.DeletionTimestamp != nil {
+		return r.checkDeletion(ctx, grant, kc, logger)
+	}
+
+	if !controllerutil.ContainsFinalizer(grant, cassandraGrantFinalizer) {
+		patch := client.MergeFrom(grant.DeepCopy())
+		controllerutil.AddFinalizer(grant, cassandraGrantFinalizer)
+		if err := r.Patch(ctx, grant, patch); err != nil {
+			logger.Error(err, "Failed to add finalizer")
+			return result.Error(err)
+		}
+	}
+
+	if kc == nil {
+		logger.Info("K8ssandraCluster not found", "K8ssandraCluster", kcKey)
+		grant.Status.SetReady(false, fmt.Sprintf("K8ssandraCluster %s not found", kcKey))
+		return result.RequeueSoon(r.LongDelay)
+	}
+
+	if !kc.Spec.IsAuthEnabled() {
+		grant.Status.SetReady(false, "Authentication is disabled in the K8ssandraCluster")
+		return result.Done()
+	}
+
+	if grant.Status.ObservedGeneration == grant.Generation && grant.Status.GetConditionStatus(api.CassandraGrantReady) == corev1.ConditionTrue {
+		return result.Done()
+	}
+
+	mgmtApi, err := newManagementApiForCluster(ctx, r.ClientCache, r.ManagementApi, kc, kc.GetInitializedDatacenters(), logger)
+	if err != nil {
+		return result.Error(err)
+	} else if mgmtApi == nil {
+		logger.Info("Waiting for a datacenter to become ready", "K8ssandraCluster", kcKey)
+		grant.Status.SetReady(false, "No datacenter is ready")
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	if revoked := permissionsToRevoke(grant); revoked != nil {
+		logger.Info("Revoking permissions", "Role", revoked.Role, "Permissions", revoked.Permissions)
+		if err := mgmtApi.RevokePermissions(revoked); err != nil {
+			logger.Error(err, "Failed to revoke permissions", "Role", revoked.Role)
+			grant.Status.SetReady(false, fmt.Sprintf("Failed to revoke permissions: %v", err))
+			return result.Error(err)
+		}
+		r.Recorder.Eventf(grant, corev1.EventTypeNormal, eventReasonRevokedPermissions, "Revoked %v from role %s", revoked.Permissions, revoked.Role)
+	}
+
+	desired := newPermissionsDefinition(grant.Spec.CassandraGrantTarget, grant.Spec.Permissions)
+	logger.Info("Granting permissions", "Role", desired.Role, "Permissions", desired.Permissions)
+	if err := mgmtApi.GrantPermissions(desired); err != nil {
+		logger.Error(err, "Failed to grant permissions", "Role", desired.Role)
+		grant.Status.SetReady(false, fmt.Sprintf("Failed to grant permissions: %v", err))
+		return result.Error(err)
+	}
+	if grant.Status.ObservedGeneration != grant.Generation {
+		r.Recorder.Eventf(grant, corev1.EventTypeNormal, eventReasonGrantedPermissions, "Granted %v to role %s", desired.Permissions, desired.Role)
+	}
+
+	grant.Status.Applied = &api.AppliedCassandraGrant{
+		CassandraGrantTarget: grant.Spec.CassandraGrantTarget,
+		Permissions:          grant.Spec.Permissions,
+	}
+	grant.Status.ObservedGeneration = grant.Generation
+	grant.Status.SetReady(true, "")
+
+	return result.Done()
+}
+
+// permissionsToRevoke returns the permissions that were previously granted but are not desired anymore, or nil if
+// there are none. All of them are revoked if the role or the resource changed.
+func permissionsToRevoke(grant *api.CassandraGrant) *cassandra.PermissionsDefinition {
+	applied := grant.Status.Applied
+	if applied == nil || len(applied.Permissions) == 0 {
+		return nil
+	}
+
+	if applied.CassandraGrantTarget != grant.Spec.CassandraGrantTarget {
+		return newPermissionsDefinition(applied.CassandraGrantTarget, applied.Permissions)
+	}
+
+	removed := make([]api.CassandraPermission, 0)
+	for _, permission := range applied.Permissions {
+		if !containsPermission(grant.Spec.Permissions, permission) {
+			removed = append(removed, permission)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	return newPermissionsDefinition(applied.CassandraGrantTarget, removed)
+}
+
+func containsPermission(permissions []api.CassandraPermission, permission api.CassandraPermission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func newPermissionsDefinition(target api.CassandraGrantTarget, permissions []api.CassandraPermission) *cassandra.PermissionsDefinition {
+	definition := &cassandra.PermissionsDefinition{
+		Role:        target.Role,
+		Keyspace:    target.Keyspace,
+		Table:       target.Table,
+		Permissions: make([]string, 0, len(permissions)),
+	}
+	for _, permission := range permissions {
+		definition.Permissions = append(definition.Permissions, string(permission))
+	}
+	return definition
+}
+
+// checkDeletion revokes the granted permissions before removing the finalizer. If the K8ssandraCluster is gone or
+// is being deleted, there is nothing left to revoke the permissions from.
+func (r *CassandraGrantReconciler) checkDeletion(ctx context.Context, grant *api.CassandraGrant, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	if !controllerutil.ContainsFinalizer(grant, cassandraGrantFinalizer) {
+		return result.Done()
+	}
+
+	if applied := grant.Status.Applied; applied != nil && kc != nil && kc.DeletionTimestamp == nil && kc.Spec.IsAuthEnabled() {
+		mgmtApi, err := newManagementApiForCluster(ctx, r.ClientCache, r.ManagementApi, kc, kc.GetInitializedDatacenters(), logger)
+		if err != nil {
+			return result.Error(err)
+		} else if mgmtApi == nil {
+			logger.Info("Waiting for a datacenter to become ready to revoke permissions")
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+
+		revoked := newPermissionsDefinition(applied.CassandraGrantTarget, applied.Permissions)
+		logger.Info("Revoking permissions", "Role", revoked.Role, "Permissions", revoked.Permissions)
+		if err := mgmtApi.RevokePermissions(revoked); err != nil {
+			logger.Error(err, "Failed to revoke permissions", "Role", revoked.Role)
+			return result.Error(err)
+		}
+	}
+
+	patch := client.MergeFrom(grant.DeepCopy())
+	controllerutil.RemoveFinalizer(grant, cassandraGrantFinalizer)
+	if err := r.Patch(ctx, grant, patch); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return result.Error(err)
+	}
+
+	return result.Done()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CassandraGrantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&api.CassandraGrant{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
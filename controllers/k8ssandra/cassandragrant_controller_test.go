@@ -0,0 +1,162 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPermissionsToRevoke(t *testing.T) {
+	target := api.CassandraGrantTarget{Role: "app", Keyspace: "ks1"}
+	otherTarget := api.CassandraGrantTarget{Role: "app", Keyspace: "ks1", Table: "t1"}
+
+	tests := []struct {
+		name     string
+		spec     api.CassandraGrantSpec
+		applied  *api.AppliedCassandraGrant
+		expected *cassandra.PermissionsDefinition
+	}{
+		{
+			name:     "nothing applied",
+			spec:     api.CassandraGrantSpec{CassandraGrantTarget: target, Permissions: []api.CassandraPermission{api.PermissionSelect}},
+			expected: nil,
+		},
+		{
+			name:     "permissions added",
+			spec:     api.CassandraGrantSpec{CassandraGrantTarget: target, Permissions: []api.CassandraPermission{api.PermissionSelect, api.PermissionModify}},
+			applied:  &api.AppliedCassandraGrant{CassandraGrantTarget: target, Permissions: []api.CassandraPermission{api.PermissionSelect}},
+			expected: nil,
+		},
+		{
+			name:    "permissions removed",
+			spec:    api.CassandraGrantSpec{CassandraGrantTarget: target, Permissions: []api.CassandraPermission{api.PermissionSelect}},
+			applied: &api.AppliedCassandraGrant{CassandraGrantTarget: target, Permissions: []api.CassandraPermission{api.PermissionSelect, api.PermissionModify}},
+			expected: &cassandra.PermissionsDefinition{
+				Role:        "app",
+				Keyspace:    "ks1",
+				Permissions: []string{"MODIFY"},
+			},
+		},
+		{
+			name:    "resource changed",
+			spec:    api.CassandraGrantSpec{CassandraGrantTarget: otherTarget, Permissions: []api.CassandraPermission{api.PermissionSelect}},
+			applied: &api.AppliedCassandraGrant{CassandraGrantTarget: target, Permissions: []api.CassandraPermission{api.PermissionSelect}},
+			expected: &cassandra.PermissionsDefinition{
+				Role:        "app",
+				Keyspace:    "ks1",
+				Permissions: []string{"SELECT"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := &api.CassandraGrant{Spec: tt.spec, Status: api.CassandraGrantStatus{Applied: tt.applied}}
+			assert.Equal(t, tt.expected, permissionsToRevoke(grant))
+		})
+	}
+}
+
+// createAndDeleteCassandraGrant verifies that the permissions of a CassandraGrant are granted through the management
+// API once a datacenter is ready, and that they are revoked when the CassandraGrant is deleted.
+func createAndDeleteCassandraGrant(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{}, nil)
+	mockMgmtApi.On(testutils.GetSchemaVersions).Return(map[string][]string{"fake": {"test"}}, nil)
+	mockMgmtApi.On(testutils.GrantPermissions, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.RevokePermissions, mock.Anything).Return(nil)
+
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "grant-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.1",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+		},
+	}
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	grant := &api.CassandraGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "app-ks1",
+		},
+		Spec: api.CassandraGrantSpec{
+			Cluster:              corev1.LocalObjectReference{Name: kc.Name},
+			CassandraGrantTarget: api.CassandraGrantTarget{Role: "app", Keyspace: "ks1"},
+			Permissions:          []api.CassandraPermission{api.PermissionSelect, api.PermissionModify},
+		},
+	}
+
+	err = f.Client.Create(ctx, grant)
+	require.NoError(err, "failed to create CassandraGrant")
+
+	dc1Key := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	t.Log("check that dc1 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc1Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc1Key)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	t.Log("check that the permissions were granted")
+	require.Eventually(func() bool {
+		actual := &api.CassandraGrant{}
+		if err := f.Client.Get(ctx, utils.GetKey(grant), actual); err != nil {
+			return false
+		}
+		return actual.Status.Applied != nil && actual.Status.GetConditionStatus(api.CassandraGrantReady) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraGrant status update")
+
+	assert.True(t, mockMgmtApi.GetFirstCall(testutils.GrantPermissions, mock.MatchedBy(func(def *cassandra.PermissionsDefinition) bool {
+		return def.Role == "app" && def.Keyspace == "ks1" && def.Table == "" &&
+			assert.ObjectsAreEqual([]string{"SELECT", "MODIFY"}, def.Permissions)
+	})) > -1, "expected permissions to be granted")
+
+	t.Log("delete the CassandraGrant and check that the permissions were revoked")
+	err = f.Client.Delete(ctx, grant)
+	require.NoError(err, "failed to delete CassandraGrant")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, utils.GetKey(grant), &api.CassandraGrant{})
+		return errors.IsNotFound(err)
+	}, timeout, interval, "timed out waiting for CassandraGrant deletion")
+	assert.True(t, mockMgmtApi.GetFirstCall(testutils.RevokePermissions, mock.MatchedBy(func(def *cassandra.PermissionsDefinition) bool {
+		return def.Role == "app" && def.Keyspace == "ks1"
+	})) > -1, "expected permissions to be revoked")
+
+	err = f.DeleteK8ssandraCluster(ctx, utils.GetKey(kc))
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	kerrors "github.com/k8ssandra/k8ssandra-operator/pkg/errors"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// CassandraKeyspaceReconciler reconciles a CassandraKeyspace object
+type CassandraKeyspaceReconciler struct {
+	*config.ReconcilerConfig
+	client.Client
+	Scheme        *runtime.Scheme
+	ClientCache   *clientcache.ClientCache
+	ManagementApi cassandra.ManagementApiFactory
+	Recorder      record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandrakeyspaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandrakeyspaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cassandra.datastax.com,namespace="k8ssandra",resources=cassandradatacenters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=pods;secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+func (r *CassandraKeyspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("CassandraKeyspace", req.NamespacedName)
+
+	ks := &api.CassandraKeyspace{}
+	if err := r.Get(ctx, req.NamespacedName, ks); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	ks = ks.DeepCopy()
+	patch := client.MergeFromWithOptions(ks.DeepCopy())
+	recResult := r.reconcile(ctx, ks, logger)
+	if err := resultError(recResult); err != nil {
+		r.Recorder.Event(ks, corev1.EventTypeWarning, eventReasonReconcileFailed, err.Error())
+	}
+	if patchErr := r.Status().Patch(ctx, ks, patch); patchErr != nil {
+		logger.Error(patchErr, "Failed to update CassandraKeyspace status")
+	}
+	return recResult.Output()
+}
+
+func (r *CassandraKeyspaceReconciler) reconcile(ctx context.Context, ks *api.CassandraKeyspace, logger logr.Logger) result.ReconcileResult {
+	kcKey := types.NamespacedName{Namespace: ks.Namespace, Name: ks.Spec.Cluster.Name}
+	kc := &api.K8ssandraCluster{}
+	if err := r.Get(ctx, kcKey, kc); err != nil {
+		if errors.IsNotFound(err) {
+			// The keyspace will be enqueued again when the K8ssandraCluster gets created
+			logger.Info("K8ssandraCluster not found", "K8ssandraCluster", kcKey)
+			ks.Status.SetReady(false, fmt.Sprintf("K8ssandraCluster %s not found", kcKey))
+			return result.Done()
+		}
+		return result.Error(err)
+	}
+
+	datacenters := kc.GetInitializedDatacenters()
+	if len(datacenters) == 0 {
+		logger.Info("Waiting for K8ssandraCluster datacenters to be initialized", "K8ssandraCluster", kcKey)
+		ks.Status.SetReady(false, "Waiting for datacenters to be initialized")
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	mgmtApi, err := newManagementApiForCluster(ctx, r.ClientCache, r.ManagementApi, kc, datacenters, logger)
+	if err != nil {
+		return result.Error(err)
+	} else if mgmtApi == nil {
+		logger.Info("Waiting for a datacenter to become ready", "K8ssandraCluster", kcKey)
+		ks.Status.SetReady(false, "No datacenter is ready")
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	keyspaceName := ks.KeyspaceName()
+	replication := cassandra.ComputeKeyspaceReplication(&ks.Spec, kc.Spec.ExternalDatacenters, datacenters...)
+	logger.Info("Reconciling keyspace", "Keyspace", keyspaceName, "Replication", replication)
+
+	if err := mgmtApi.EnsureKeyspaceReplication(keyspaceName, replication); err != nil {
+		if kerrors.IsSchemaDisagreement(err) {
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+		logger.Error(err, "Failed to reconcile keyspace replication", "Keyspace", keyspaceName)
+		ks.Status.SetReady(false, fmt.Sprintf("Failed to reconcile replication: %v", err))
+		return result.Error(err)
+	}
+	if !reflect.DeepEqual(ks.Status.Replication, replication) {
+		r.Recorder.Eventf(ks, corev1.EventTypeNormal, eventReasonReplicationUpdated, "Set the replication of keyspace %s to %v", keyspaceName, replication)
+	}
+	ks.Status.Replication = replication
+
+	durableWrites := ks.Spec.IsDurableWrites()
+	if ks.Status.DurableWrites == nil || *ks.Status.DurableWrites != durableWrites {
+		if err := mgmtApi.AlterKeyspaceDurableWrites(keyspaceName, replication, durableWrites); err != nil {
+			if kerrors.IsSchemaDisagreement(err) {
+				return result.RequeueSoon(r.DefaultDelay)
+			}
+			logger.Error(err, "Failed to update durable writes", "Keyspace", keyspaceName)
+			ks.Status.SetReady(false, fmt.Sprintf("Failed to update durable writes: %v", err))
+			return result.Error(err)
+		}
+		ks.Status.DurableWrites = &durableWrites
+	}
+
+	ks.Status.ObservedGeneration = ks.Generation
+	ks.Status.SetReady(true, "")
+
+	return result.Done()
+}
+
+// getManagedKeyspaces returns the names of the keyspaces of kc that are managed through CassandraKeyspace objects.
+func getManagedKeyspaces(ctx context.Context, c client.Client, kc *api.K8ssandraCluster) ([]string, error) {
+	list := &api.CassandraKeyspaceList{}
+	if err := c.List(ctx, list, client.InNamespace(kc.Namespace)); err != nil {
+		return nil, err
+	}
+
+	keyspaces := make([]string, 0)
+	for _, ks := range list.Items {
+		if ks.Spec.Cluster.Name == kc.Name {
+			keyspaces = append(keyspaces, ks.KeyspaceName())
+		}
+	}
+	return keyspaces, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CassandraKeyspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Keyspaces need to be reconciled whenever datacenters are added to or removed from their cluster, which is
+	// reflected in the K8ssandraCluster status.
+	clusterToKeyspaces := func(mapObj client.Object) []reconcile.Request {
+		requests := make([]reconcile.Request, 0)
+
+		list := &api.CassandraKeyspaceList{}
+		if err := r.List(context.Background(), list, client.InNamespace(mapObj.GetNamespace())); err != nil {
+			mgr.GetLogger().Error(err, "Failed to list CassandraKeyspaces", "K8ssandraCluster", client.ObjectKeyFromObject(mapObj))
+			return requests
+		}
+
+		for _, ks := range list.Items {
+			if ks.Spec.Cluster.Name == mapObj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ks.Namespace, Name: ks.Name}})
+			}
+		}
+		return requests
+	}
+
+	// The K8ssandraCluster status is patched on every reconciliation, only the changes that can affect replication
+	// are relevant here.
+	datacentersChanged := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldKc, oldOk := e.ObjectOld.(*api.K8ssandraCluster)
+			newKc, newOk := e.ObjectNew.(*api.K8ssandraCluster)
+			if !oldOk || !newOk {
+				return true
+			}
+			return !reflect.DeepEqual(replicationDatacenters(oldKc), replicationDatacenters(newKc))
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&api.CassandraKeyspace{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &api.K8ssandraCluster{}},
+			handler.EnqueueRequestsFromMapFunc(clusterToKeyspaces),
+			builder.WithPredicates(datacentersChanged)).
+		Complete(r)
+}
+
+// replicationDatacenters returns the names and sizes of the datacenters of kc that keyspace replication depends on.
+func replicationDatacenters(kc *api.K8ssandraCluster) map[string]int {
+	dcs := make(map[string]int)
+	for _, dcTemplate := range kc.GetInitializedDatacenters() {
+		dcs[dcTemplate.Meta.Name] = int(dcTemplate.Size)
+	}
+	for _, dcName := range kc.Spec.ExternalDatacenters {
+		dcs[dcName] = -1
+	}
+	return dcs
+}
@@ -0,0 +1,117 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// createCassandraKeyspace verifies that a CassandraKeyspace gets its replication computed from the deployed DCs
+// and applied through the management API, and that the applied settings are reported in its status.
+func createCassandraKeyspace(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.AlterKeyspaceDurableWrites, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{}, nil)
+	mockMgmtApi.On(testutils.GetSchemaVersions).Return(map[string][]string{"fake": {"test"}}, nil)
+
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "keyspace-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.1",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, K8sContext: k8sCtx1, Size: 1},
+				},
+			},
+		},
+	}
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	ks := &api.CassandraKeyspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "ks1",
+		},
+		Spec: api.CassandraKeyspaceSpec{
+			Cluster:       corev1.LocalObjectReference{Name: kc.Name},
+			DurableWrites: pointer.Bool(false),
+		},
+	}
+
+	err = f.Client.Create(ctx, ks)
+	require.NoError(err, "failed to create CassandraKeyspace")
+
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dc1Key := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	t.Log("check that dc1 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc1Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc1Key)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	t.Log("check that the keyspace was created with dc1 replication")
+	verifyCassandraKeyspaceStatus(ctx, t, f, utils.GetKey(ks), map[string]int{"dc1": 3})
+
+	dc2Key := framework.NewClusterKey(k8sCtx1, namespace, "dc2")
+	t.Log("check that dc2 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc2Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc2Key)
+	require.NoError(err, "failed to set dc2 status ready")
+
+	t.Log("check that the keyspace replication was updated for dc2")
+	verifyCassandraKeyspaceStatus(ctx, t, f, utils.GetKey(ks), map[string]int{"dc1": 3, "dc2": 1})
+	verifyReplicationOfKeyspaceUpdated(t, mockMgmtApi, "ks1", map[string]int{"dc1": 3, "dc2": 1})
+	assert.True(t, mockMgmtApi.GetFirstCall(testutils.AlterKeyspaceDurableWrites, "ks1", mock.Anything, false) > -1,
+		"expected durable writes to be disabled")
+
+	err = f.Client.Delete(ctx, ks)
+	require.NoError(err, "failed to delete CassandraKeyspace")
+
+	err = f.DeleteK8ssandraCluster(ctx, utils.GetKey(kc))
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
+
+func verifyCassandraKeyspaceStatus(ctx context.Context, t *testing.T, f *framework.Framework, key client.ObjectKey, replication map[string]int) {
+	require.Eventually(t, func() bool {
+		ks := &api.CassandraKeyspace{}
+		if err := f.Client.Get(ctx, key, ks); err != nil {
+			t.Logf("failed to get CassandraKeyspace: %v", err)
+			return false
+		}
+		return ks.Status.GetConditionStatus(api.CassandraKeyspaceReady) == corev1.ConditionTrue &&
+			assert.ObjectsAreEqual(replication, ks.Status.Replication) &&
+			ks.Status.DurableWrites != nil && !*ks.Status.DurableWrites
+	}, timeout, interval, "timed out waiting for CassandraKeyspace status update")
+}
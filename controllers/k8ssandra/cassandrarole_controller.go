@@ -0,0 +1,254 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/secret"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	cassandraRoleFinalizer = "cassandrarole.k8ssandra.io/finalizer"
+)
+
+// CassandraRoleReconciler reconciles a CassandraRole object
+type CassandraRoleReconciler struct {
+	*config.ReconcilerConfig
+	client.Client
+	Scheme        *runtime.Scheme
+	ClientCache   *clientcache.ClientCache
+	ManagementApi cassandra.ManagementApiFactory
+	Recorder      record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandraroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandraroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandraroles/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+func (r *CassandraRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("CassandraRole", req.NamespacedName)
+
+	role := &api.CassandraRole{}
+	if err := r.Get(ctx, req.NamespacedName, role); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	role = role.DeepCopy()
+	patch := client.MergeFromWithOptions(role.DeepCopy())
+	recResult := r.reconcile(ctx, role, logger)
+	if err := resultError(recResult); err != nil {
+		r.Recorder.Event(role, corev1.EventTypeWarning, eventReasonReconcileFailed, err.Error())
+	}
+	if role.GetDeletionTimestamp() == nil {
+		if patchErr := r.Status().Patch(ctx, role, patch); patchErr != nil {
+			logger.Error(patchErr, "Failed to update CassandraRole status")
+		}
+	}
+	return recResult.Output()
+}
+
+func (r *CassandraRoleReconciler) reconcile(ctx context.Context, role *api.CassandraRole, logger logr.Logger) result.ReconcileResult {
+	kcKey := types.NamespacedName{Namespace: role.Namespace, Name: role.Spec.Cluster.Name}
+	kc := &api.K8ssandraCluster{}
+	if err := r.Get(ctx, kcKey, kc); err != nil {
+		if !errors.IsNotFound(err) {
+			return result.Error(err)
+		}
+		kc = nil
+	}
+
+	if role.DeletionTimestamp != nil {
+		return r.checkDeletion(ctx, role, kc, logger)
+	}
+
+	if !controllerutil.ContainsFinalizer(role, cassandraRoleFinalizer) {
+		patch := client.MergeFrom(role.DeepCopy())
+		controllerutil.AddFinalizer(role, cassandraRoleFinalizer)
+		if err := r.Patch(ctx, role, patch); err != nil {
+			logger.Error(err, "Failed to add finalizer")
+			return result.Error(err)
+		}
+	}
+
+	if kc == nil {
+		logger.Info("K8ssandraCluster not found", "K8ssandraCluster", kcKey)
+		role.Status.SetReady(false, fmt.Sprintf("K8ssandraCluster %s not found", kcKey))
+		return result.RequeueSoon(r.LongDelay)
+	}
+
+	if !kc.Spec.IsAuthEnabled() {
+		role.Status.SetReady(false, "Authentication is disabled in the K8ssandraCluster")
+		return result.Done()
+	}
+
+	// Labelling the secret as managed by the K8ssandraCluster gets it replicated to all the DCs by the
+	// ReplicatedSecret of the cluster.
+	if err := secret.ReconcileSecretWithUsername(ctx, r.Client, role.Spec.SecretRef.Name, role.RoleName(), utils.GetKey(kc)); err != nil {
+		logger.Error(err, "Failed to reconcile role secret", "Secret", role.Spec.SecretRef.Name)
+		return result.Error(err)
+	}
+
+	roleSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: role.Namespace, Name: role.Spec.SecretRef.Name}, roleSecret); err != nil {
+		return result.Error(err)
+	}
+	password, found := roleSecret.Data["password"]
+	if !found {
+		err := fmt.Errorf("secret %s does not have a password key", role.Spec.SecretRef.Name)
+		role.Status.SetReady(false, err.Error())
+		return result.Error(err)
+	}
+
+	mgmtApi, err := newManagementApiForCluster(ctx, r.ClientCache, r.ManagementApi, kc, kc.GetInitializedDatacenters(), logger)
+	if err != nil {
+		return result.Error(err)
+	} else if mgmtApi == nil {
+		logger.Info("Waiting for a datacenter to become ready", "K8ssandraCluster", kcKey)
+		role.Status.SetReady(false, "No datacenter is ready")
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	roleName := role.RoleName()
+	roles, err := mgmtApi.ListRoles()
+	if err != nil {
+		logger.Error(err, "Failed to list roles")
+		return result.Error(err)
+	}
+	role.Status.Exists = utils.SliceContains(roles, roleName)
+
+	definition := &cassandra.RoleDefinition{
+		Name:      roleName,
+		Password:  string(password),
+		Superuser: role.Spec.Superuser,
+		Login:     role.Spec.CanLogin(),
+	}
+
+	if !role.Status.Exists {
+		logger.Info("Creating role", "Role", roleName)
+		if err := mgmtApi.CreateRole(definition); err != nil {
+			logger.Error(err, "Failed to create role", "Role", roleName)
+			role.Status.SetReady(false, fmt.Sprintf("Failed to create role: %v", err))
+			return result.Error(err)
+		}
+		role.Status.Exists = true
+		r.Recorder.Eventf(role, corev1.EventTypeNormal, eventReasonCreatedRole, "Created role %s", roleName)
+	} else if role.Status.ObservedGeneration != role.Generation || role.Status.SecretResourceVersion != roleSecret.ResourceVersion {
+		logger.Info("Updating role", "Role", roleName)
+		if err := mgmtApi.AlterRole(definition); err != nil {
+			logger.Error(err, "Failed to alter role", "Role", roleName)
+			role.Status.SetReady(false, fmt.Sprintf("Failed to alter role: %v", err))
+			return result.Error(err)
+		}
+		r.Recorder.Eventf(role, corev1.EventTypeNormal, eventReasonUpdatedRole, "Updated role %s", roleName)
+	}
+
+	role.Status.ObservedGeneration = role.Generation
+	role.Status.SecretResourceVersion = roleSecret.ResourceVersion
+	role.Status.SetReady(true, "")
+
+	return result.Done()
+}
+
+// checkDeletion drops the role before removing the finalizer. If the K8ssandraCluster is gone or is being deleted,
+// there is nothing left to drop the role from.
+func (r *CassandraRoleReconciler) checkDeletion(ctx context.Context, role *api.CassandraRole, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	if !controllerutil.ContainsFinalizer(role, cassandraRoleFinalizer) {
+		return result.Done()
+	}
+
+	if kc != nil && kc.DeletionTimestamp == nil && kc.Spec.IsAuthEnabled() {
+		mgmtApi, err := newManagementApiForCluster(ctx, r.ClientCache, r.ManagementApi, kc, kc.GetInitializedDatacenters(), logger)
+		if err != nil {
+			return result.Error(err)
+		} else if mgmtApi == nil {
+			logger.Info("Waiting for a datacenter to become ready to drop role")
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+
+		logger.Info("Dropping role", "Role", role.RoleName())
+		if err := mgmtApi.DropRole(role.RoleName()); err != nil {
+			logger.Error(err, "Failed to drop role", "Role", role.RoleName())
+			return result.Error(err)
+		}
+		r.Recorder.Eventf(role, corev1.EventTypeNormal, eventReasonDroppedRole, "Dropped role %s", role.RoleName())
+	}
+
+	patch := client.MergeFrom(role.DeepCopy())
+	controllerutil.RemoveFinalizer(role, cassandraRoleFinalizer)
+	if err := r.Patch(ctx, role, patch); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return result.Error(err)
+	}
+
+	return result.Done()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CassandraRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Password changes need to be applied to the roles using the secret.
+	secretToRoles := func(mapObj client.Object) []reconcile.Request {
+		requests := make([]reconcile.Request, 0)
+
+		list := &api.CassandraRoleList{}
+		if err := r.List(context.Background(), list, client.InNamespace(mapObj.GetNamespace())); err != nil {
+			mgr.GetLogger().Error(err, "Failed to list CassandraRoles", "Secret", client.ObjectKeyFromObject(mapObj))
+			return requests
+		}
+
+		for _, role := range list.Items {
+			if role.Spec.SecretRef.Name == mapObj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: role.Namespace, Name: role.Name}})
+			}
+		}
+		return requests
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&api.CassandraRole{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(secretToRoles),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Complete(r)
+}
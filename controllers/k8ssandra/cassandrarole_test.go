@@ -0,0 +1,121 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/labels"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// createAndDeleteCassandraRole verifies that a CassandraRole gets its secret generated and labelled for replication,
+// that the role is created through the management API, and that it is dropped when the CassandraRole is deleted.
+func createAndDeleteCassandraRole(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{}, nil)
+	mockMgmtApi.On(testutils.GetSchemaVersions).Return(map[string][]string{"fake": {"test"}}, nil)
+	mockMgmtApi.On(testutils.ListRoles).Return([]string{"cassandra"}, nil)
+	mockMgmtApi.On(testutils.CreateRole, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.DropRole, "app").Return(nil)
+
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "role-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.1",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+		},
+	}
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	role := &api.CassandraRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "app",
+		},
+		Spec: api.CassandraRoleSpec{
+			Cluster:   corev1.LocalObjectReference{Name: kc.Name},
+			SecretRef: corev1.LocalObjectReference{Name: "app-role"},
+		},
+	}
+
+	err = f.Client.Create(ctx, role)
+	require.NoError(err, "failed to create CassandraRole")
+
+	t.Log("check that the role secret was created and is replicated")
+	require.Eventually(func() bool {
+		roleSecret := &corev1.Secret{}
+		if err := f.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "app-role"}, roleSecret); err != nil {
+			return false
+		}
+		return labels.IsManagedBy(roleSecret, utils.GetKey(kc)) &&
+			string(roleSecret.Data["username"]) == "app" &&
+			len(roleSecret.Data["password"]) > 0
+	}, timeout, interval, "timed out waiting for role secret")
+
+	dc1Key := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	t.Log("check that dc1 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc1Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc1Key)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	t.Log("check that the role was created")
+	require.Eventually(func() bool {
+		actual := &api.CassandraRole{}
+		if err := f.Client.Get(ctx, utils.GetKey(role), actual); err != nil {
+			return false
+		}
+		return actual.Status.Exists && actual.Status.GetConditionStatus(api.CassandraRoleReady) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraRole status update")
+
+	assert.True(t, mockMgmtApi.GetFirstCall(testutils.CreateRole, mock.MatchedBy(func(def *cassandra.RoleDefinition) bool {
+		return def.Name == "app" && def.Login && !def.Superuser && def.Password != ""
+	})) > -1, "expected role to be created")
+
+	t.Log("delete the CassandraRole and check that the role was dropped")
+	err = f.Client.Delete(ctx, role)
+	require.NoError(err, "failed to delete CassandraRole")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, utils.GetKey(role), &api.CassandraRole{})
+		return errors.IsNotFound(err)
+	}, timeout, interval, "timed out waiting for CassandraRole deletion")
+	assert.True(t, mockMgmtApi.GetFirstCall(testutils.DropRole, "app") > -1, "expected role to be dropped")
+
+	err = f.DeleteK8ssandraCluster(ctx, utils.GetKey(kc))
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
@@ -95,6 +95,10 @@ func (r *K8ssandraClusterReconciler) checkDeletion(ctx context.Context, kc *api.
 		}
 	}
 
+	if r.deleteMigrationDatacenters(ctx, kc, logger) {
+		hasErrors = true
+	}
+
 	if hasErrors {
 		return result.RequeueSoon(r.DefaultDelay)
 	}
@@ -138,44 +142,74 @@ func (r *K8ssandraClusterReconciler) checkDcDeletion(ctx context.Context, kc *ap
 		logger.Info("Preparing to updating replication for DC decommission", "DC", dcName)
 		status.DecommissionProgress = api.DecommUpdatingReplication
 		kc.Status.Datacenters[dcName] = status
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDecommissionStarted, "Decommissioning datacenter %s", dcName)
 		return result.Continue()
 	case api.DecommUpdatingReplication:
 		logger.Info("Waiting for replication updates for DC decommission to complete", "DC", dcName)
 		return result.Continue()
 	default:
 		logger.Info("Proceeding with DC deletion", "DC", dcName)
-		return r.deleteDc(ctx, kc, dcName, logger)
+		return r.deleteDc(ctx, kc, dcName, nil, logger)
 	}
 }
 
-func (r *K8ssandraClusterReconciler) deleteDc(ctx context.Context, kc *api.K8ssandraCluster, dcName string, logger logr.Logger) result.ReconcileResult {
+func (r *K8ssandraClusterReconciler) deleteDc(ctx context.Context, kc *api.K8ssandraCluster, dcName string, remoteClient client.Client, logger logr.Logger) result.ReconcileResult {
+	if recResult := r.deleteDcResources(ctx, kc, dcName, remoteClient, logger); recResult.Completed() {
+		return recResult
+	}
+
+	delete(kc.Status.Datacenters, dcName)
+	deleteDatacenterMetrics(kc, dcName)
+	logger.Info("DC deletion finished", "DC", dcName)
+	r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDecommissionFinished, "Datacenter %s was decommissioned", dcName)
+	return result.Continue()
+}
+
+// deleteDcResources deletes the Stargate, Reaper and CassandraDatacenter objects of the datacenter dcName. They are
+// looked up with remoteClient, or in all the remote Kubernetes clusters if it is nil. The nodes of the datacenter are
+// decommissioned. The result is not completed once they are all gone.
+func (r *K8ssandraClusterReconciler) deleteDcResources(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcName string,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
 	kcKey := utils.GetKey(kc)
 
-	stargate, remoteClient, err := r.findStargateForDeletion(ctx, kcKey, dcName, nil)
+	stargate, stargateClient, err := r.findStargateForDeletion(ctx, kcKey, dcName, remoteClient)
 	if err != nil {
 		return result.Error(err)
 	}
+	if remoteClient == nil {
+		remoteClient = stargateClient
+	}
 
 	if stargate != nil {
-		if err = remoteClient.Delete(ctx, stargate); err != nil && !errors.IsNotFound(err) {
+		if err = stargateClient.Delete(ctx, stargate); err != nil && !errors.IsNotFound(err) {
 			return result.Error(fmt.Errorf("failed to delete Stargate for dc (%s): %v", dcName, err))
 		}
 		logger.Info("Deleted Stargate", "Stargate", utils.GetKey(stargate))
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDeletedStargate, "Deleted Stargate %s of datacenter %s", stargate.Name, dcName)
 	}
 
-	reaper, remoteClient, err := r.findReaperForDeletion(ctx, kcKey, dcName, remoteClient)
+	reaper, reaperClient, err := r.findReaperForDeletion(ctx, kcKey, dcName, remoteClient)
 	if err != nil {
 		return result.Error(err)
 	}
+	if remoteClient == nil {
+		remoteClient = reaperClient
+	}
 
 	if reaper != nil {
-		if err = remoteClient.Delete(ctx, reaper); err != nil && !errors.IsNotFound(err) {
+		if err = reaperClient.Delete(ctx, reaper); err != nil && !errors.IsNotFound(err) {
 			return result.Error(fmt.Errorf("failed to delete Reaper for dc (%s): %v", dcName, err))
 		}
 		logger.Info("Deleted Reaper", "Reaper", utils.GetKey(reaper))
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDeletedReaper, "Deleted Reaper %s of datacenter %s", reaper.Name, dcName)
 	}
 
-	dc, remoteClient, err := r.findDcForDeletion(ctx, kcKey, dcName, remoteClient)
+	dc, dcClient, err := r.findDcForDeletion(ctx, kcKey, dcName, remoteClient)
 	if err != nil {
 		return result.Error(err)
 	}
@@ -190,21 +224,20 @@ func (r *K8ssandraClusterReconciler) deleteDc(ctx context.Context, kc *api.K8ssa
 		if !annotations.HasAnnotationWithValue(dc, cassdcapi.DecommissionOnDeleteAnnotation, "true") {
 			patch := client.MergeFrom(dc.DeepCopy())
 			annotations.AddAnnotation(dc, cassdcapi.DecommissionOnDeleteAnnotation, "true")
-			if err = remoteClient.Patch(ctx, dc, patch); err != nil {
+			if err = dcClient.Patch(ctx, dc, patch); err != nil {
 				return result.Error(fmt.Errorf("failed to add %s annotation to dc: %v", cassdcapi.DecommissionOnDeleteAnnotation, err))
 			}
 		}
 
-		if err = remoteClient.Delete(ctx, dc); err != nil && !errors.IsNotFound(err) {
+		if err = dcClient.Delete(ctx, dc); err != nil && !errors.IsNotFound(err) {
 			return result.Error(fmt.Errorf("failed to delete CassandraDatacenter (%s): %v", dcName, err))
 		}
 		logger.Info("Deleted CassandraDatacenter", "CassandraDatacenter", utils.GetKey(dc))
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDeletedDatacenter, "Deleted CassandraDatacenter %s", dcName)
 		// There is no need to requeue here. Reconciliation will be trigger by updates made by cass-operator.
 		return result.Done()
 	}
 
-	delete(kc.Status.Datacenters, dcName)
-	logger.Info("DC deletion finished", "DC", dcName)
 	return result.Continue()
 }
 
@@ -308,16 +341,14 @@ func (r *K8ssandraClusterReconciler) findDcForDeletion(
 			}
 		}
 	} else {
-		for _, remoteClient := range r.ClientCache.GetRemoteClients() {
-			err := remoteClient.List(ctx, dcList, options)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to find CassandraDatacenter (%s) for deletion: %v", dcName, err)
-			}
+		err := remoteClient.List(ctx, dcList, options)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to find CassandraDatacenter (%s) for deletion: %v", dcName, err)
+		}
 
-			for _, dc := range dcList.Items {
-				if dc.Name == dcName {
-					return &dc, remoteClient, nil
-				}
+		for _, dc := range dcList.Items {
+			if dc.Name == dcName {
+				return &dc, remoteClient, nil
 			}
 		}
 	}
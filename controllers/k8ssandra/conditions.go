@@ -0,0 +1,143 @@
+package k8ssandra
+
+import (
+	"fmt"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Reasons used for the K8ssandraCluster conditions.
+const (
+	reasonReconciled              = "Reconciled"
+	reasonReconciling             = "Reconciling"
+	reasonReconcileFailed         = "ReconcileFailed"
+	reasonCreatingDatacenter      = "CreatingDatacenter"
+	reasonUpdatingDatacenter      = "UpdatingDatacenter"
+	reasonWaitingForDatacenter    = "WaitingForDatacenter"
+	reasonDatacenterNotReady      = "DatacenterNotReady"
+	reasonRebuildingDatacenter    = "RebuildingDatacenter"
+	reasonDecommissioning         = "DecommissioningDatacenter"
+	reasonUpgrading               = "UpgradingDatacenter"
+	reasonUpgradePending          = "UpgradePending"
+	reasonUpgradeFailed           = "UpgradeFailed"
+	reasonReconcilingComponents   = "ReconcilingComponents"
+	reasonReplicationUpdated      = "ReplicationUpdated"
+	reasonUpdatingReplication     = "UpdatingReplication"
+	reasonReplicationFailed       = "ReplicationFailed"
+	reasonSchemaAgreement         = "SchemaAgreement"
+	reasonSchemaDisagreement      = "SchemaDisagreement"
+	reasonSchemaCheckFailed       = "SchemaCheckFailed"
+	reasonRemoteClustersReachable = "RemoteClustersReachable"
+	reasonRemoteClientUnavailable = "RemoteClientUnavailable"
+	reasonRemoteRequestFailed     = "RemoteRequestFailed"
+	reasonWaitingForSecrets       = "WaitingForReplicatedSecrets"
+	reasonPaused                  = "Paused"
+	reasonResumed                 = "Resumed"
+	reasonRotatingCredentials     = "RotatingCredentials"
+	reasonRestartPending          = "RestartPending"
+	reasonRestartingDatacenter    = "RestartingDatacenter"
+	reasonScaleDownBlocked        = "ScaleDownBlocked"
+	reasonMovingDatacenter        = "MovingDatacenter"
+)
+
+// markProgressing records that changes are being rolled out. The cluster is not considered ready until they are.
+func markProgressing(kc *api.K8ssandraCluster, reason, message string) {
+	kc.Status.SetConditionStatus(api.ClusterProgressing, corev1.ConditionTrue, reason, message)
+	kc.Status.SetConditionStatus(api.ClusterReady, corev1.ConditionFalse, reason, message)
+}
+
+// markDegraded records that the cluster, or its reconciliation, is not healthy.
+func markDegraded(kc *api.K8ssandraCluster, reason, message string) {
+	kc.Status.SetConditionStatus(api.ClusterDegraded, corev1.ConditionTrue, reason, message)
+	kc.Status.SetConditionStatus(api.ClusterReady, corev1.ConditionFalse, reason, message)
+}
+
+// markDatacenterNotReady records that reconciliation is waiting for dc to become ready. This is expected while
+// changes are being rolled out. Otherwise, a datacenter that was initialized and is no longer ready means that the
+// cluster is degraded.
+func markDatacenterNotReady(kc *api.K8ssandraCluster, dc *cassdcapi.CassandraDatacenter) {
+	message := fmt.Sprintf("CassandraDatacenter %s is not ready", dc.Name)
+	if kc.Status.ObservedGeneration == kc.Generation &&
+		dc.GetConditionStatus(cassdcapi.DatacenterInitialized) == corev1.ConditionTrue &&
+		dc.GetConditionStatus(cassdcapi.DatacenterUpdating) != corev1.ConditionTrue {
+		markDegraded(kc, reasonDatacenterNotReady, message)
+	} else {
+		markProgressing(kc, reasonWaitingForDatacenter, message)
+	}
+}
+
+// markReconciled records that the current generation of kc has been applied. Failed or pending version upgrades are
+// reported since the cluster does not match its spec until they complete.
+func markReconciled(kc *api.K8ssandraCluster) {
+	kc.Status.ObservedGeneration = kc.Generation
+	if markUpgradeStatus(kc) || markScaleDownStatus(kc) {
+		return
+	}
+	kc.Status.SetConditionStatus(api.ClusterProgressing, corev1.ConditionFalse, reasonReconciled, "")
+	kc.Status.SetConditionStatus(api.ClusterDegraded, corev1.ConditionFalse, reasonReconciled, "")
+	kc.Status.SetConditionStatus(api.ClusterReady, corev1.ConditionTrue, reasonReconciled, "")
+}
+
+// markReconcileFailed records a reconciliation error. Readiness is left untouched since the error may be transient.
+func markReconcileFailed(kc *api.K8ssandraCluster, err error) {
+	kc.Status.SetConditionStatus(api.ClusterDegraded, corev1.ConditionTrue, reasonReconcileFailed, err.Error())
+}
+
+func markSchemaAgreement(kc *api.K8ssandraCluster, agreement bool) {
+	if agreement {
+		kc.Status.SetConditionStatus(api.ClusterSchemaAgreement, corev1.ConditionTrue, reasonSchemaAgreement, "")
+	} else {
+		kc.Status.SetConditionStatus(api.ClusterSchemaAgreement, corev1.ConditionFalse, reasonSchemaDisagreement, "Nodes report different schema versions")
+	}
+}
+
+func markRemoteClusterUnreachable(kc *api.K8ssandraCluster, reason, k8sContext string, err error) {
+	kc.Status.SetConditionStatus(api.ClusterRemoteClusterReachable, corev1.ConditionFalse, reason,
+		fmt.Sprintf("Kubernetes context %s: %v", k8sContext, err))
+}
+
+// markUpgradeStatus reflects the version upgrades that are paused or waiting to start in the cluster conditions. It
+// returns true if such an upgrade was found.
+func markUpgradeStatus(kc *api.K8ssandraCluster) bool {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if upgrade := kc.Status.Datacenters[dcTemplate.Meta.Name].Upgrade; upgrade != nil {
+			message := fmt.Sprintf("Upgrade of datacenter %s to %s: %s", dcTemplate.Meta.Name, upgrade.ToVersion, upgrade.Message)
+			switch upgrade.Progress {
+			case api.UpgradeFailed:
+				kc.Status.SetConditionStatus(api.ClusterProgressing, corev1.ConditionFalse, reasonUpgradeFailed, message)
+				markDegraded(kc, reasonUpgradeFailed, message)
+				return true
+			case api.UpgradePending:
+				markProgressing(kc, reasonUpgradePending, message)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// markScaleDownStatus reflects the datacenter scale-downs that are blocked by the replication of keyspaces in the
+// cluster conditions. It returns true if such a scale-down was found.
+func markScaleDownStatus(kc *api.K8ssandraCluster) bool {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if scaleDown := kc.Status.Datacenters[dcTemplate.Meta.Name].ScaleDownBlocked; scaleDown != nil {
+			message := fmt.Sprintf("Scale-down of datacenter %s from %d to %d nodes: %s", dcTemplate.Meta.Name, scaleDown.FromSize, scaleDown.ToSize, scaleDown.Message)
+			kc.Status.SetConditionStatus(api.ClusterProgressing, corev1.ConditionFalse, reasonScaleDownBlocked, message)
+			markDegraded(kc, reasonScaleDownBlocked, message)
+			return true
+		}
+	}
+	return false
+}
+
+// resultError returns the error that recResult stops the reconciliation with, if any.
+func resultError(recResult result.ReconcileResult) error {
+	if !recResult.Completed() {
+		return nil
+	}
+	_, err := recResult.Output()
+	return err
+}
@@ -0,0 +1,345 @@
+package k8ssandra
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// credentialsSecret is a secret holding credentials that the operator generates for kc.
+type credentialsSecret struct {
+	name string
+
+	// cqlRole is true if the secret holds the credentials of a CQL role, whose password must be altered in Cassandra.
+	// The other secrets hold JMX or Reaper UI credentials.
+	cqlRole bool
+}
+
+// credentialsSecrets returns the secrets holding the credentials of the superuser, Reaper and Medusa. They may be
+// provided by the user, in which case they are not rotated.
+func credentialsSecrets(kc *api.K8ssandraCluster) []credentialsSecret {
+	secrets := []credentialsSecret{{name: kc.Spec.Cassandra.SuperuserSecretRef.Name, cqlRole: true}}
+	if kc.Spec.Reaper != nil {
+		cqlSecretName := kc.Spec.Reaper.CassandraUserSecretRef.Name
+		if cqlSecretName == "" {
+			cqlSecretName = reaper.DefaultUserSecretName(kc.Name)
+		}
+		jmxSecretName := kc.Spec.Reaper.JmxUserSecretRef.Name
+		if jmxSecretName == "" {
+			jmxSecretName = reaper.DefaultJmxUserSecretName(kc.Name)
+		}
+		uiSecretName := kc.Spec.Reaper.UiUserSecretRef.Name
+		if uiSecretName == "" {
+			uiSecretName = reaper.DefaultUiSecretName(kc.Name)
+		}
+		secrets = append(secrets,
+			credentialsSecret{name: cqlSecretName, cqlRole: true},
+			credentialsSecret{name: jmxSecretName},
+			credentialsSecret{name: uiSecretName})
+	}
+	if kc.Spec.Medusa != nil {
+		secrets = append(secrets, credentialsSecret{name: medusa.CassandraUserSecretName(kc.Spec.Medusa, kc.Name), cqlRole: true})
+	}
+	return secrets
+}
+
+// nextCredentialsRotation returns the time at which the next scheduled rotation is due, or nil if there is no rotation
+// schedule.
+func nextCredentialsRotation(kc *api.K8ssandraCluster) *time.Time {
+	if kc.Spec.CredentialsRotation == nil {
+		return nil
+	}
+	last := kc.CreationTimestamp.Time
+	if rotation := kc.Status.CredentialsRotation; rotation != nil && rotation.CompletionTime != nil {
+		last = rotation.CompletionTime.Time
+	}
+	next := last.Add(kc.Spec.CredentialsRotation.Interval.Duration)
+	return &next
+}
+
+// reconcileCredentialsRotation starts a rotation of the credentials generated by the operator when it is requested
+// with the RotateCredentialsAnnotation or when it is due according to the rotation schedule. The new passwords are
+// written to the secrets and applied to the CQL roles, then the operator waits for the ReplicatedSecret to copy the
+// secrets to the Kubernetes clusters of all the datacenters. This is expected to be called once all the datacenters
+// are reconciled.
+func (r *K8ssandraClusterReconciler) reconcileCredentialsRotation(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+
+	if recResult := r.checkCredentialsRotation(ctx, kc, logger); recResult.Completed() {
+		return recResult
+	}
+
+	rotation := kc.Status.CredentialsRotation
+	if rotation == nil {
+		return result.Continue()
+	}
+	switch rotation.Progress {
+	case api.CredentialsRotationRotatingPasswords:
+		return r.rotatePasswords(ctx, kc, logger)
+	case api.CredentialsRotationReplicatingSecrets:
+		return r.checkRotatedSecretsReplicated(ctx, kc, logger)
+	}
+	return result.Continue()
+}
+
+// checkCredentialsRotation starts a new rotation if one is requested and no other rotation is in progress.
+func (r *K8ssandraClusterReconciler) checkCredentialsRotation(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	rotation := kc.Status.CredentialsRotation
+	if rotation != nil && rotation.Progress != api.CredentialsRotationCompleted {
+		// A rotation requested in the meantime starts once this one is completed
+		return result.Continue()
+	}
+
+	requested := annotations.HasAnnotationWithValue(kc, api.RotateCredentialsAnnotation, "true")
+	if !requested {
+		next := nextCredentialsRotation(kc)
+		if next == nil || time.Now().Before(*next) {
+			return result.Continue()
+		}
+	} else {
+		if err := r.removeAnnotation(ctx, kc, api.RotateCredentialsAnnotation); err != nil {
+			return result.Error(err)
+		}
+	}
+
+	if !kc.Spec.IsAuthEnabled() {
+		if requested {
+			logger.Info("Not rotating credentials since authentication is disabled")
+			r.Recorder.Event(kc, corev1.EventTypeWarning, eventReasonCredentialsRotationRejected,
+				"Credentials cannot be rotated while authentication is disabled")
+		}
+		return result.Continue()
+	}
+
+	var secretNames []string
+	for _, credentials := range credentialsSecrets(kc) {
+		sec := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: kc.Namespace, Name: credentials.name}, sec); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			logger.Error(err, "Failed to get credentials secret", "Secret", credentials.name)
+			return result.Error(err)
+		}
+		if secret.IsGenerated(sec, client.ObjectKeyFromObject(kc)) {
+			secretNames = append(secretNames, credentials.name)
+		}
+	}
+
+	now := metav1.Now()
+	revision := int64(1)
+	if rotation != nil {
+		revision = rotation.Revision + 1
+	}
+
+	if len(secretNames) == 0 {
+		logger.Info("No credentials generated by the operator, nothing to rotate")
+		if rotation != nil {
+			revision = rotation.Revision
+		} else {
+			revision = 0
+		}
+		kc.Status.CredentialsRotation = &api.CredentialsRotationStatus{
+			Revision:       revision,
+			Progress:       api.CredentialsRotationCompleted,
+			StartTime:      &now,
+			CompletionTime: &now,
+		}
+		return result.Continue()
+	}
+
+	logger.Info("Starting credentials rotation", "Revision", revision, "Secrets", secretNames)
+	r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonCredentialsRotationStarted,
+		"Rotating the credentials stored in secrets %v", secretNames)
+	kc.Status.CredentialsRotation = &api.CredentialsRotationStatus{
+		Revision:  revision,
+		Progress:  api.CredentialsRotationRotatingPasswords,
+		Secrets:   secretNames,
+		StartTime: &now,
+	}
+	return result.Continue()
+}
+
+// rotatePasswords writes new passwords to the secrets being rotated and alters the matching CQL roles. The password
+// of a secret is only changed once per revision, so that a failure can be retried.
+func (r *K8ssandraClusterReconciler) rotatePasswords(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	rotation := kc.Status.CredentialsRotation.DeepCopy()
+	revision := strconv.FormatInt(rotation.Revision, 10)
+	markProgressing(kc, reasonRotatingCredentials, "Rotating credentials")
+
+	rotated := make(map[string]bool)
+	for _, name := range rotation.Secrets {
+		rotated[name] = true
+	}
+
+	var mgmtApi cassandra.ManagementApiFacade
+	for _, credentials := range credentialsSecrets(kc) {
+		if !rotated[credentials.name] {
+			continue
+		}
+		sec := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: kc.Namespace, Name: credentials.name}, sec); err != nil {
+			logger.Error(err, "Failed to get credentials secret", "Secret", credentials.name)
+			return result.Error(err)
+		}
+		if err := secret.RotatePassword(ctx, r.Client, sec, revision); err != nil {
+			if errors.IsConflict(err) {
+				return result.RequeueSoon(r.DefaultDelay)
+			}
+			logger.Error(err, "Failed to rotate password", "Secret", credentials.name)
+			return result.Error(err)
+		}
+
+		if !credentials.cqlRole {
+			continue
+		}
+		if mgmtApi == nil {
+			var err error
+			if mgmtApi, err = newManagementApiForCluster(ctx, r.ClientCache, r.ManagementApi, kc, kc.Spec.Cassandra.Datacenters, logger); err != nil {
+				return result.Error(err)
+			} else if mgmtApi == nil {
+				logger.Info("Waiting for a datacenter to be ready to alter roles")
+				return result.RequeueSoon(r.DefaultDelay)
+			}
+		}
+		// The roles of the superuser, Reaper and Medusa are all created as superusers by cass-operator
+		role := &cassandra.RoleDefinition{
+			Name:      string(sec.Data["username"]),
+			Password:  string(sec.Data["password"]),
+			Superuser: true,
+			Login:     true,
+		}
+		if err := mgmtApi.AlterRole(role); err != nil {
+			logger.Error(err, "Failed to alter role", "Role", role.Name)
+			return result.Error(err)
+		}
+	}
+
+	logger.Info("Rotated passwords, waiting for the secrets to be replicated", "Revision", rotation.Revision)
+	rotation.Progress = api.CredentialsRotationReplicatingSecrets
+	kc.Status.CredentialsRotation = rotation
+	return result.RequeueSoon(r.DefaultDelay)
+}
+
+// checkRotatedSecretsReplicated waits for the rotated secrets to be copied by the ReplicatedSecret to the namespaces
+// of all the datacenters. The components are restarted once they are.
+func (r *K8ssandraClusterReconciler) checkRotatedSecretsReplicated(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	rotation := kc.Status.CredentialsRotation.DeepCopy()
+	markProgressing(kc, reasonRotatingCredentials, "Waiting for the rotated credentials to be replicated")
+
+	for _, name := range rotation.Secrets {
+		sec := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: kc.Namespace, Name: name}, sec); err != nil {
+			logger.Error(err, "Failed to get credentials secret", "Secret", name)
+			return result.Error(err)
+		}
+
+		for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+			namespace := dcTemplate.Meta.Namespace
+			if namespace == "" {
+				namespace = kc.Namespace
+			}
+			if dcTemplate.K8sContext == "" && namespace == kc.Namespace {
+				continue
+			}
+			remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+			if err != nil {
+				logger.Error(err, "Failed to get remote client")
+				return result.Error(err)
+			}
+			replica := &corev1.Secret{}
+			if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, replica); err != nil {
+				if !errors.IsNotFound(err) {
+					logger.Error(err, "Failed to get replicated secret", "Secret", name, "K8sContext", dcTemplate.K8sContext)
+					return result.Error(err)
+				}
+			} else if bytes.Equal(replica.Data["password"], sec.Data["password"]) {
+				continue
+			}
+			logger.Info("Waiting for rotated secret to be replicated", "Secret", name, "K8sContext", dcTemplate.K8sContext)
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+	}
+
+	logger.Info("Rotated secrets are replicated, restarting components", "Revision", rotation.Revision)
+	rotation.Progress = api.CredentialsRotationRollingComponents
+	kc.Status.CredentialsRotation = rotation
+	return result.RequeueSoon(r.DefaultDelay)
+}
+
+// checkCredentialsRotationCompleted completes the rotation in progress once the Cassandra pods were restarted. This
+// is expected to be called once Stargate and Reaper are reconciled and ready.
+func (r *K8ssandraClusterReconciler) checkCredentialsRotationCompleted(
+	kc *api.K8ssandraCluster,
+	dcs []*cassdcapi.CassandraDatacenter,
+	logger logr.Logger) result.ReconcileResult {
+
+	rotation := kc.Status.CredentialsRotation
+	if rotation == nil || rotation.Progress != api.CredentialsRotationRollingComponents {
+		return result.Continue()
+	}
+
+	for _, dc := range dcs {
+		if dc.Spec.Stopped {
+			continue
+		}
+		if rotation.StartTime != nil && !cassandra.DatacenterUpdatedAfter(rotation.StartTime.Time, dc) {
+			logger.Info("Waiting for datacenter pods to be restarted with the rotated credentials", "CassandraDatacenter", dc.Name)
+			markProgressing(kc, reasonRotatingCredentials, fmt.Sprintf("Restarting CassandraDatacenter %s with the rotated credentials", dc.Name))
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+	}
+
+	logger.Info("Credentials rotation completed", "Revision", rotation.Revision)
+	rotation = rotation.DeepCopy()
+	now := metav1.Now()
+	rotation.Progress = api.CredentialsRotationCompleted
+	rotation.CompletionTime = &now
+	kc.Status.CredentialsRotation = rotation
+	r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonCredentialsRotationCompleted,
+		"Rotated the credentials stored in secrets %v", rotation.Secrets)
+	return result.Continue()
+}
+
+// scheduledRequeue returns the result of a reconciliation that completed, so that it is requeued when the next
+// credentials rotation is due, or right away if a rotation was requested while another one was in progress. Running
+// and pending datacenter cleanups are checked again in the same way, as well as the operations that wait for a
+// maintenance window and the backups that expire.
+func (r *K8ssandraClusterReconciler) scheduledRequeue(kc *api.K8ssandraCluster) result.ReconcileResult {
+	if annotations.HasAnnotationWithValue(kc, api.RotateCredentialsAnnotation, "true") && kc.Spec.IsAuthEnabled() {
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+	next := nextCredentialsRotation(kc)
+	if nextCleanup := nextCleanupCheck(kc, r.DefaultDelay); nextCleanup != nil && (next == nil || nextCleanup.Before(*next)) {
+		next = nextCleanup
+	}
+	if nextWindow := nextMaintenanceWindow(kc); nextWindow != nil && (next == nil || nextWindow.Before(*next)) {
+		next = nextWindow
+	}
+	if nextExpiry := nextBackupExpiry(kc); nextExpiry != nil && (next == nil || nextExpiry.Before(*next)) {
+		next = nextExpiry
+	}
+	if next != nil {
+		delay := time.Until(*next)
+		if delay < r.DefaultDelay {
+			delay = r.DefaultDelay
+		}
+		return result.RequeueSoon(delay)
+	}
+	return result.Done()
+}
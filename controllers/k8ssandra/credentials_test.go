@@ -0,0 +1,164 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/secret"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCredentialsSecrets(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				SuperuserSecretRef: corev1.LocalObjectReference{Name: "test-superuser"},
+			},
+		},
+	}
+	assert.Equal(t, []credentialsSecret{{name: "test-superuser", cqlRole: true}}, credentialsSecrets(kc))
+
+	kc.Spec.Reaper = &reaperapi.ReaperClusterTemplate{}
+	kc.Spec.Reaper.UiUserSecretRef = corev1.LocalObjectReference{Name: "reaper-ui"}
+	kc.Spec.Medusa = &medusaapi.MedusaClusterTemplate{}
+	assert.Equal(t, []credentialsSecret{
+		{name: "test-superuser", cqlRole: true},
+		{name: reaper.DefaultUserSecretName("test"), cqlRole: true},
+		{name: reaper.DefaultJmxUserSecretName("test")},
+		{name: "reaper-ui"},
+		{name: medusa.CassandraUserSecretName(kc.Spec.Medusa, "test"), cqlRole: true},
+	}, credentialsSecrets(kc))
+}
+
+func TestNextCredentialsRotation(t *testing.T) {
+	created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	kc := &api.K8ssandraCluster{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}}
+	assert.Nil(t, nextCredentialsRotation(kc))
+
+	kc.Spec.CredentialsRotation = &api.CredentialsRotationSpec{Interval: metav1.Duration{Duration: 24 * time.Hour}}
+	assert.Equal(t, created.Add(24*time.Hour), *nextCredentialsRotation(kc))
+
+	completed := metav1.NewTime(created.Add(36 * time.Hour))
+	kc.Status.CredentialsRotation = &api.CredentialsRotationStatus{Revision: 1, Progress: api.CredentialsRotationCompleted, CompletionTime: &completed}
+	assert.Equal(t, created.Add(60*time.Hour), *nextCredentialsRotation(kc))
+}
+
+// rotateCredentials verifies that a credentials rotation requested with the annotation changes the superuser password
+// in the secret and in Cassandra, then restarts the datacenter.
+func rotateCredentials(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "rotation-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx1, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+	superuserKey := client.ObjectKey{Namespace: namespace, Name: secret.DefaultSuperuserSecretName(kc.Name)}
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{}, nil)
+	mockMgmtApi.On(testutils.AlterRole, mock.Anything).Return(nil)
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dcKey := framework.NewClusterKey(k8sCtx1, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, dcKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+
+	superuser := &corev1.Secret{}
+	err = f.Client.Get(ctx, superuserKey, superuser)
+	require.NoError(err, "failed to get superuser secret")
+	initialPassword := string(superuser.Data["password"])
+
+	t.Log("request a credentials rotation")
+	patch := client.MergeFrom(kc.DeepCopy())
+	metav1.SetMetaDataAnnotation(&kc.ObjectMeta, api.RotateCredentialsAnnotation, "true")
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	t.Log("check that the superuser password is rotated")
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, superuserKey, superuser)
+		return err == nil && string(superuser.Data["password"]) != initialPassword
+	}, timeout, interval, "timed out waiting for the superuser password to be rotated")
+	assert.Equal(t, "1", superuser.Annotations[api.CredentialsRevisionAnnotation])
+
+	t.Log("copy the rotated secret to the datacenter cluster")
+	replica := &corev1.Secret{}
+	require.Eventually(func() bool {
+		return f.Get(ctx, framework.NewClusterKey(k8sCtx1, namespace, superuserKey.Name), replica) == nil
+	}, timeout, interval, "failed to get the replicated superuser secret")
+	replica.Data = superuser.Data
+	err = f.Update(ctx, framework.NewClusterKey(k8sCtx1, namespace, superuserKey.Name), replica)
+	require.NoError(err, "failed to update the replicated superuser secret")
+
+	t.Log("check that the datacenter is restarted")
+	require.Eventually(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.PodTemplateSpec != nil && dc.Spec.PodTemplateSpec.Annotations[api.CredentialsRevisionAnnotation] == "1"
+	}), timeout, interval, "timed out waiting for the datacenter pod template to be updated")
+	mockMgmtApi.AssertCalled(t, testutils.AlterRole, mock.MatchedBy(func(role *cassandra.RoleDefinition) bool {
+		return role.Name == string(superuser.Data["username"]) && role.Password == string(superuser.Data["password"])
+	}))
+
+	setDatacenterUpdated(ctx, t, f, dcKey)
+
+	t.Log("check that the rotation is completed")
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		rotation := kc.Status.CredentialsRotation
+		return rotation != nil && rotation.Revision == 1 && rotation.Progress == api.CredentialsRotationCompleted
+	}, timeout, interval, "timed out waiting for the credentials rotation to complete")
+	assert.NotContains(t, kc.Annotations, api.RotateCredentialsAnnotation)
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
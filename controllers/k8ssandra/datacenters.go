@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
@@ -22,10 +23,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (r *K8ssandraClusterReconciler) reconcileDatacenters(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) (result.ReconcileResult, []*cassdcapi.CassandraDatacenter) {
-	kcKey := utils.GetKey(kc)
+// datacenterReconciliation holds the desired state of a CassandraDatacenter along with what is needed to apply it.
+type datacenterReconciliation struct {
+	template     api.CassandraDatacenterTemplate
+	config       *cassandra.DatacenterConfig
+	desiredDc    *cassdcapi.CassandraDatacenter
+	remoteClient client.Client
+	logger       logr.Logger
+}
 
+func (r *K8ssandraClusterReconciler) reconcileDatacenters(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) (result.ReconcileResult, []*cassdcapi.CassandraDatacenter) {
 	if recResult := r.checkDcDeletion(ctx, kc, logger); recResult.Completed() {
+		if resultError(recResult) == nil {
+			markProgressing(kc, reasonDecommissioning, "Decommissioning datacenter")
+		}
 		return recResult, nil
 	}
 
@@ -35,6 +46,10 @@ func (r *K8ssandraClusterReconciler) reconcileDatacenters(ctx context.Context, k
 		return result.Error(err), nil
 	}
 
+	if recResult := r.reconcileDatacenterMigration(ctx, kc, systemReplication, logger); recResult.Completed() {
+		return recResult, nil
+	}
+
 	actualDcs := make([]*cassdcapi.CassandraDatacenter, 0, len(kc.Spec.Cassandra.Datacenters))
 
 	seeds, err := r.findSeeds(ctx, kc, logger)
@@ -43,168 +58,347 @@ func (r *K8ssandraClusterReconciler) reconcileDatacenters(ctx context.Context, k
 		return result.Error(err), actualDcs
 	}
 
+	var deployedDcs []*cassdcapi.CassandraDatacenter
+	if kc.Spec.Cassandra.ParallelDatacenterReconciliation {
+		var recResult result.ReconcileResult
+		if recResult, deployedDcs = r.reconcileDeployedDatacenters(ctx, kc, seeds, systemReplication, logger); recResult.Completed() {
+			return recResult, actualDcs
+		}
+	} else {
+		clearDatacenterReconciliation(kc)
+	}
+
 	// Reconcile CassandraDatacenter objects only
-	for idx, dcTemplate := range kc.Spec.Cassandra.Datacenters {
-		if !secret.HasReplicatedSecrets(ctx, r.Client, kcKey, dcTemplate.K8sContext) {
-			// ReplicatedSecret has not replicated yet, wait until it has
-			logger.Info("Waiting for replication to complete")
-			return result.RequeueSoon(r.DefaultDelay), actualDcs
+	for idx := range kc.Spec.Cassandra.Datacenters {
+		if deployedDcs != nil && deployedDcs[idx] != nil {
+			actualDcs = append(actualDcs, deployedDcs[idx])
+			continue
 		}
 
-		// Note that it is necessary to use a copy of the CassandraClusterTemplate because
-		// its fields are pointers, and without the copy we could end of with shared
-		// references that would lead to unexpected and incorrect values.
-		dcConfig := cassandra.Coalesce(kc.Name, kc.Spec.Cassandra.DeepCopy(), dcTemplate.DeepCopy())
-		cassandra.ApplyAuth(dcConfig, kc.Spec.IsAuthEnabled())
+		recResult, actualDc := r.reconcileDatacenter(ctx, kc, idx, seeds, systemReplication, logger)
+		if recResult.Completed() {
+			return recResult, actualDcs
+		}
+		actualDcs = append(actualDcs, actualDc)
+	}
 
-		// This is only really required when auth is enabled, but it doesn't hurt to apply system replication on
-		// unauthenticated clusters.
-		cassandra.ApplySystemReplication(dcConfig, *systemReplication)
+	kc.Status.SetConditionStatus(api.ClusterRemoteClusterReachable, corev1.ConditionTrue, reasonRemoteClustersReachable, "")
 
-		if !cassandra.IsCassandra3(dcConfig.ServerVersion) && kc.HasStargates() {
-			// if we're not running Cassandra 3.11 and have Stargate pods, we need to allow alter RF during range movements
-			cassandra.AllowAlterRfDuringRangeMovement(dcConfig)
-		}
-		if kc.Spec.Reaper != nil {
-			reaper.AddReaperSettingsToDcConfig(kc.Spec.Reaper.DeepCopy(), dcConfig, kc.Spec.IsAuthEnabled())
-		}
-		// Create Medusa related objects
-		if medusaResult := r.ReconcileMedusa(ctx, dcConfig, dcTemplate, kc, logger); medusaResult.Completed() {
-			return medusaResult, actualDcs
-		}
+	if recResult := r.checkReplicationReady(ctx, kc, actualDcs, logger); recResult.Completed() {
+		return recResult, actualDcs
+	}
 
-		remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
-		if err != nil {
-			logger.Error(err, "Failed to get remote client")
-			return result.Error(err), actualDcs
-		}
+	// If we reach this point all CassandraDatacenters are ready. We only set the
+	// CassandraInitialized condition if it is unset, i.e., only once. This allows us to
+	// distinguish whether we are deploying a CassandraDatacenter as part of a new cluster
+	// or as part of an existing cluster.
+	if kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionUnknown {
+		now := metav1.Now()
+		kc.Status.SetCondition(api.K8ssandraClusterCondition{
+			Type:               api.CassandraInitialized,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: &now,
+		})
+	}
 
-		err = cassandra.ReadEncryptionStoresSecrets(ctx, kcKey, dcConfig, remoteClient, logger)
-		if err != nil {
-			logger.Error(err, "Failed to read encryption secrets")
-			return result.Error(err), actualDcs
-		}
-		desiredDc, err := cassandra.NewDatacenter(kcKey, dcConfig)
-		if err != nil {
-			logger.Error(err, "Failed to create new CassandraDatacenter")
-			return result.Error(err), actualDcs
-		}
-		if idx > 0 {
-			desiredDc.Annotations[cassdcapi.SkipUserCreationAnnotation] = "true"
-		}
+	return result.Continue(), actualDcs
+}
 
-		// Note: desiredDc should not be modified from now on
-		annotations.AddHashAnnotation(desiredDc)
+// reconcileDatacenter reconciles the idx-th datacenter of kc. The CassandraDatacenter is returned once it is ready and
+// its keyspaces, rebuild and upgrade are reconciled.
+func (r *K8ssandraClusterReconciler) reconcileDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	idx int,
+	seeds []corev1.Pod,
+	systemReplication *cassandra.SystemReplication,
+	logger logr.Logger) (result.ReconcileResult, *cassdcapi.CassandraDatacenter) {
 
-		dcKey := types.NamespacedName{Namespace: desiredDc.Namespace, Name: desiredDc.Name}
-		logger := logger.WithValues("CassandraDatacenter", dcKey, "K8SContext", dcTemplate.K8sContext)
+	dcRec, recResult := r.prepareDatacenter(ctx, kc, idx, systemReplication, logger)
+	if recResult.Completed() {
+		return recResult, nil
+	}
 
-		if recResult := r.checkRebuildAnnotation(ctx, kc, dcKey.Name); recResult.Completed() {
-			return recResult, actualDcs
-		}
+	recResult, actualDc := r.applyDatacenter(ctx, kc, dcRec, seeds)
+	if recResult.Completed() {
+		return recResult, nil
+	}
 
-		actualDc := &cassdcapi.CassandraDatacenter{}
+	if recResult := r.reconcileReadyDatacenter(ctx, kc, actualDc, dcRec.remoteClient, dcRec.logger); recResult.Completed() {
+		return recResult, nil
+	}
+	return result.Continue(), actualDc
+}
 
-		if recResult := r.reconcileSeedsEndpoints(ctx, desiredDc, seeds, dcConfig.AdditionalSeeds, remoteClient, logger); recResult.Completed() {
-			return recResult, actualDcs
+// prepareDatacenter computes the desired CassandraDatacenter for the idx-th datacenter of kc. This includes deciding
+// whether a version upgrade can start, which is why it must not run concurrently for several datacenters.
+func (r *K8ssandraClusterReconciler) prepareDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	idx int,
+	systemReplication *cassandra.SystemReplication,
+	logger logr.Logger) (*datacenterReconciliation, result.ReconcileResult) {
+
+	kcKey := utils.GetKey(kc)
+	dcTemplate := kc.Spec.Cassandra.Datacenters[idx]
+
+	if !secret.HasReplicatedSecrets(ctx, r.Client, kcKey, dcTemplate.K8sContext) {
+		// ReplicatedSecret has not replicated yet, wait until it has
+		logger.Info("Waiting for replication to complete")
+		markProgressing(kc, reasonWaitingForSecrets, fmt.Sprintf("Waiting for secrets to be replicated for CassandraDatacenter %s", dcTemplate.Meta.Name))
+		return nil, result.RequeueSoon(r.DefaultDelay)
+	}
+
+	// Note that it is necessary to use a copy of the CassandraClusterTemplate because
+	// its fields are pointers, and without the copy we could end of with shared
+	// references that would lead to unexpected and incorrect values.
+	dcConfig := cassandra.Coalesce(kc.Name, kc.Spec.Cassandra.DeepCopy(), dcTemplate.DeepCopy())
+
+	remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+	if err != nil {
+		logger.Error(err, "Failed to get remote client")
+		markRemoteClusterUnreachable(kc, reasonRemoteClientUnavailable, dcTemplate.K8sContext, err)
+		return nil, result.Error(err)
+	}
+
+	// Version changes are rolled out one DC at a time, this must be checked before anything depends on the
+	// version in dcConfig.
+	if recResult := r.checkVersionUpgrade(ctx, kc, dcConfig, remoteClient, logger); recResult.Completed() {
+		return nil, recResult
+	}
+
+	if recResult := r.checkScaleDown(ctx, kc, dcConfig, remoteClient, logger); recResult.Completed() {
+		return nil, recResult
+	}
+
+	if medusaResult := r.ReconcileMedusa(ctx, dcTemplate, kc, logger); medusaResult.Completed() {
+		return nil, medusaResult
+	}
+
+	desiredDc, err := r.newDesiredDatacenter(ctx, kc, idx, dcConfig, systemReplication, remoteClient, logger)
+	if err != nil {
+		return nil, result.Error(err)
+	}
+
+	dcKey := types.NamespacedName{Namespace: desiredDc.Namespace, Name: desiredDc.Name}
+	logger = logger.WithValues("CassandraDatacenter", dcKey, "K8SContext", dcTemplate.K8sContext)
+
+	checkRebuild(kc, dcKey.Name)
+
+	return &datacenterReconciliation{
+		template:     dcTemplate,
+		config:       dcConfig,
+		desiredDc:    desiredDc,
+		remoteClient: remoteClient,
+		logger:       logger,
+	}, result.Continue()
+}
+
+// newDesiredDatacenter computes the CassandraDatacenter for the idx-th datacenter of kc from dcConfig. It does not
+// create or update anything, which allows using it to compute plans.
+func (r *K8ssandraClusterReconciler) newDesiredDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	idx int,
+	dcConfig *cassandra.DatacenterConfig,
+	systemReplication *cassandra.SystemReplication,
+	remoteClient client.Client,
+	logger logr.Logger) (*cassdcapi.CassandraDatacenter, error) {
+
+	cassandra.ApplyAuth(dcConfig, kc.Spec.IsAuthEnabled())
+
+	// This is only really required when auth is enabled, but it doesn't hurt to apply system replication on
+	// unauthenticated clusters.
+	cassandra.ApplySystemReplication(dcConfig, *systemReplication)
+
+	if !cassandra.IsCassandra3(dcConfig.ServerVersion) && kc.HasStargates() {
+		// if we're not running Cassandra 3.11 and have Stargate pods, we need to allow alter RF during range movements
+		cassandra.AllowAlterRfDuringRangeMovement(dcConfig)
+	}
+	if kc.Spec.Reaper != nil {
+		reaper.AddReaperSettingsToDcConfig(kc.Spec.Reaper.DeepCopy(), dcConfig, kc.Spec.IsAuthEnabled())
+	}
+	applyMedusaSettings(dcConfig, kc, logger)
+
+	// Restarting the pods after a credentials rotation makes the JMX init container and the Medusa container pick up
+	// the new credentials.
+	if revision := kc.CredentialsRevision(); revision != "" {
+		if dcConfig.PodTemplateSpec == nil {
+			dcConfig.PodTemplateSpec = &corev1.PodTemplateSpec{}
 		}
+		annotations.AddAnnotation(dcConfig.PodTemplateSpec, api.CredentialsRevisionAnnotation, revision)
+	}
+	// A rolling restart changes the revision of the datacenter, which makes cass-operator restart its pods one rack at
+	// a time.
+	if revision := kc.RestartRevision(dcConfig.Meta.Name); revision != "" {
+		if dcConfig.PodTemplateSpec == nil {
+			dcConfig.PodTemplateSpec = &corev1.PodTemplateSpec{}
+		}
+		annotations.AddAnnotation(dcConfig.PodTemplateSpec, api.RestartRevisionAnnotation, revision)
+	}
 
-		if err = remoteClient.Get(ctx, dcKey, actualDc); err == nil {
-			r.setStatusForDatacenter(kc, actualDc)
+	err := cassandra.ReadEncryptionStoresSecrets(ctx, utils.GetKey(kc), dcConfig, remoteClient, logger)
+	if err != nil {
+		logger.Error(err, "Failed to read encryption secrets")
+		return nil, err
+	}
+	desiredDc, err := cassandra.NewDatacenter(utils.GetKey(kc), dcConfig)
+	if err != nil {
+		logger.Error(err, "Failed to create new CassandraDatacenter")
+		return nil, err
+	}
+	if idx > 0 {
+		desiredDc.Annotations[cassdcapi.SkipUserCreationAnnotation] = "true"
+	}
 
-			if !annotations.CompareHashAnnotations(actualDc, desiredDc) {
-				logger.Info("Updating datacenter")
+	// Note: desiredDc should not be modified from now on
+	annotations.AddHashAnnotation(desiredDc)
+	return desiredDc, nil
+}
 
-				if actualDc.Spec.SuperuserSecretName != desiredDc.Spec.SuperuserSecretName {
-					// If actualDc is created with SuperuserSecretName, it can't be changed anymore. We should reject all changes coming from K8ssandraCluster
-					desiredDc.Spec.SuperuserSecretName = actualDc.Spec.SuperuserSecretName
-					err = fmt.Errorf("tried to update superuserSecretName in K8ssandraCluster")
-					logger.Error(err, "SuperuserSecretName is immutable, reverting to existing value in CassandraDatacenter")
-				}
+// applyDatacenter creates or updates the CassandraDatacenter, and returns it once it is ready. It only modifies the
+// status of kc, and the status entry of the datacenter, which allows running it concurrently on copies of kc.
+func (r *K8ssandraClusterReconciler) applyDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcRec *datacenterReconciliation,
+	seeds []corev1.Pod) (result.ReconcileResult, *cassdcapi.CassandraDatacenter) {
 
-				if annotations.HasAnnotationWithValue(kc, api.RebuildDcAnnotation, dcKey.Name) && desiredDc.Spec.Stopped {
-					desiredDc.Spec.Stopped = false
-					err = fmt.Errorf("tried to stop a datacenter that is being rebuilt")
-					logger.Error(err, "Stopped cannot be set to true until the CassandraDatacenter is fully rebuilt")
-				}
+	desiredDc := dcRec.desiredDc
+	remoteClient := dcRec.remoteClient
+	logger := dcRec.logger
+	dcKey := types.NamespacedName{Namespace: desiredDc.Namespace, Name: desiredDc.Name}
 
-				if err := cassandra.ValidateConfig(desiredDc, actualDc); err != nil {
-					return result.Error(fmt.Errorf("invalid Cassandra config: %v", err)), actualDcs
-				}
+	actualDc := &cassdcapi.CassandraDatacenter{}
 
-				actualDc = actualDc.DeepCopy()
-				resourceVersion := actualDc.GetResourceVersion()
-				desiredDc.DeepCopyInto(actualDc)
-				actualDc.SetResourceVersion(resourceVersion)
-				if err = remoteClient.Update(ctx, actualDc); err != nil {
-					logger.Error(err, "Failed to update datacenter")
-					return result.Error(err), actualDcs
-				}
-			}
+	if recResult := r.reconcileSeedsEndpoints(ctx, desiredDc, seeds, dcRec.config.AdditionalSeeds, remoteClient, logger); recResult.Completed() {
+		return recResult, nil
+	}
 
-			if actualDc.Spec.Stopped {
-				if !cassandra.DatacenterStopped(actualDc) {
-					logger.Info("Waiting for datacenter to satisfy Stopped condition")
-					return result.Done(), actualDcs
-				}
-			} else {
-				if !cassandra.DatacenterReady(actualDc) {
-					logger.Info("Waiting for datacenter to satisfy Ready condition")
-					return result.Done(), actualDcs
-				}
+	err := remoteClient.Get(ctx, dcKey, actualDc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			if kc.IsRebuilding(dcKey.Name) && desiredDc.Spec.Stopped {
+				err := fmt.Errorf("cannot add a datacenter in stopped state to an existing cluster")
+				return result.Error(err), nil
+			}
+			// cassdc doesn't exist, we'll create it
+			if err = remoteClient.Create(ctx, desiredDc); err != nil {
+				logger.Error(err, "Failed to create datacenter")
+				return result.Error(err), nil
 			}
+			markProgressing(kc, reasonCreatingDatacenter, fmt.Sprintf("Creating CassandraDatacenter %s", dcKey.Name))
+			r.recordDatacenterEvent(kc, desiredDc, corev1.EventTypeNormal, eventReasonCreatedDatacenter, "Created CassandraDatacenter %s", dcKey.Name)
+			return result.RequeueSoon(r.DefaultDelay), nil
+		}
+		logger.Error(err, "Failed to get datacenter")
+		markRemoteClusterUnreachable(kc, reasonRemoteRequestFailed, dcRec.template.K8sContext, err)
+		return result.Error(err), nil
+	}
 
-			logger.Info("The datacenter is reconciled")
+	r.setStatusForDatacenter(kc, actualDc)
 
-			actualDcs = append(actualDcs, actualDc)
+	if annotations.CompareHashAnnotations(actualDc, desiredDc) {
+		releaseMaintenance(kc, dcKey.Name, api.MaintenanceConfigChange)
+	} else if !r.holdDatacenterUpdate(kc, actualDc, desiredDc, logger) {
+		logger.Info("Updating datacenter")
 
-			if !actualDc.Spec.Stopped {
+		if actualDc.Spec.SuperuserSecretName != desiredDc.Spec.SuperuserSecretName {
+			// If actualDc is created with SuperuserSecretName, it can't be changed anymore. We should reject all changes coming from K8ssandraCluster
+			desiredDc.Spec.SuperuserSecretName = actualDc.Spec.SuperuserSecretName
+			err = fmt.Errorf("tried to update superuserSecretName in K8ssandraCluster")
+			logger.Error(err, "SuperuserSecretName is immutable, reverting to existing value in CassandraDatacenter")
+			r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeWarning, eventReasonSuperuserSecretImmutable,
+				"Rejected the change of the superuser secret of CassandraDatacenter %s, it cannot be changed once the datacenter is created", dcKey.Name)
+		}
 
-				if recResult := r.checkSchemas(ctx, kc, actualDc, remoteClient, logger); recResult.Completed() {
-					return recResult, actualDcs
-				}
+		if kc.IsRebuilding(dcKey.Name) && desiredDc.Spec.Stopped {
+			desiredDc.Spec.Stopped = false
+			err = fmt.Errorf("tried to stop a datacenter that is being rebuilt")
+			logger.Error(err, "Stopped cannot be set to true until the CassandraDatacenter is fully rebuilt")
+			r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeWarning, eventReasonStopRejected,
+				"CassandraDatacenter %s cannot be stopped until it is fully rebuilt", dcKey.Name)
+		}
 
-				if annotations.HasAnnotationWithValue(kc, api.RebuildDcAnnotation, dcKey.Name) {
-					if recResult := r.reconcileDcRebuild(ctx, kc, actualDc, remoteClient, logger); recResult.Completed() {
-						return recResult, actualDcs
-					}
-				}
+		if err := cassandra.ValidateConfig(desiredDc, actualDc); err != nil {
+			return result.Error(fmt.Errorf("invalid Cassandra config: %v", err)), nil
+		}
 
-			}
+		// A pending request to replace nodes, see reconcileNodeReplacement, is not part of the desired state
+		desiredDc.Spec.ReplaceNodes = actualDc.Spec.ReplaceNodes
+
+		actualDc = actualDc.DeepCopy()
+		resourceVersion := actualDc.GetResourceVersion()
+		desiredDc.DeepCopyInto(actualDc)
+		actualDc.SetResourceVersion(resourceVersion)
+		if err = remoteClient.Update(ctx, actualDc); err != nil {
+			logger.Error(err, "Failed to update datacenter")
+			return result.Error(err), nil
+		}
+		markProgressing(kc, reasonUpdatingDatacenter, fmt.Sprintf("Updating CassandraDatacenter %s", dcKey.Name))
+		r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeNormal, eventReasonUpdatedDatacenter, "Updated CassandraDatacenter %s", dcKey.Name)
+	}
+
+	if actualDc.Spec.Stopped {
+		if !cassandra.DatacenterStopped(actualDc) {
+			logger.Info("Waiting for datacenter to satisfy Stopped condition")
+			markProgressing(kc, reasonWaitingForDatacenter, fmt.Sprintf("Waiting for CassandraDatacenter %s to stop", dcKey.Name))
+			return result.Done(), nil
+		}
+	} else {
+		if !cassandra.DatacenterReady(actualDc) {
+			logger.Info("Waiting for datacenter to satisfy Ready condition")
+			markDatacenterNotReady(kc, actualDc)
+			return result.Done(), nil
+		}
+	}
+
+	logger.Info("The datacenter is reconciled")
 
+	return result.Continue(), actualDc
+}
+
+// reconcileReadyDatacenter updates the replication of keyspaces for dc, and drives its rebuild and version upgrade.
+// These operations affect the whole cluster, they are performed for one datacenter at a time. The cleanup of dc after
+// a scale-up is tracked here as well, but the other datacenters do not wait for it.
+func (r *K8ssandraClusterReconciler) reconcileReadyDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	if dc.Spec.Stopped {
+		return result.Continue()
+	}
+
+	if recResult := r.checkSchemas(ctx, kc, dc, remoteClient, logger); recResult.Completed() {
+		if err := resultError(recResult); err != nil {
+			kc.Status.SetConditionStatus(api.ClusterReplicationReady, corev1.ConditionFalse, reasonReplicationFailed, err.Error())
 		} else {
-			if errors.IsNotFound(err) {
-				if annotations.HasAnnotationWithValue(kc, api.RebuildDcAnnotation, dcKey.Name) && desiredDc.Spec.Stopped {
-					err := fmt.Errorf("cannot add a datacenter in stopped state to an existing cluster")
-					return result.Error(err), actualDcs
-				}
-				// cassdc doesn't exist, we'll create it
-				if err = remoteClient.Create(ctx, desiredDc); err != nil {
-					logger.Error(err, "Failed to create datacenter")
-					return result.Error(err), actualDcs
-				}
-				return result.RequeueSoon(r.DefaultDelay), actualDcs
-			} else {
-				logger.Error(err, "Failed to get datacenter")
-				return result.Error(err), actualDcs
+			kc.Status.SetConditionStatus(api.ClusterReplicationReady, corev1.ConditionFalse, reasonUpdatingReplication, "")
+			markProgressing(kc, reasonUpdatingReplication, fmt.Sprintf("Updating replication for CassandraDatacenter %s", dc.Name))
+		}
+		return recResult
+	}
+
+	if kc.IsRebuilding(dc.Name) {
+		if recResult := r.reconcileDcRebuild(ctx, kc, dc, remoteClient, logger); recResult.Completed() {
+			if resultError(recResult) == nil {
+				markProgressing(kc, reasonRebuildingDatacenter, fmt.Sprintf("Rebuilding CassandraDatacenter %s", dc.Name))
 			}
+			return recResult
 		}
 	}
 
-	// If we reach this point all CassandraDatacenters are ready. We only set the
-	// CassandraInitialized condition if it is unset, i.e., only once. This allows us to
-	// distinguish whether we are deploying a CassandraDatacenter as part of a new cluster
-	// or as part of an existing cluster.
-	if kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionUnknown {
-		now := metav1.Now()
-		kc.Status.SetCondition(api.K8ssandraClusterCondition{
-			Type:               api.CassandraInitialized,
-			Status:             corev1.ConditionTrue,
-			LastTransitionTime: &now,
-		})
+	if recResult := r.reconcileDcUpgrade(ctx, kc, dc, remoteClient, logger); recResult.Completed() {
+		if resultError(recResult) == nil {
+			markProgressing(kc, reasonUpgrading, fmt.Sprintf("Upgrading CassandraDatacenter %s", dc.Name))
+		}
+		return recResult
 	}
 
-	return result.Continue(), actualDcs
+	return r.reconcileDcCleanup(ctx, kc, dc, remoteClient, logger)
 }
 
 func (r *K8ssandraClusterReconciler) setStatusForDatacenter(kc *api.K8ssandraCluster, dc *cassdcapi.CassandraDatacenter) {
@@ -214,12 +408,11 @@ func (r *K8ssandraClusterReconciler) setStatusForDatacenter(kc *api.K8ssandraClu
 
 	kdcStatus, found := kc.Status.Datacenters[dc.Name]
 
-	if found {
+	if found && kdcStatus.Cassandra != nil {
 		dc.Status.DeepCopyInto(kdcStatus.Cassandra)
 	} else {
-		kc.Status.Datacenters[dc.Name] = api.K8ssandraStatus{
-			Cassandra: dc.Status.DeepCopy(),
-		}
+		kdcStatus.Cassandra = dc.Status.DeepCopy()
+		kc.Status.Datacenters[dc.Name] = kdcStatus
 	}
 }
 
@@ -246,14 +439,19 @@ func getSourceDatacenterName(targetDc *cassdcapi.CassandraDatacenter, kc *api.K8
 	dcNames := make([]string, 0)
 
 	for _, dc := range kc.Spec.Cassandra.Datacenters {
-		if dcStatus, found := kc.Status.Datacenters[dc.Meta.Name]; found {
+		if dcStatus, found := kc.Status.Datacenters[dc.Meta.Name]; found && dcStatus.Cassandra != nil {
 			if dcStatus.Cassandra.GetConditionStatus(cassdcapi.DatacenterReady) == corev1.ConditionTrue {
 				dcNames = append(dcNames, dc.Meta.Name)
 			}
 		}
 	}
 
-	if rebuildFrom, found := kc.Annotations[api.RebuildSourceDcAnnotation]; found {
+	rebuildFrom, found, err := kc.GetRebuildSource(targetDc.Name)
+	if err != nil {
+		return "", fmt.Errorf("rebuild error: %v", err)
+	}
+
+	if found {
 		if rebuildFrom == targetDc.Name {
 			return "", fmt.Errorf("rebuild error: src dc and target dc cannot be the same")
 		}
@@ -277,16 +475,13 @@ func getSourceDatacenterName(targetDc *cassdcapi.CassandraDatacenter, kc *api.K8
 		targetDc.Name, strings.Trim(fmt.Sprint(dcNames), "[]"))
 }
 
-func (r *K8ssandraClusterReconciler) checkRebuildAnnotation(ctx context.Context, kc *api.K8ssandraCluster, dcName string) result.ReconcileResult {
-	if !annotations.HasAnnotationWithValue(kc, api.RebuildDcAnnotation, dcName) && datacenterAddedToExistingCluster(kc, dcName) {
-		patch := client.MergeFromWithOptions(kc.DeepCopy())
-		annotations.AddAnnotation(kc, api.RebuildDcAnnotation, dcName)
-		if err := r.Client.Patch(ctx, kc, patch); err != nil {
-			return result.Error(fmt.Errorf("failed to add rebuild annotation: %v", err))
-		}
+// checkRebuild records in the status that the datacenter needs to be rebuilt if it is being added to an existing
+// cluster.
+func checkRebuild(kc *api.K8ssandraCluster, dcName string) {
+	if !kc.IsRebuilding(dcName) && datacenterAddedToExistingCluster(kc, dcName) {
+		now := metav1.Now()
+		kc.Status.Rebuild = &api.DatacenterRebuildStatus{Datacenter: dcName, StartTime: &now}
 	}
-
-	return result.Continue()
 }
 
 func (r *K8ssandraClusterReconciler) reconcileDcRebuild(
@@ -311,12 +506,13 @@ func (r *K8ssandraClusterReconciler) reconcileDcRebuild(
 		if taskFinished(task) {
 			// TODO what should we do if it failed?
 			logger.Info("Datacenter rebuild finished")
-			patch := client.MergeFromWithOptions(kc.DeepCopy())
-			delete(kc.Annotations, api.RebuildDcAnnotation)
-			if err = r.Client.Patch(ctx, kc, patch); err != nil {
-				err = fmt.Errorf("failed to remove %s annotation: %v", api.RebuildDcAnnotation, err)
-				return result.Error(err)
+			r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonRebuildFinished, "Rebuild of CassandraDatacenter %s from %s finished", dc.Name, srcDc)
+			if _, found := kc.Annotations[api.RebuildDcAnnotation]; found {
+				if err = r.removeAnnotation(ctx, kc, api.RebuildDcAnnotation); err != nil {
+					return result.Error(err)
+				}
 			}
+			kc.Status.Rebuild = nil
 			return result.Continue()
 		} else {
 			logger.Info("Waiting for datacenter rebuild to complete", "Task", taskKey)
@@ -324,11 +520,19 @@ func (r *K8ssandraClusterReconciler) reconcileDcRebuild(
 		}
 	} else {
 		if errors.IsNotFound(err) {
+			if next, held := r.holdForMaintenance(kc, dc.Name, api.MaintenanceRebuild, maintenanceWindowFor(kc, dc.Name), logger); held {
+				// The other datacenters wait for the rebuild, as they do while it runs
+				if next != nil && time.Until(next.Time) > r.DefaultDelay {
+					return result.RequeueSoon(time.Until(next.Time))
+				}
+				return result.RequeueSoon(r.DefaultDelay)
+			}
 			logger.Info("Creating rebuild task", "Task", taskKey)
 			if err = remoteClient.Create(ctx, desiredTask); err != nil {
 				logger.Error(err, "Failed to create rebuild task", "Task", taskKey)
 				return result.Error(err)
 			}
+			r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonRebuildStarted, "Rebuilding CassandraDatacenter %s from %s", dc.Name, srcDc)
 			return result.RequeueSoon(15)
 		}
 		logger.Error(err, "Failed to get rebuild task", "Task", taskKey)
@@ -0,0 +1,96 @@
+package k8ssandra
+
+import (
+	"fmt"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"k8s.io/client-go/tools/record"
+)
+
+// Reasons of the events recorded by the K8ssandraCluster controller.
+const (
+	eventReasonReconcileFailed              = "ReconcileFailed"
+	eventReasonCreatedDatacenter            = "CreatedDatacenter"
+	eventReasonUpdatedDatacenter            = "UpdatedDatacenter"
+	eventReasonSuperuserSecretImmutable     = "SuperuserSecretImmutable"
+	eventReasonStopRejected                 = "StopRejected"
+	eventReasonRebuildStarted               = "RebuildStarted"
+	eventReasonRebuildFinished              = "RebuildFinished"
+	eventReasonDecommissionStarted          = "DecommissionStarted"
+	eventReasonDeletedDatacenter            = "DeletedDatacenter"
+	eventReasonDecommissionFinished         = "DecommissionFinished"
+	eventReasonUpgradeRejected              = "UpgradeRejected"
+	eventReasonUpgradeStarted               = "UpgradeStarted"
+	eventReasonUpgradingSSTables            = "UpgradingSSTables"
+	eventReasonUpgradeCompleted             = "UpgradeCompleted"
+	eventReasonUpgradeFailed                = "UpgradeFailed"
+	eventReasonUpgradeResumed               = "UpgradeResumed"
+	eventReasonCreatedStargate              = "CreatedStargate"
+	eventReasonCreatedReaper                = "CreatedReaper"
+	eventReasonDeletedStargate              = "DeletedStargate"
+	eventReasonDeletedReaper                = "DeletedReaper"
+	eventReasonPaused                       = "Paused"
+	eventReasonResumed                      = "Resumed"
+	eventReasonPlanComputed                 = "PlanComputed"
+	eventReasonCredentialsRotationStarted   = "CredentialsRotationStarted"
+	eventReasonCredentialsRotationCompleted = "CredentialsRotationCompleted"
+	eventReasonCredentialsRotationRejected  = "CredentialsRotationRejected"
+	eventReasonRollingRestartStarted        = "RollingRestartStarted"
+	eventReasonRestartingDatacenter         = "RestartingDatacenter"
+	eventReasonRollingRestartCompleted      = "RollingRestartCompleted"
+	eventReasonScaleDownRejected            = "ScaleDownRejected"
+	eventReasonSystemAuthReplicationReduced = "SystemAuthReplicationReduced"
+	eventReasonMigrationStarted             = "MigrationStarted"
+	eventReasonMigrationRebuildFailed       = "MigrationRebuildFailed"
+	eventReasonMigrationCompleted           = "MigrationCompleted"
+	eventReasonCleanupStarted               = "CleanupStarted"
+	eventReasonCleanupCompleted             = "CleanupCompleted"
+	eventReasonCleanupFailed                = "CleanupFailed"
+	eventReasonReplacingNode                = "ReplacingNode"
+	eventReasonRepairingReplacedNode        = "RepairingReplacedNode"
+	eventReasonNodeReplaced                 = "NodeReplaced"
+	eventReasonNodeReplacementRejected      = "NodeReplacementRejected"
+	eventReasonNodeReplacementFailed        = "NodeReplacementFailed"
+	eventReasonMaintenancePending           = "MaintenancePending"
+	eventReasonBackupPruned                 = "BackupPruned"
+)
+
+// Reasons of the events recorded by the CassandraKeyspace, CassandraRole and CassandraGrant controllers.
+const (
+	eventReasonReplicationUpdated = "ReplicationUpdated"
+	eventReasonCreatedRole        = "CreatedRole"
+	eventReasonUpdatedRole        = "UpdatedRole"
+	eventReasonDroppedRole        = "DroppedRole"
+	eventReasonGrantedPermissions = "GrantedPermissions"
+	eventReasonRevokedPermissions = "RevokedPermissions"
+)
+
+// recordDatacenterEvent records an event on kc and the same event on dc. The latter is recorded in the Kubernetes
+// cluster the datacenter is deployed to, so that it shows up when describing the CassandraDatacenter.
+func (r *K8ssandraClusterReconciler) recordDatacenterEvent(
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	eventType, reason, messageFmt string,
+	args ...interface{}) {
+
+	message := fmt.Sprintf(messageFmt, args...)
+	r.Recorder.Event(kc, eventType, reason, message)
+	if recorder := r.datacenterRecorder(kc, dc.Name); recorder != nil {
+		recorder.Event(dc, eventType, reason, message)
+	}
+}
+
+// datacenterRecorder returns the recorder for events on the objects of datacenter dcName, or nil if the datacenter is
+// not part of kc or there is no recorder for its Kubernetes cluster.
+func (r *K8ssandraClusterReconciler) datacenterRecorder(kc *api.K8ssandraCluster, dcName string) record.EventRecorder {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName {
+			if dcTemplate.K8sContext == "" {
+				return r.Recorder
+			}
+			return r.ClientCache.GetRemoteRecorder(dcTemplate.K8sContext)
+		}
+	}
+	return nil
+}
@@ -18,25 +18,30 @@ package k8ssandra
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
 	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/labels"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -55,20 +60,25 @@ type K8ssandraClusterReconciler struct {
 	Scheme        *runtime.Scheme
 	ClientCache   *clientcache.ClientCache
 	ManagementApi cassandra.ManagementApiFactory
+	Recorder      record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=k8ssandraclusters;clientconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=config.k8ssandra.io,namespace="k8ssandra",resources=clientconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=k8ssandraclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=k8ssandraclusters/finalizers,verbs=update
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=cassandrakeyspaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cassandra.datastax.com,namespace="k8ssandra",resources=cassandradatacenters,verbs=get;list;watch;create;update;delete;patch
 // +kubebuilder:rbac:groups=control.k8ssandra.io,namespace="k8ssandra",resources=cassandratasks,verbs=get;list;watch;create;update;delete;patch
 // +kubebuilder:rbac:groups=stargate.k8ssandra.io,namespace="k8ssandra",resources=stargates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=reaper.k8ssandra.io,namespace="k8ssandra",resources=reapers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandraclusterbackups,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=pods;secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=endpoints,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,namespace="k8ssandra",resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
 
 func (r *K8ssandraClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx).WithValues("K8ssandraCluster", req.NamespacedName)
@@ -85,25 +95,44 @@ func (r *K8ssandraClusterReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	kc = kc.DeepCopy()
 	patch := client.MergeFromWithOptions(kc.DeepCopy())
 	result, err := r.reconcile(ctx, kc, logger)
+	if err != nil {
+		markReconcileFailed(kc, err)
+		r.Recorder.Event(kc, corev1.EventTypeWarning, eventReasonReconcileFailed, err.Error())
+	}
 	if kc.GetDeletionTimestamp() == nil {
 		if patchErr := r.Status().Patch(ctx, kc, patch); patchErr != nil {
 			logger.Error(patchErr, "failed to update k8ssandracluster status")
 		} else {
 			logger.Info("updated k8ssandracluster status")
 		}
+		updateClusterMetrics(kc)
+	} else if !controllerutil.ContainsFinalizer(kc, k8ssandraClusterFinalizer) {
+		deleteClusterMetrics(kc)
 	}
 	return result, err
 }
 
 func (r *K8ssandraClusterReconciler) reconcile(ctx context.Context, kc *api.K8ssandraCluster, kcLogger logr.Logger) (ctrl.Result, error) {
+	if recResult := r.checkPaused(ctx, kc, kcLogger); recResult.Completed() {
+		return recResult.Output()
+	}
+
 	if recResult := r.checkDeletion(ctx, kc, kcLogger); recResult.Completed() {
 		return recResult.Output()
 	}
 
+	if recResult := r.checkDryRun(ctx, kc, kcLogger); recResult.Completed() {
+		return recResult.Output()
+	}
+
 	if recResult := r.checkFinalizer(ctx, kc, kcLogger); recResult.Completed() {
 		return recResult.Output()
 	}
 
+	if kc.Status.ObservedGeneration != kc.Generation {
+		markProgressing(kc, reasonReconciling, fmt.Sprintf("Reconciling generation %d", kc.Generation))
+	}
+
 	if kc.Spec.Cassandra == nil {
 		// TODO handle the scenario of CassandraClusterTemplate being set to nil after having a non-nil value
 		return ctrl.Result{}, nil
@@ -122,6 +151,7 @@ func (r *K8ssandraClusterReconciler) reconcile(ctx context.Context, kc *api.K8ss
 	if medusaSecretResult := r.reconcileMedusaSecrets(ctx, kc, kcLogger); medusaSecretResult.Completed() {
 		return medusaSecretResult.Output()
 	}
+	r.reconcileClusterBackupRetention(ctx, kc, kcLogger)
 
 	kcLogger.Info("Reconciling replicated secrets")
 
@@ -129,6 +159,10 @@ func (r *K8ssandraClusterReconciler) reconcile(ctx context.Context, kc *api.K8ss
 		return recResult.Output()
 	}
 
+	if recResult := r.reconcileNodeReplacement(ctx, kc, kcLogger); recResult.Completed() {
+		return recResult.Output()
+	}
+
 	var actualDcs []*cassdcapi.CassandraDatacenter
 	if recResult, dcs := r.reconcileDatacenters(ctx, kc, kcLogger); recResult.Completed() {
 		return recResult.Output()
@@ -138,13 +172,29 @@ func (r *K8ssandraClusterReconciler) reconcile(ctx context.Context, kc *api.K8ss
 
 	kcLogger.Info("All DCs reconciled")
 
+	if recResult := r.reconcileCredentialsRotation(ctx, kc, kcLogger); recResult.Completed() {
+		return recResult.Output()
+	}
+
 	if recResult := r.afterCassandraReconciled(ctx, kc, actualDcs, kcLogger); recResult.Completed() {
+		if resultError(recResult) == nil {
+			markProgressing(kc, reasonReconcilingComponents, "Reconciling Stargate, Reaper and telemetry")
+		}
 		return recResult.Output()
 	}
 
+	if recResult := r.checkCredentialsRotationCompleted(kc, actualDcs, kcLogger); recResult.Completed() {
+		return recResult.Output()
+	}
+
+	if recResult := r.reconcileRollingRestart(ctx, kc, actualDcs, kcLogger); recResult.Completed() {
+		return recResult.Output()
+	}
+
+	markReconciled(kc)
 	kcLogger.Info("Finished reconciling the k8ssandracluster")
 
-	return result.Done().Output()
+	return r.scheduledRequeue(kc).Output()
 }
 
 func (r *K8ssandraClusterReconciler) afterCassandraReconciled(ctx context.Context, kc *api.K8ssandraCluster, dcs []*cassdcapi.CassandraDatacenter, logger logr.Logger) result.ReconcileResult {
@@ -167,10 +217,24 @@ func (r *K8ssandraClusterReconciler) afterCassandraReconciled(ctx context.Contex
 	return result.Continue()
 }
 
+// removeAnnotation removes the given annotation from the K8ssandraCluster with a patch, keeping the status updates
+// that were made in memory during this reconciliation.
+func (r *K8ssandraClusterReconciler) removeAnnotation(ctx context.Context, kc *api.K8ssandraCluster, annotation string) error {
+	kcCopy := kc.DeepCopy()
+	patch := client.MergeFromWithOptions(kc.DeepCopy())
+	delete(kc.Annotations, annotation)
+	if err := r.Client.Patch(ctx, kc, patch); err != nil {
+		return fmt.Errorf("failed to remove %s annotation: %v", annotation, err)
+	}
+	// The patch response overwrites the in-memory status updates
+	kc.Status = kcCopy.Status
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *K8ssandraClusterReconciler) SetupWithManager(mgr ctrl.Manager, clusters []cluster.Cluster) error {
 	cb := ctrl.NewControllerManagedBy(mgr).
-		For(&api.K8ssandraCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})) // No generation changed predicate here?
+		For(&api.K8ssandraCluster{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, annotations.ValueChangedPredicate{Keys: []string{api.PausedAnnotation, api.DryRunAnnotation, api.RotateCredentialsAnnotation, api.RollingRestartAnnotation, api.ReplaceNodeAnnotation}})))
 
 	clusterLabelFilter := func(mapObj client.Object) []reconcile.Request {
 		requests := make([]reconcile.Request, 0)
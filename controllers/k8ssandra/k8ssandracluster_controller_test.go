@@ -83,8 +83,41 @@ func TestK8ssandraCluster(t *testing.T) {
 			Scheme:           scheme.Scheme,
 			ClientCache:      clientCache,
 			ManagementApi:    managementApiFactory,
+			Recorder:         mgr.GetEventRecorderFor("k8ssandracluster-controller"),
 		}).SetupWithManager(mgr, clusters)
-		return err
+		if err != nil {
+			return err
+		}
+		err = (&CassandraKeyspaceReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           scheme.Scheme,
+			ClientCache:      clientCache,
+			ManagementApi:    managementApiFactory,
+			Recorder:         mgr.GetEventRecorderFor("cassandrakeyspace-controller"),
+		}).SetupWithManager(mgr)
+		if err != nil {
+			return err
+		}
+		err = (&CassandraRoleReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           scheme.Scheme,
+			ClientCache:      clientCache,
+			ManagementApi:    managementApiFactory,
+			Recorder:         mgr.GetEventRecorderFor("cassandrarole-controller"),
+		}).SetupWithManager(mgr)
+		if err != nil {
+			return err
+		}
+		return (&CassandraGrantReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           scheme.Scheme,
+			ClientCache:      clientCache,
+			ManagementApi:    managementApiFactory,
+			Recorder:         mgr.GetEventRecorderFor("cassandragrant-controller"),
+		}).SetupWithManager(mgr)
 	})
 	if err != nil {
 		t.Fatalf("failed to start test environment: %s", err)
@@ -109,6 +142,20 @@ func TestK8ssandraCluster(t *testing.T) {
 	t.Run("ApplyClusterWithEncryptionOptions", testEnv.ControllerTest(ctx, applyClusterWithEncryptionOptions))
 	t.Run("ApplyClusterWithEncryptionOptionsFail", testEnv.ControllerTest(ctx, applyClusterWithEncryptionOptionsFail))
 	t.Run("StopDatacenter", testEnv.ControllerTest(ctx, stopDc))
+	t.Run("CreateCassandraKeyspace", testEnv.ControllerTest(ctx, createCassandraKeyspace))
+	t.Run("CreateAndDeleteCassandraRole", testEnv.ControllerTest(ctx, createAndDeleteCassandraRole))
+	t.Run("CreateAndDeleteCassandraGrant", testEnv.ControllerTest(ctx, createAndDeleteCassandraGrant))
+	t.Run("UpgradeCassandraVersion", testEnv.ControllerTest(ctx, upgradeCassandraVersion))
+	t.Run("ReconcileDatacentersInParallel", testEnv.ControllerTest(ctx, reconcileDatacentersInParallel))
+	t.Run("PauseCluster", testEnv.ControllerTest(ctx, pauseCluster))
+	t.Run("PlanClusterChanges", testEnv.ControllerTest(ctx, planClusterChanges))
+	t.Run("RotateCredentials", testEnv.ControllerTest(ctx, rotateCredentials))
+	t.Run("RestartCluster", testEnv.ControllerTest(ctx, restartCluster))
+	t.Run("ScaleDownDatacenter", testEnv.ControllerTest(ctx, scaleDownDatacenter))
+	t.Run("MoveDatacenter", testEnv.ControllerTest(ctx, moveDatacenter))
+	t.Run("MoveLocalDatacenter", testEnv.ControllerTest(ctx, moveLocalDatacenter))
+	t.Run("CleanupAfterScaleUp", testEnv.ControllerTest(ctx, cleanupAfterScaleUp))
+	t.Run("ReplaceNode", testEnv.ControllerTest(ctx, replaceNode))
 }
 
 // createSingleDcCluster verifies that the CassandraDatacenter is created and that the
@@ -246,6 +293,26 @@ func createSingleDcCluster(t *testing.T, ctx context.Context, f *framework.Frame
 		return true
 	}, timeout, interval, "timed out waiting for K8ssandraCluster status update")
 
+	t.Log("check that the K8ssandraCluster is ready")
+	require.Eventually(func() bool {
+		kc := &api.K8ssandraCluster{}
+		if err := f.Get(ctx, kcKey, kc); err != nil {
+			t.Logf("failed to get K8ssandraCluster: %v", err)
+			return false
+		}
+		return kc.Status.ObservedGeneration == kc.Generation &&
+			kc.Status.GetConditionStatus(api.ClusterReady) == corev1.ConditionTrue &&
+			kc.Status.GetConditionStatus(api.ClusterProgressing) == corev1.ConditionFalse &&
+			kc.Status.GetConditionStatus(api.ClusterReplicationReady) == corev1.ConditionTrue &&
+			kc.Status.GetConditionStatus(api.ClusterRemoteClusterReachable) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for K8ssandraCluster to be ready")
+
+	t.Log("check that the datacenter creation was recorded as events")
+	require.Eventually(f.EventRecorded(ctx, kcKey, eventReasonCreatedDatacenter), timeout, interval,
+		"timed out waiting for CreatedDatacenter event on K8ssandraCluster")
+	require.Eventually(f.EventRecorded(ctx, dcKey, eventReasonCreatedDatacenter), timeout, interval,
+		"timed out waiting for CreatedDatacenter event on CassandraDatacenter")
+
 	// Test that prometheus servicemonitor comes up when it is requested in the CassandraDatacenter.
 	kcPatch := client.MergeFrom(kc.DeepCopy())
 	kc.Spec.Cassandra.Datacenters[0].Telemetry = &telemetryapi.TelemetrySpec{
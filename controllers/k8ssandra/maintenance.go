@@ -0,0 +1,161 @@
+package k8ssandra
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/plan"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var maintenanceOperationDescriptions = map[api.MaintenanceOperation]string{
+	api.MaintenanceConfigChange: "configuration change",
+	api.MaintenanceUpgrade:      "version upgrade",
+	api.MaintenanceRebuild:      "rebuild",
+}
+
+// maintenanceWindowFor returns the maintenance window of the datacenter dcName: its own window if it overrides the
+// one of the cluster, the window of the cluster otherwise. nil means that operations can start at any time.
+func maintenanceWindowFor(kc *api.K8ssandraCluster, dcName string) *api.MaintenanceWindow {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName && dcTemplate.MaintenanceWindow != nil {
+			return dcTemplate.MaintenanceWindow
+		}
+	}
+	return kc.Spec.Cassandra.MaintenanceWindow
+}
+
+// holdForMaintenance returns true if operation on the datacenter dcName must wait for window to open, along with the
+// time at which it opens next. The operation is then reported as pending in the status of the datacenter, and an
+// event is recorded when it starts waiting. Otherwise, it is removed from the pending operations. An invalid window
+// holds the operation, since the webhook is expected to reject it.
+func (r *K8ssandraClusterReconciler) holdForMaintenance(
+	kc *api.K8ssandraCluster,
+	dcName string,
+	operation api.MaintenanceOperation,
+	window *api.MaintenanceWindow,
+	logger logr.Logger) (*metav1.Time, bool) {
+
+	open, next, err := window.IsOpen(time.Now())
+	if err != nil {
+		logger.Error(err, "Invalid maintenance window", "Operation", operation)
+	} else if open {
+		releaseMaintenance(kc, dcName, operation)
+		return nil, false
+	}
+
+	var nextWindowTime *metav1.Time
+	if !next.IsZero() {
+		nextWindowTime = &metav1.Time{Time: next}
+	}
+
+	maintenance := &api.DatacenterMaintenanceStatus{}
+	if actual := kc.Status.Datacenters[dcName].Maintenance; actual != nil {
+		maintenance = actual.DeepCopy()
+	}
+	if !maintenanceOperationPending(maintenance, operation) {
+		maintenance.Pending = append(maintenance.Pending, operation)
+		logger.Info("Holding operation until the maintenance window opens", "Operation", operation, "NextWindowTime", next)
+		if nextWindowTime != nil {
+			r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonMaintenancePending,
+				"Holding the %s of CassandraDatacenter %s until the maintenance window opens at %s",
+				maintenanceOperationDescriptions[operation], dcName, next.Format(time.RFC3339))
+		}
+	}
+	maintenance.NextWindowTime = nextWindowTime
+	setMaintenanceStatus(kc, dcName, maintenance)
+	return nextWindowTime, true
+}
+
+// holdDatacenterUpdate returns true if updating actualDc to desiredDc restarts the Cassandra pods and must wait for
+// the maintenance window of the datacenter. Updates that carry on an operation that was not held, i.e. the new version
+// of an upgrade that started, the pod restart that completes a credentials rotation, or a requested rolling restart, are
+// applied right away.
+func (r *K8ssandraClusterReconciler) holdDatacenterUpdate(
+	kc *api.K8ssandraCluster,
+	actualDc, desiredDc *cassdcapi.CassandraDatacenter,
+	logger logr.Logger) bool {
+
+	dcName := actualDc.Name
+	upgrade := kc.Status.Datacenters[dcName].Upgrade
+	rotation := kc.Status.CredentialsRotation
+	if actualDc.Spec.Stopped ||
+		(upgrade != nil && upgrade.Progress == api.UpgradeUpgradingNodes) ||
+		(rotation != nil && rotation.Progress != api.CredentialsRotationCompleted) ||
+		datacenterRestarting(kc, dcName) {
+		releaseMaintenance(kc, dcName, api.MaintenanceConfigChange)
+		return false
+	}
+
+	changes, err := plan.Diff(actualDc.Spec, desiredDc.Spec)
+	if err != nil {
+		logger.Error(err, "Failed to compute the changes of the datacenter, applying them")
+		releaseMaintenance(kc, dcName, api.MaintenanceConfigChange)
+		return false
+	}
+	// The hashes differ, changes that the diff does not show are treated as requiring a restart
+	if len(changes) > 0 && !plan.DatacenterRequiresRollingRestart(changes) {
+		releaseMaintenance(kc, dcName, api.MaintenanceConfigChange)
+		return false
+	}
+
+	_, held := r.holdForMaintenance(kc, dcName, api.MaintenanceConfigChange, maintenanceWindowFor(kc, dcName), logger)
+	return held
+}
+
+// releaseMaintenance removes operation from the operations of the datacenter dcName that wait for the maintenance
+// window.
+func releaseMaintenance(kc *api.K8ssandraCluster, dcName string, operation api.MaintenanceOperation) {
+	actual := kc.Status.Datacenters[dcName].Maintenance
+	if !maintenanceOperationPending(actual, operation) {
+		return
+	}
+	maintenance := &api.DatacenterMaintenanceStatus{NextWindowTime: actual.NextWindowTime}
+	for _, pending := range actual.Pending {
+		if pending != operation {
+			maintenance.Pending = append(maintenance.Pending, pending)
+		}
+	}
+	if len(maintenance.Pending) == 0 {
+		maintenance = nil
+	}
+	setMaintenanceStatus(kc, dcName, maintenance)
+}
+
+// nextMaintenanceWindow returns the earliest time at which the maintenance window of a datacenter with pending
+// operations opens, or nil if no operation is pending.
+func nextMaintenanceWindow(kc *api.K8ssandraCluster) *time.Time {
+	var next *time.Time
+	for _, dcStatus := range kc.Status.Datacenters {
+		if maintenance := dcStatus.Maintenance; maintenance != nil && maintenance.NextWindowTime != nil {
+			if t := maintenance.NextWindowTime.Time; next == nil || t.Before(*next) {
+				next = &t
+			}
+		}
+	}
+	return next
+}
+
+func maintenanceOperationPending(maintenance *api.DatacenterMaintenanceStatus, operation api.MaintenanceOperation) bool {
+	if maintenance == nil {
+		return false
+	}
+	for _, pending := range maintenance.Pending {
+		if pending == operation {
+			return true
+		}
+	}
+	return false
+}
+
+func setMaintenanceStatus(kc *api.K8ssandraCluster, dcName string, maintenance *api.DatacenterMaintenanceStatus) {
+	if kc.Status.Datacenters == nil {
+		kc.Status.Datacenters = make(map[string]api.K8ssandraStatus)
+	}
+	status := kc.Status.Datacenters[dcName]
+	status.Maintenance = maintenance
+	kc.Status.Datacenters[dcName] = status
+}
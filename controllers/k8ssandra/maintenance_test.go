@@ -0,0 +1,137 @@
+package k8ssandra
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var (
+	// openWindow is open at any time.
+	openWindow = &api.MaintenanceWindow{Schedule: "* * * * *", Duration: metav1.Duration{Duration: time.Hour}}
+	// closedWindow only opens for one minute a year.
+	closedWindow = &api.MaintenanceWindow{Schedule: "0 0 1 1 *", Duration: metav1.Duration{Duration: time.Minute}}
+)
+
+func newMaintenanceTestCluster() *api.K8ssandraCluster {
+	return &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				MaintenanceWindow: closedWindow,
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, MaintenanceWindow: openWindow},
+				},
+			},
+		},
+	}
+}
+
+func TestMaintenanceWindowFor(t *testing.T) {
+	kc := newMaintenanceTestCluster()
+	assert.Equal(t, closedWindow, maintenanceWindowFor(kc, "dc1"))
+	assert.Equal(t, openWindow, maintenanceWindowFor(kc, "dc2"))
+
+	kc.Spec.Cassandra.MaintenanceWindow = nil
+	assert.Nil(t, maintenanceWindowFor(kc, "dc1"))
+}
+
+func TestHoldForMaintenance(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &K8ssandraClusterReconciler{Recorder: recorder}
+	kc := newMaintenanceTestCluster()
+	logger := logr.Discard()
+
+	next, held := r.holdForMaintenance(kc, "dc1", api.MaintenanceUpgrade, closedWindow, logger)
+	require.True(t, held)
+	require.NotNil(t, next)
+	maintenance := kc.Status.Datacenters["dc1"].Maintenance
+	require.NotNil(t, maintenance)
+	assert.Equal(t, []api.MaintenanceOperation{api.MaintenanceUpgrade}, maintenance.Pending)
+	assert.Equal(t, next, maintenance.NextWindowTime)
+	assert.Len(t, recorder.Events, 1)
+
+	_, held = r.holdForMaintenance(kc, "dc1", api.MaintenanceUpgrade, closedWindow, logger)
+	assert.True(t, held)
+	assert.Len(t, recorder.Events, 1, "no event must be recorded for an operation that is already pending")
+
+	_, held = r.holdForMaintenance(kc, "dc1", api.MaintenanceConfigChange, closedWindow, logger)
+	assert.True(t, held)
+	assert.Equal(t, []api.MaintenanceOperation{api.MaintenanceUpgrade, api.MaintenanceConfigChange}, kc.Status.Datacenters["dc1"].Maintenance.Pending)
+
+	next, held = r.holdForMaintenance(kc, "dc1", api.MaintenanceUpgrade, openWindow, logger)
+	assert.False(t, held)
+	assert.Nil(t, next)
+	assert.Equal(t, []api.MaintenanceOperation{api.MaintenanceConfigChange}, kc.Status.Datacenters["dc1"].Maintenance.Pending)
+
+	releaseMaintenance(kc, "dc1", api.MaintenanceConfigChange)
+	assert.Nil(t, kc.Status.Datacenters["dc1"].Maintenance)
+
+	_, held = r.holdForMaintenance(kc, "dc1", api.MaintenanceRebuild, nil, logger)
+	assert.False(t, held, "operations must not be held without a maintenance window")
+
+	_, held = r.holdForMaintenance(kc, "dc1", api.MaintenanceRebuild, &api.MaintenanceWindow{Schedule: "invalid"}, logger)
+	assert.True(t, held, "operations must be held by an invalid maintenance window")
+}
+
+func TestHoldDatacenterUpdate(t *testing.T) {
+	r := &K8ssandraClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+	kc := newMaintenanceTestCluster()
+	logger := logr.Discard()
+
+	actualDc := &cassdcapi.CassandraDatacenter{
+		ObjectMeta: metav1.ObjectMeta{Name: "dc1"},
+		Spec:       cassdcapi.CassandraDatacenterSpec{Size: 3, ServerImage: "cassandra:4.0.3"},
+	}
+
+	desiredDc := actualDc.DeepCopy()
+	desiredDc.Spec.Size = 4
+	assert.False(t, r.holdDatacenterUpdate(kc, actualDc, desiredDc, logger), "a scale-up does not restart the pods")
+
+	desiredDc = actualDc.DeepCopy()
+	desiredDc.Spec.ServerImage = "cassandra:4.0.4"
+	assert.True(t, r.holdDatacenterUpdate(kc, actualDc, desiredDc, logger))
+	assert.Equal(t, []api.MaintenanceOperation{api.MaintenanceConfigChange}, kc.Status.Datacenters["dc1"].Maintenance.Pending)
+
+	kc.Status.CredentialsRotation = &api.CredentialsRotationStatus{Progress: api.CredentialsRotationRollingComponents}
+	assert.False(t, r.holdDatacenterUpdate(kc, actualDc, desiredDc, logger), "a credentials rotation must not be held")
+	assert.Nil(t, kc.Status.Datacenters["dc1"].Maintenance)
+	kc.Status.CredentialsRotation = nil
+
+	actualDc.Name = "dc2"
+	assert.False(t, r.holdDatacenterUpdate(kc, actualDc, desiredDc, logger), "the window of dc2 is open")
+
+	kc = newMaintenanceTestCluster()
+	actualDc.Name = "dc1"
+	actualDc.Spec.Config = json.RawMessage(`{"cassandra-yaml":{"num_tokens":16,"concurrent_reads":32}}`)
+	desiredDc = actualDc.DeepCopy()
+	desiredDc.Spec.Config = json.RawMessage(`{"cassandra-yaml":{"num_tokens":16}}`)
+	assert.True(t, r.holdDatacenterUpdate(kc, actualDc, desiredDc, logger), "removing a setting restarts the pods")
+	assert.Equal(t, []api.MaintenanceOperation{api.MaintenanceConfigChange}, kc.Status.Datacenters["dc1"].Maintenance.Pending)
+}
+
+func TestNextMaintenanceWindow(t *testing.T) {
+	kc := &api.K8ssandraCluster{}
+	assert.Nil(t, nextMaintenanceWindow(kc))
+
+	window := time.Now().Add(time.Hour)
+	setMaintenanceStatus(kc, "dc1", &api.DatacenterMaintenanceStatus{
+		Pending:        []api.MaintenanceOperation{api.MaintenanceUpgrade},
+		NextWindowTime: &metav1.Time{Time: window.Add(time.Hour)},
+	})
+	setMaintenanceStatus(kc, "dc2", &api.DatacenterMaintenanceStatus{
+		Pending:        []api.MaintenanceOperation{api.MaintenanceRebuild},
+		NextWindowTime: &metav1.Time{Time: window},
+	})
+	next := nextMaintenanceWindow(kc)
+	require.NotNil(t, next)
+	assert.True(t, window.Equal(*next))
+}
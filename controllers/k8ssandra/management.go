@@ -0,0 +1,52 @@
+package k8ssandra
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newManagementApiForCluster returns a ManagementApiFacade connected to the first of the given datacenters of kc
+// that is ready. This is meant for controllers of objects that apply schema or auth changes to a K8ssandraCluster,
+// which can be done through any DC. A nil facade is returned if none of the datacenters is ready.
+func newManagementApiForCluster(
+	ctx context.Context,
+	clientCache *clientcache.ClientCache,
+	factory cassandra.ManagementApiFactory,
+	kc *api.K8ssandraCluster,
+	datacenters []api.CassandraDatacenterTemplate,
+	logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+
+	for _, dcTemplate := range datacenters {
+		remoteClient, err := clientCache.GetRemoteClient(dcTemplate.K8sContext)
+		if err != nil {
+			return nil, err
+		}
+
+		namespace := dcTemplate.Meta.Namespace
+		if namespace == "" {
+			namespace = kc.Namespace
+		}
+
+		dc := &cassdcapi.CassandraDatacenter{}
+		dcKey := client.ObjectKey{Namespace: namespace, Name: dcTemplate.Meta.Name}
+		if err := remoteClient.Get(ctx, dcKey, dc); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		if cassandra.DatacenterReady(dc) {
+			return factory.NewManagementApiFacade(ctx, dc, remoteClient, logger.WithValues("CassandraDatacenter", dcKey))
+		}
+	}
+
+	return nil, nil
+}
@@ -3,9 +3,11 @@ package k8ssandra
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
 	cassandra "github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
 	medusa "github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
@@ -14,13 +16,13 @@ import (
 	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Create all things Medusa related in the cassdc podTemplateSpec
 func (r *K8ssandraClusterReconciler) ReconcileMedusa(
 	ctx context.Context,
-	dcConfig *cassandra.DatacenterConfig,
 	dcTemplate api.CassandraDatacenterTemplate,
 	kc *api.K8ssandraCluster,
 	logger logr.Logger,
@@ -33,18 +35,10 @@ func (r *K8ssandraClusterReconciler) ReconcileMedusa(
 	if namespace == "" {
 		namespace = kc.Namespace
 	}
-	logger.Info("Medusa reconcile for " + dcConfig.Meta.Name + " on namespace " + namespace)
+	logger.Info("Medusa reconcile for " + dcTemplate.Meta.Name + " on namespace " + namespace)
 	medusaSpec := kc.Spec.Medusa
 	if medusaSpec != nil {
 		logger.Info("Medusa is enabled")
-		if dcConfig.PodTemplateSpec == nil {
-			dcConfig.PodTemplateSpec = &corev1.PodTemplateSpec{
-				Spec: corev1.PodSpec{
-					Containers:     []corev1.Container{},
-					InitContainers: []corev1.Container{},
-				},
-			}
-		}
 
 		if medusaSpec.StorageProperties.StorageProvider != "local" && medusaSpec.StorageProperties.StorageSecretRef.Name == "" {
 			return result.Error(fmt.Errorf("medusa storage secret is not defined for storage provider %s", medusaSpec.StorageProperties.StorageProvider))
@@ -52,10 +46,7 @@ func (r *K8ssandraClusterReconciler) ReconcileMedusa(
 		if res := r.reconcileMedusaConfigMap(ctx, remoteClient, kc, logger, namespace); res.Completed() {
 			return res
 		}
-		medusa.UpdateMedusaInitContainer(dcConfig, medusaSpec, logger)
-		medusa.UpdateMedusaMainContainer(dcConfig, medusaSpec, logger)
-		medusa.UpdateMedusaVolumes(dcConfig, medusaSpec, logger)
-		cassandra.AddCqlUser(medusaSpec.CassandraUserSecretRef, dcConfig, medusa.CassandraUserSecretName(medusaSpec, kc.Name))
+		r.reconcileBackupRetention(ctx, remoteClient, kc, dcTemplate.Meta.Name, namespace, logger)
 	} else {
 		logger.Info("Medusa is not enabled")
 	}
@@ -63,6 +54,26 @@ func (r *K8ssandraClusterReconciler) ReconcileMedusa(
 	return result.Continue()
 }
 
+// applyMedusaSettings adds the Medusa containers, volumes and CQL user to dcConfig when Medusa is enabled.
+func applyMedusaSettings(dcConfig *cassandra.DatacenterConfig, kc *api.K8ssandraCluster, logger logr.Logger) {
+	medusaSpec := kc.Spec.Medusa
+	if medusaSpec == nil {
+		return
+	}
+	if dcConfig.PodTemplateSpec == nil {
+		dcConfig.PodTemplateSpec = &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers:     []corev1.Container{},
+				InitContainers: []corev1.Container{},
+			},
+		}
+	}
+	medusa.UpdateMedusaInitContainer(dcConfig, medusaSpec, logger)
+	medusa.UpdateMedusaMainContainer(dcConfig, medusaSpec, logger)
+	medusa.UpdateMedusaVolumes(dcConfig, medusaSpec, logger)
+	cassandra.AddCqlUser(medusaSpec.CassandraUserSecretRef, dcConfig, medusa.CassandraUserSecretName(medusaSpec, kc.Name))
+}
+
 // Generate a secret for Medusa or use the existing one if provided in the spec
 func (r *K8ssandraClusterReconciler) reconcileMedusaSecrets(
 	ctx context.Context,
@@ -137,3 +148,110 @@ func (r *K8ssandraClusterReconciler) reconcileMedusaConfigMap(
 	logger.Info("Medusa ConfigMap successfully reconciled")
 	return result.Continue()
 }
+
+// reconcileBackupRetention deletes the CassandraBackups of the datacenter dcName that its backup retention policy does
+// not keep, except those of CassandraClusterBackups, see reconcileClusterBackupRetention. The finalizer of the backups
+// deletes their data from the storage backend. Failures are only logged, the retention policy is enforced again at the
+// next reconciliation.
+func (r *K8ssandraClusterReconciler) reconcileBackupRetention(
+	ctx context.Context,
+	remoteClient client.Client,
+	kc *api.K8ssandraCluster,
+	dcName, namespace string,
+	logger logr.Logger) {
+
+	// The status entry of a datacenter tells whether it is deployed, so it must not be created here
+	if dcStatus, found := kc.Status.Datacenters[dcName]; !found || dcStatus.Cassandra == nil {
+		return
+	}
+
+	policy := medusa.BackupRetentionPolicy(kc, dcName)
+	if policy == nil {
+		setNextBackupExpiry(kc, dcName, nil)
+		return
+	}
+
+	backups := &medusaapi.CassandraBackupList{}
+	if err := remoteClient.List(ctx, backups, client.InNamespace(namespace)); err != nil {
+		logger.Error(err, "Failed to list CassandraBackups")
+		return
+	}
+
+	expired, nextExpiry := medusa.ExpiredBackups(backups.Items, dcName, policy, time.Now())
+	for i := range expired {
+		backup := &expired[i]
+		logger.Info("Deleting CassandraBackup beyond the retention policy", "CassandraBackup", backup.Name)
+		if err := remoteClient.Delete(ctx, backup); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete CassandraBackup", "CassandraBackup", backup.Name)
+			continue
+		}
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonBackupPruned,
+			"Deleted CassandraBackup %s of CassandraDatacenter %s according to the backup retention policy", backup.Name, dcName)
+	}
+
+	if nextExpiry != nil {
+		setNextBackupExpiry(kc, dcName, &metav1.Time{Time: *nextExpiry})
+	} else {
+		setNextBackupExpiry(kc, dcName, nil)
+	}
+}
+
+// reconcileClusterBackupRetention deletes the CassandraClusterBackups of kc that the backup retention policy of the
+// cluster does not keep. The finalizer of the cluster backups deletes their data from the storage backend, once for all
+// the datacenters. Failures are only logged, the retention policy is enforced again at the next reconciliation.
+func (r *K8ssandraClusterReconciler) reconcileClusterBackupRetention(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) {
+	if kc.Spec.Medusa == nil || kc.Spec.Medusa.BackupRetention == nil {
+		kc.Status.NextClusterBackupExpiry = nil
+		return
+	}
+
+	clusterBackups := &medusaapi.CassandraClusterBackupList{}
+	if err := r.Client.List(ctx, clusterBackups, client.InNamespace(kc.Namespace)); err != nil {
+		logger.Error(err, "Failed to list CassandraClusterBackups")
+		return
+	}
+
+	expired, nextExpiry := medusa.ExpiredClusterBackups(clusterBackups.Items, kc.Name, kc.Spec.Medusa.BackupRetention, time.Now())
+	for i := range expired {
+		clusterBackup := &expired[i]
+		logger.Info("Deleting CassandraClusterBackup beyond the retention policy", "CassandraClusterBackup", clusterBackup.Name)
+		if err := r.Client.Delete(ctx, clusterBackup); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete CassandraClusterBackup", "CassandraClusterBackup", clusterBackup.Name)
+			continue
+		}
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonBackupPruned,
+			"Deleted CassandraClusterBackup %s according to the backup retention policy", clusterBackup.Name)
+	}
+
+	if nextExpiry != nil {
+		kc.Status.NextClusterBackupExpiry = &metav1.Time{Time: *nextExpiry}
+	} else {
+		kc.Status.NextClusterBackupExpiry = nil
+	}
+}
+
+func setNextBackupExpiry(kc *api.K8ssandraCluster, dcName string, expiry *metav1.Time) {
+	status, found := kc.Status.Datacenters[dcName]
+	if !found {
+		return
+	}
+	status.NextBackupExpiry = expiry
+	kc.Status.Datacenters[dcName] = status
+}
+
+// nextBackupExpiry returns the earliest time at which a backup of the cluster exceeds the maximum age of its
+// retention policy, or nil if there is none.
+func nextBackupExpiry(kc *api.K8ssandraCluster) *time.Time {
+	var next *time.Time
+	if expiry := kc.Status.NextClusterBackupExpiry; expiry != nil {
+		t := expiry.Time
+		next = &t
+	}
+	for _, dcStatus := range kc.Status.Datacenters {
+		if expiry := dcStatus.NextBackupExpiry; expiry != nil && (next == nil || expiry.Time.Before(*next)) {
+			t := expiry.Time
+			next = &t
+		}
+	}
+	return next
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
@@ -16,7 +18,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const (
@@ -245,3 +250,138 @@ func checkMedusaObjectsCompliance(t *testing.T, f *framework.Framework, dc *cass
 		assert.True(t, f.ContainerHasEnvVar(container, "CQL_PASSWORD", ""), "Missing CQL_PASSWORD env var for medusa-restore")
 	}
 }
+
+func TestReconcileBackupRetention(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	require.NoError(t, medusaapi.AddToScheme(s))
+
+	newBackup := func(name string, age time.Duration) *medusaapi.CassandraBackup {
+		backup := &medusaapi.CassandraBackup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       medusaapi.CassandraBackupSpec{Name: name, CassandraDatacenter: "dc1"},
+		}
+		backup.Status.StartTime = metav1.NewTime(time.Now().Add(-age))
+		backup.Status.FinishTime = backup.Status.StartTime
+		return backup
+	}
+	remoteClient := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		newBackup("backup1", 72*time.Hour),
+		newBackup("backup2", 48*time.Hour),
+		newBackup("backup3", 24*time.Hour),
+	).Build()
+
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{{Meta: api.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+			Medusa: &medusaapi.MedusaClusterTemplate{
+				BackupRetention: &medusaapi.BackupRetentionPolicy{MaxBackupCount: 2},
+			},
+		},
+	}
+	r := &K8ssandraClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+
+	r.reconcileBackupRetention(ctx, remoteClient, kc, "dc1", "default", logr.Discard())
+	backups := &medusaapi.CassandraBackupList{}
+	require.NoError(t, remoteClient.List(ctx, backups))
+	assert.Len(t, backups.Items, 3, "dc1 is not deployed yet")
+	assert.Empty(t, kc.Status.Datacenters)
+
+	kc.Status.Datacenters = map[string]api.K8ssandraStatus{"dc1": {Cassandra: &cassdcapi.CassandraDatacenterStatus{}}}
+	r.reconcileBackupRetention(ctx, remoteClient, kc, "dc1", "default", logr.Discard())
+	require.NoError(t, remoteClient.List(ctx, backups))
+	assert.Len(t, backups.Items, 2)
+	assert.Nil(t, nextBackupExpiry(kc))
+
+	kc.Spec.Medusa.BackupRetention.MaxBackupAge = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	r.reconcileBackupRetention(ctx, remoteClient, kc, "dc1", "default", logr.Discard())
+	require.NotNil(t, nextBackupExpiry(kc), "backup2 expires in 28 days")
+	assert.WithinDuration(t, time.Now().Add(28*24*time.Hour), *nextBackupExpiry(kc), time.Minute)
+
+	kc.Spec.Medusa.BackupRetention = nil
+	r.reconcileBackupRetention(ctx, remoteClient, kc, "dc1", "default", logr.Discard())
+	assert.Nil(t, nextBackupExpiry(kc))
+}
+
+func TestReconcileClusterBackupRetention(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	require.NoError(t, medusaapi.AddToScheme(s))
+
+	newClusterBackup := func(name string, age time.Duration) *medusaapi.CassandraClusterBackup {
+		clusterBackup := &medusaapi.CassandraClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       medusaapi.CassandraClusterBackupSpec{Cluster: corev1.LocalObjectReference{Name: "test"}},
+		}
+		clusterBackup.Status.StartTime = metav1.NewTime(time.Now().Add(-age))
+		clusterBackup.Status.FinishTime = clusterBackup.Status.StartTime
+		return clusterBackup
+	}
+	newDatacenterBackup := func(clusterBackup *medusaapi.CassandraClusterBackup, dcName string) *medusaapi.CassandraBackup {
+		backup := &medusaapi.CassandraBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      clusterBackup.Name + "-" + dcName,
+				Labels:    map[string]string{medusaapi.ClusterBackupNameLabel: clusterBackup.Name},
+			},
+			Spec: medusaapi.CassandraBackupSpec{Name: clusterBackup.Name, CassandraDatacenter: dcName},
+		}
+		backup.Status.StartTime = clusterBackup.Status.StartTime
+		backup.Status.FinishTime = clusterBackup.Status.FinishTime
+		return backup
+	}
+	clusterBackup1 := newClusterBackup("cluster-backup1", 72*time.Hour)
+	clusterBackup2 := newClusterBackup("cluster-backup2", 24*time.Hour)
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		clusterBackup1,
+		clusterBackup2,
+		newDatacenterBackup(clusterBackup1, "dc1"),
+		newDatacenterBackup(clusterBackup1, "dc2"),
+		newDatacenterBackup(clusterBackup2, "dc1"),
+		newDatacenterBackup(clusterBackup2, "dc2"),
+	).Build()
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}},
+				},
+			},
+			Medusa: &medusaapi.MedusaClusterTemplate{
+				BackupRetention: &medusaapi.BackupRetentionPolicy{MaxBackupCount: 1},
+			},
+		},
+		Status: api.K8ssandraClusterStatus{
+			Datacenters: map[string]api.K8ssandraStatus{
+				"dc1": {Cassandra: &cassdcapi.CassandraDatacenterStatus{}},
+				"dc2": {Cassandra: &cassdcapi.CassandraDatacenterStatus{}},
+			},
+		},
+	}
+	r := &K8ssandraClusterReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+	t.Log("the datacenter retention leaves the backups of the cluster backups alone")
+	r.reconcileBackupRetention(ctx, c, kc, "dc1", "default", logr.Discard())
+	backups := &medusaapi.CassandraBackupList{}
+	require.NoError(t, c.List(ctx, backups))
+	assert.Len(t, backups.Items, 4)
+
+	t.Log("the cluster retention deletes the whole cluster backup")
+	r.reconcileClusterBackupRetention(ctx, kc, logr.Discard())
+	clusterBackups := &medusaapi.CassandraClusterBackupList{}
+	require.NoError(t, c.List(ctx, clusterBackups))
+	require.Len(t, clusterBackups.Items, 1)
+	assert.Equal(t, "cluster-backup2", clusterBackups.Items[0].Name)
+	assert.Nil(t, nextBackupExpiry(kc))
+
+	kc.Spec.Medusa.BackupRetention = &medusaapi.BackupRetentionPolicy{MaxBackupAge: &metav1.Duration{Duration: 48 * time.Hour}}
+	require.NoError(t, c.Create(ctx, newClusterBackup("cluster-backup3", time.Hour)))
+	r.reconcileClusterBackupRetention(ctx, kc, logr.Discard())
+	require.NotNil(t, nextBackupExpiry(kc), "cluster-backup2 expires in 24 hours")
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), *nextBackupExpiry(kc), time.Minute)
+}
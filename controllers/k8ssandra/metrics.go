@@ -0,0 +1,72 @@
+package k8ssandra
+
+import (
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// updateClusterMetrics sets the K8ssandraCluster metrics from the status of kc. Datacenters are reported for as long
+// as they are in the spec or the status, i.e. until their decommission is finished.
+func updateClusterMetrics(kc *api.K8ssandraCluster) {
+	for _, dcName := range clusterDatacenterNames(kc) {
+		dcStatus := kc.Status.Datacenters[dcName]
+		ready := dcStatus.Cassandra != nil && dcStatus.Cassandra.GetConditionStatus(cassdcapi.DatacenterReady) == corev1.ConditionTrue
+		metrics.DatacenterReady.WithLabelValues(kc.Namespace, kc.Name, dcName).Set(metrics.BoolToFloat(ready))
+		metrics.DatacenterDecommissionProgress.WithLabelValues(kc.Namespace, kc.Name, dcName).Set(decommissionProgressValue(dcStatus.DecommissionProgress))
+		rebuilding := kc.IsRebuilding(dcName)
+		metrics.DatacenterRebuildInProgress.WithLabelValues(kc.Namespace, kc.Name, dcName).Set(metrics.BoolToFloat(rebuilding))
+	}
+
+	switch kc.Status.GetConditionStatus(api.ClusterSchemaAgreement) {
+	case corev1.ConditionTrue:
+		metrics.SchemaAgreement.WithLabelValues(kc.Namespace, kc.Name).Set(1)
+	case corev1.ConditionFalse:
+		metrics.SchemaAgreement.WithLabelValues(kc.Namespace, kc.Name).Set(0)
+	default:
+		metrics.SchemaAgreement.DeleteLabelValues(kc.Namespace, kc.Name)
+	}
+}
+
+// deleteClusterMetrics removes the metrics of kc once it is deleted.
+func deleteClusterMetrics(kc *api.K8ssandraCluster) {
+	for _, dcName := range clusterDatacenterNames(kc) {
+		deleteDatacenterMetrics(kc, dcName)
+	}
+	metrics.SchemaAgreement.DeleteLabelValues(kc.Namespace, kc.Name)
+}
+
+// deleteDatacenterMetrics removes the metrics of a datacenter that was removed from kc.
+func deleteDatacenterMetrics(kc *api.K8ssandraCluster, dcName string) {
+	metrics.DatacenterReady.DeleteLabelValues(kc.Namespace, kc.Name, dcName)
+	metrics.DatacenterDecommissionProgress.DeleteLabelValues(kc.Namespace, kc.Name, dcName)
+	metrics.DatacenterRebuildInProgress.DeleteLabelValues(kc.Namespace, kc.Name, dcName)
+}
+
+func clusterDatacenterNames(kc *api.K8ssandraCluster) []string {
+	names := make([]string, 0, len(kc.Status.Datacenters))
+	if kc.Spec.Cassandra != nil {
+		for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+			names = append(names, dcTemplate.Meta.Name)
+		}
+	}
+	for dcName := range kc.Status.Datacenters {
+		if !utils.SliceContains(names, dcName) {
+			names = append(names, dcName)
+		}
+	}
+	return names
+}
+
+func decommissionProgressValue(progress api.DecommissionProgress) float64 {
+	switch progress {
+	case api.DecommUpdatingReplication:
+		return metrics.DecommissionUpdatingReplication
+	case api.DecommDeleting:
+		return metrics.DecommissionDeleting
+	default:
+		return metrics.DecommissionNone
+	}
+}
@@ -0,0 +1,58 @@
+package k8ssandra
+
+import (
+	"testing"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateClusterMetrics(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "metrics-test",
+			Name:      "test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}},
+				},
+			},
+		},
+		Status: api.K8ssandraClusterStatus{
+			Rebuild: &api.DatacenterRebuildStatus{Datacenter: "dc2"},
+			Datacenters: map[string]api.K8ssandraStatus{
+				"dc1": {Cassandra: &cassdcapi.CassandraDatacenterStatus{
+					Conditions: []cassdcapi.DatacenterCondition{
+						{Type: cassdcapi.DatacenterReady, Status: corev1.ConditionTrue},
+					},
+				}},
+				"dc3": {DecommissionProgress: api.DecommDeleting},
+			},
+		},
+	}
+	markSchemaAgreement(kc, false)
+
+	updateClusterMetrics(kc)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.DatacenterReady.WithLabelValues("metrics-test", "test", "dc1")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.DatacenterReady.WithLabelValues("metrics-test", "test", "dc2")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.DatacenterRebuildInProgress.WithLabelValues("metrics-test", "test", "dc1")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.DatacenterRebuildInProgress.WithLabelValues("metrics-test", "test", "dc2")))
+	assert.Equal(t, float64(metrics.DecommissionDeleting),
+		testutil.ToFloat64(metrics.DatacenterDecommissionProgress.WithLabelValues("metrics-test", "test", "dc3")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.SchemaAgreement.WithLabelValues("metrics-test", "test")))
+
+	deleteClusterMetrics(kc)
+
+	assert.False(t, metrics.DatacenterReady.DeleteLabelValues("metrics-test", "test", "dc1"), "dc1 metrics should have been deleted")
+	assert.False(t, metrics.DatacenterDecommissionProgress.DeleteLabelValues("metrics-test", "test", "dc3"), "dc3 metrics should have been deleted")
+	assert.False(t, metrics.SchemaAgreement.DeleteLabelValues("metrics-test", "test"), "schema agreement metric should have been deleted")
+}
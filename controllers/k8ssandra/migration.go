@@ -0,0 +1,536 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	cassctlapi "github.com/k8ssandra/cass-operator/apis/control/v1alpha1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	kerrors "github.com/k8ssandra/k8ssandra-operator/pkg/errors"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/labels"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// temporaryDatacenterSuffix is appended to the name of a datacenter being moved to another Kubernetes cluster to name
+// the datacenter that holds its replicas in the meantime.
+const temporaryDatacenterSuffix = "-migration"
+
+// reconcileDatacenterMigration moves a datacenter whose k8sContext was changed to its new Kubernetes cluster. A
+// CassandraDatacenter cannot be renamed, so the data goes through a temporary datacenter:
+//
+// 1. the temporary datacenter is created in the target Kubernetes cluster and rebuilt from the datacenter,
+// 2. the datacenter is decommissioned in the source Kubernetes cluster,
+// 3. the datacenter is created in the target Kubernetes cluster and rebuilt from the temporary datacenter,
+// 4. the temporary datacenter is decommissioned.
+//
+// The keyspaces are replicated to a datacenter before it is rebuilt, with as many replicas as in the datacenter it is
+// rebuilt from, and their replicas are removed from a datacenter before it is decommissioned. One datacenter is moved
+// at a time, and the other datacenters are not reconciled until the move is completed.
+func (r *K8ssandraClusterReconciler) reconcileDatacenterMigration(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	systemReplication *cassandra.SystemReplication,
+	logger logr.Logger) result.ReconcileResult {
+
+	if recResult := r.checkDatacenterMigration(ctx, kc, logger); recResult.Completed() {
+		return recResult
+	}
+
+	if kc.Status.Migration == nil || !kc.IsMigrating(kc.Status.Migration.Datacenter) {
+		return result.Continue()
+	}
+	migration := kc.Status.Migration.DeepCopy()
+	defer func() { kc.Status.Migration = migration }()
+
+	idx := -1
+	for i, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == migration.Datacenter {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return result.Error(fmt.Errorf("datacenter %s is being moved but is not part of the cluster", migration.Datacenter))
+	}
+	dcTemplate := kc.Spec.Cassandra.Datacenters[idx]
+
+	logger = logger.WithValues("Datacenter", migration.Datacenter, "SourceContext", migration.SourceContext, "TargetContext", migration.TargetContext)
+	markProgressing(kc, reasonMovingDatacenter, fmt.Sprintf("Moving CassandraDatacenter %s from k8sContext '%s' to '%s': %s",
+		migration.Datacenter, migration.SourceContext, migration.TargetContext, migration.Progress))
+
+	sourceClient, err := r.ClientCache.GetRemoteClient(migration.SourceContext)
+	if err != nil {
+		logger.Error(err, "Failed to get remote client")
+		markRemoteClusterUnreachable(kc, reasonRemoteClientUnavailable, migration.SourceContext, err)
+		return result.Error(err)
+	}
+	targetClient, err := r.ClientCache.GetRemoteClient(migration.TargetContext)
+	if err != nil {
+		logger.Error(err, "Failed to get remote client")
+		markRemoteClusterUnreachable(kc, reasonRemoteClientUnavailable, migration.TargetContext, err)
+		return result.Error(err)
+	}
+
+	seeds, err := r.findMigrationSeeds(ctx, kc, migration, dcTemplate, logger)
+	if err != nil {
+		logger.Error(err, "Failed to find seed nodes")
+		return result.Error(err)
+	}
+
+	switch migration.Progress {
+	case api.MigrationCreatingTemporaryDatacenter, api.MigrationRebuildingTemporaryDatacenter:
+		sourceKey := client.ObjectKey{Namespace: datacenterNamespace(kc, dcTemplate), Name: migration.Datacenter}
+		sourceDc := &cassdcapi.CassandraDatacenter{}
+		if err := sourceClient.Get(ctx, sourceKey, sourceDc); err != nil {
+			logger.Error(err, "Failed to get datacenter")
+			return result.Error(err)
+		}
+		if !cassandra.DatacenterReady(sourceDc) {
+			logger.Info("Waiting for datacenter to be ready before moving it")
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+
+		recResult, tempDc := r.applyTemporaryDatacenter(ctx, kc, migration, idx, seeds, systemReplication, targetClient, logger)
+		if recResult.Completed() {
+			return recResult
+		}
+		migration.Progress = api.MigrationRebuildingTemporaryDatacenter
+
+		if recResult := r.rebuildMigratedDatacenter(ctx, kc, migration, tempDc, sourceDc.Name, targetClient, logger); recResult.Completed() {
+			return recResult
+		}
+		migration.Progress = api.MigrationDecommissioningSource
+		return result.RequeueSoon(r.DefaultDelay)
+
+	case api.MigrationDecommissioningSource:
+		recResult, tempDc := r.applyTemporaryDatacenter(ctx, kc, migration, idx, seeds, systemReplication, targetClient, logger)
+		if recResult.Completed() {
+			return recResult
+		}
+		if recResult := r.removeDatacenterReplication(ctx, kc, tempDc, migration.Datacenter, targetClient, logger); recResult.Completed() {
+			return recResult
+		}
+		if recResult := r.deleteDcResources(ctx, kc, migration.Datacenter, sourceClient, logger); recResult.Completed() {
+			return recResult
+		}
+		sourceKey := client.ObjectKey{Namespace: datacenterNamespace(kc, dcTemplate), Name: migration.Datacenter}
+		if err := sourceClient.Get(ctx, sourceKey, &cassdcapi.CassandraDatacenter{}); err == nil {
+			logger.Info("Waiting for datacenter to be removed from the source Kubernetes cluster")
+			return result.RequeueSoon(r.DefaultDelay)
+		} else if !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get datacenter")
+			return result.Error(err)
+		}
+		logger.Info("Datacenter removed from the source Kubernetes cluster")
+		migration.Progress = api.MigrationCreatingDatacenter
+		return result.RequeueSoon(r.DefaultDelay)
+
+	case api.MigrationCreatingDatacenter, api.MigrationRebuildingDatacenter:
+		recResult, tempDc := r.applyTemporaryDatacenter(ctx, kc, migration, idx, seeds, systemReplication, targetClient, logger)
+		if recResult.Completed() {
+			return recResult
+		}
+
+		dcRec, recResult := r.prepareDatacenter(ctx, kc, idx, systemReplication, logger)
+		if recResult.Completed() {
+			return recResult
+		}
+		recResult, dc := r.applyDatacenter(ctx, kc, dcRec, seeds)
+		if recResult.Completed() {
+			return recResult
+		}
+		migration.Progress = api.MigrationRebuildingDatacenter
+
+		if recResult := r.rebuildMigratedDatacenter(ctx, kc, migration, dc, tempDc.Name, targetClient, dcRec.logger); recResult.Completed() {
+			return recResult
+		}
+		migration.Progress = api.MigrationDecommissioningTemporaryDatacenter
+		return result.RequeueSoon(r.DefaultDelay)
+
+	case api.MigrationDecommissioningTemporaryDatacenter:
+		dcRec, recResult := r.prepareDatacenter(ctx, kc, idx, systemReplication, logger)
+		if recResult.Completed() {
+			return recResult
+		}
+		recResult, dc := r.applyDatacenter(ctx, kc, dcRec, seeds)
+		if recResult.Completed() {
+			return recResult
+		}
+		if recResult := r.removeDatacenterReplication(ctx, kc, dc, migration.TemporaryDatacenter, targetClient, dcRec.logger); recResult.Completed() {
+			return recResult
+		}
+		if recResult := r.deleteDc(ctx, kc, migration.TemporaryDatacenter, targetClient, logger); recResult.Completed() {
+			return recResult
+		}
+	}
+
+	logger.Info("Datacenter moved")
+	now := metav1.Now()
+	migration.Progress = api.MigrationCompleted
+	migration.CompletionTime = &now
+	migration.Message = ""
+	r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonMigrationCompleted, "Moved datacenter %s from k8sContext '%s' to '%s'",
+		migration.Datacenter, migration.SourceContext, migration.TargetContext)
+	return result.Continue()
+}
+
+// checkDatacenterMigration starts moving a datacenter if its CassandraDatacenter is not found in the Kubernetes cluster
+// of its k8sContext, but in another one.
+func (r *K8ssandraClusterReconciler) checkDatacenterMigration(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	if kc.Status.GetConditionStatus(api.CassandraInitialized) != corev1.ConditionTrue {
+		return result.Continue()
+	}
+	if migration := kc.Status.Migration; migration != nil && kc.IsMigrating(migration.Datacenter) {
+		return result.Continue()
+	}
+
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if _, found := kc.Status.Datacenters[dcTemplate.Meta.Name]; !found {
+			// The datacenter is being added to the cluster
+			continue
+		}
+
+		remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+		if err != nil {
+			logger.Error(err, "Failed to get remote client")
+			markRemoteClusterUnreachable(kc, reasonRemoteClientUnavailable, dcTemplate.K8sContext, err)
+			return result.Error(err)
+		}
+
+		dcKey := client.ObjectKey{Namespace: datacenterNamespace(kc, dcTemplate), Name: dcTemplate.Meta.Name}
+		if err := remoteClient.Get(ctx, dcKey, &cassdcapi.CassandraDatacenter{}); err == nil {
+			continue
+		} else if !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get datacenter", "CassandraDatacenter", dcKey)
+			markRemoteClusterUnreachable(kc, reasonRemoteRequestFailed, dcTemplate.K8sContext, err)
+			return result.Error(err)
+		}
+
+		sourceContext, found, err := r.findDatacenterContext(ctx, kc, dcKey, dcTemplate.K8sContext)
+		if err != nil {
+			logger.Error(err, "Failed to look for datacenter in other Kubernetes clusters", "CassandraDatacenter", dcKey)
+			return result.Error(err)
+		}
+		if !found {
+			// The CassandraDatacenter was deleted, it is created again where it is declared
+			continue
+		}
+
+		logger.Info("Starting to move datacenter", "CassandraDatacenter", dcKey, "SourceContext", sourceContext, "TargetContext", dcTemplate.K8sContext)
+		now := metav1.Now()
+		kc.Status.Migration = &api.DatacenterMigrationStatus{
+			Datacenter:          dcTemplate.Meta.Name,
+			SourceContext:       sourceContext,
+			TargetContext:       dcTemplate.K8sContext,
+			TemporaryDatacenter: dcTemplate.Meta.Name + temporaryDatacenterSuffix,
+			Progress:            api.MigrationCreatingTemporaryDatacenter,
+			StartTime:           &now,
+		}
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonMigrationStarted, "Moving datacenter %s from k8sContext '%s' to '%s'",
+			dcTemplate.Meta.Name, sourceContext, dcTemplate.K8sContext)
+		return result.Continue()
+	}
+
+	return result.Continue()
+}
+
+// findDatacenterContext looks for the CassandraDatacenter dcKey of kc in the Kubernetes clusters other than
+// excludedContext, and returns the context of the one where it is found. The remote clusters are searched before
+// the local one.
+func (r *K8ssandraClusterReconciler) findDatacenterContext(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcKey client.ObjectKey,
+	excludedContext string) (string, bool, error) {
+
+	remoteClients := r.ClientCache.GetRemoteClients()
+	contexts := make([]string, 0, len(remoteClients)+1)
+	for k8sContext := range remoteClients {
+		contexts = append(contexts, k8sContext)
+	}
+	sort.Strings(contexts)
+	contexts = append(contexts, "")
+
+	for _, k8sContext := range contexts {
+		if k8sContext == excludedContext {
+			continue
+		}
+		remoteClient, err := r.ClientCache.GetRemoteClient(k8sContext)
+		if err != nil {
+			return "", false, err
+		}
+		dc := &cassdcapi.CassandraDatacenter{}
+		if err := remoteClient.Get(ctx, dcKey, dc); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return "", false, err
+		}
+		if labels.IsPartOf(dc, utils.GetKey(kc)) {
+			return k8sContext, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// findMigrationSeeds returns the seeds of the cluster, including those of the datacenter being moved in the source
+// Kubernetes cluster and those of the temporary datacenter, which are not found from the templates.
+func (r *K8ssandraClusterReconciler) findMigrationSeeds(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	migration *api.DatacenterMigrationStatus,
+	dcTemplate api.CassandraDatacenterTemplate,
+	logger logr.Logger) ([]corev1.Pod, error) {
+
+	seeds, err := r.findSeeds(ctx, kc, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := datacenterNamespace(kc, dcTemplate)
+	sourceSeeds, err := r.findDatacenterSeeds(ctx, kc, client.ObjectKey{Namespace: namespace, Name: migration.Datacenter}, migration.SourceContext, logger)
+	if err != nil {
+		return nil, err
+	}
+	tempSeeds, err := r.findDatacenterSeeds(ctx, kc, client.ObjectKey{Namespace: namespace, Name: migration.TemporaryDatacenter}, migration.TargetContext, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds = append(seeds, sourceSeeds...)
+	return append(seeds, tempSeeds...), nil
+}
+
+// applyTemporaryDatacenter creates or updates the temporary datacenter of migration, and returns it once it is ready.
+// It is configured like the idx-th datacenter of kc, which is being moved.
+func (r *K8ssandraClusterReconciler) applyTemporaryDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	migration *api.DatacenterMigrationStatus,
+	idx int,
+	seeds []corev1.Pod,
+	systemReplication *cassandra.SystemReplication,
+	remoteClient client.Client,
+	logger logr.Logger) (result.ReconcileResult, *cassdcapi.CassandraDatacenter) {
+
+	dcTemplate := kc.Spec.Cassandra.Datacenters[idx].DeepCopy()
+	dcTemplate.Meta.Name = migration.TemporaryDatacenter
+	dcTemplate.K8sContext = migration.TargetContext
+	// The temporary datacenter only holds data, Stargate is deployed once the datacenter is recreated
+	dcTemplate.Stargate = nil
+
+	dcConfig := cassandra.Coalesce(kc.Name, kc.Spec.Cassandra.DeepCopy(), dcTemplate.DeepCopy())
+	// The superuser already exists, the index only matters for it not to be created again
+	desiredDc, err := r.newDesiredDatacenter(ctx, kc, idx+1, dcConfig, systemReplication, remoteClient, logger)
+	if err != nil {
+		return result.Error(err), nil
+	}
+
+	return r.applyDatacenter(ctx, kc, &datacenterReconciliation{
+		template:     *dcTemplate,
+		config:       dcConfig,
+		desiredDc:    desiredDc,
+		remoteClient: remoteClient,
+		logger:       logger.WithValues("CassandraDatacenter", utils.GetKey(desiredDc)),
+	}, seeds)
+}
+
+// rebuildMigratedDatacenter replicates the keyspaces to dc and rebuilds it from srcDc, with the same task as
+// datacenters added to an existing cluster. A failed rebuild task is reported in the status of the migration, and is
+// created again once it is deleted. The result is not completed once dc is rebuilt.
+func (r *K8ssandraClusterReconciler) rebuildMigratedDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	migration *api.DatacenterMigrationStatus,
+	dc *cassdcapi.CassandraDatacenter,
+	srcDc string,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	desiredTask := newRebuildTask(dc.Name, dc.Namespace, srcDc)
+	taskKey := client.ObjectKey{Namespace: desiredTask.Namespace, Name: desiredTask.Name}
+	task := &cassctlapi.CassandraTask{}
+
+	if err := remoteClient.Get(ctx, taskKey, task); err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get rebuild task", "Task", taskKey)
+			return result.Error(err)
+		}
+
+		// The replication must be updated before streaming, for the ranges of dc to be computed with its replicas
+		if recResult := r.copyDatacenterReplication(ctx, kc, dc, srcDc, remoteClient, logger); recResult.Completed() {
+			return recResult
+		}
+
+		logger.Info("Creating rebuild task", "Task", taskKey)
+		if err = remoteClient.Create(ctx, desiredTask); err != nil {
+			logger.Error(err, "Failed to create rebuild task", "Task", taskKey)
+			return result.Error(err)
+		}
+		migration.Message = ""
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonRebuildStarted, "Rebuilding CassandraDatacenter %s from %s", dc.Name, srcDc)
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	if !taskFinished(task) {
+		logger.Info("Waiting for datacenter rebuild to complete", "Task", taskKey)
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	if task.Status.Failed > 0 {
+		message := fmt.Sprintf("rebuild task %s failed, delete it to retry", taskKey.Name)
+		if migration.Message != message {
+			migration.Message = message
+			logger.Error(fmt.Errorf(message), "Datacenter rebuild failed")
+			r.Recorder.Eventf(kc, corev1.EventTypeWarning, eventReasonMigrationRebuildFailed, "Rebuild of CassandraDatacenter %s from %s failed", dc.Name, srcDc)
+		}
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	logger.Info("Datacenter rebuild finished")
+	r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonRebuildFinished, "Rebuild of CassandraDatacenter %s from %s finished", dc.Name, srcDc)
+	return result.Continue()
+}
+
+// copyDatacenterReplication gives dc as many replicas of each keyspace as srcDc has. This includes the system and
+// operator-managed keyspaces, since the datacenter being moved may be the only one that has replicas of them.
+func (r *K8ssandraClusterReconciler) copyDatacenterReplication(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	srcDc string,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	return r.updateDatacenterReplication(ctx, kc, dc, remoteClient, logger, func(replication map[string]int) bool {
+		if replication[srcDc] == 0 || replication[dc.Name] == replication[srcDc] {
+			return false
+		}
+		replication[dc.Name] = replication[srcDc]
+		return true
+	})
+}
+
+// removeDatacenterReplication removes the replicas of all keyspaces from the datacenter dcName, going through the
+// management API of dc.
+func (r *K8ssandraClusterReconciler) removeDatacenterReplication(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	dcName string,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	return r.updateDatacenterReplication(ctx, kc, dc, remoteClient, logger, func(replication map[string]int) bool {
+		if _, found := replication[dcName]; !found {
+			return false
+		}
+		delete(replication, dcName)
+		return true
+	})
+}
+
+// updateDatacenterReplication applies update to the replication of each keyspace that uses NetworkTopologyStrategy,
+// through the management API of dc. update returns false when the replication does not need to be changed.
+func (r *K8ssandraClusterReconciler) updateDatacenterReplication(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger,
+	update func(replication map[string]int) bool) result.ReconcileResult {
+
+	mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, dc, remoteClient, logger)
+	if err != nil {
+		return result.Error(err)
+	}
+
+	keyspaces, err := mgmtApi.ListKeyspaces("")
+	if err != nil {
+		return result.Error(fmt.Errorf("failed to list keyspaces: %v", err))
+	}
+
+	for _, ks := range keyspaces {
+		settings, err := mgmtApi.GetKeyspaceReplication(ks)
+		if err != nil {
+			return result.Error(fmt.Errorf("failed to get replication for keyspace (%s): %v", ks, err))
+		}
+		if settings["class"] != cassandra.NetworkTopology {
+			continue
+		}
+		replication, err := parseKeyspaceReplication(settings)
+		if err != nil {
+			return result.Error(fmt.Errorf("failed to parse replication for keyspace (%s): %v", ks, err))
+		}
+		if !update(replication) {
+			continue
+		}
+
+		logger.Info("Updating keyspace replication", "Keyspace", ks, "Replication", replication)
+		if err = mgmtApi.AlterKeyspace(ks, replication); err != nil {
+			if kerrors.IsSchemaDisagreement(err) {
+				markSchemaAgreement(kc, false)
+				return result.RequeueSoon(r.DefaultDelay)
+			}
+			return result.Error(fmt.Errorf("failed to update replication for keyspace (%s): %v", ks, err))
+		}
+	}
+
+	return result.Continue()
+}
+
+// deleteMigrationDatacenters deletes the CassandraDatacenters of a move in progress that are not where the spec of kc
+// declares them. It returns true if an error occurred.
+func (r *K8ssandraClusterReconciler) deleteMigrationDatacenters(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) bool {
+	migration := kc.Status.Migration
+	if migration == nil || !kc.IsMigrating(migration.Datacenter) {
+		return false
+	}
+
+	namespace := kc.Namespace
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == migration.Datacenter {
+			namespace = datacenterNamespace(kc, dcTemplate)
+		}
+	}
+
+	hasErrors := false
+	for _, dc := range []struct{ name, k8sContext string }{
+		{migration.Datacenter, migration.SourceContext},
+		{migration.TemporaryDatacenter, migration.TargetContext},
+	} {
+		dcKey := client.ObjectKey{Namespace: namespace, Name: dc.name}
+		remoteClient, err := r.ClientCache.GetRemoteClient(dc.k8sContext)
+		if err != nil {
+			logger.Error(err, "Failed to get remote client", "Context", dc.k8sContext)
+			hasErrors = true
+			continue
+		}
+		cassdc := &cassdcapi.CassandraDatacenter{}
+		if err = remoteClient.Get(ctx, dcKey, cassdc); err != nil {
+			if !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to get CassandraDatacenter for deletion", "CassandraDatacenter", dcKey, "Context", dc.k8sContext)
+				hasErrors = true
+			}
+		} else if err = remoteClient.Delete(ctx, cassdc); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete CassandraDatacenter", "CassandraDatacenter", dcKey, "Context", dc.k8sContext)
+			hasErrors = true
+		}
+	}
+	return hasErrors
+}
+
+func datacenterNamespace(kc *api.K8ssandraCluster, dcTemplate api.CassandraDatacenterTemplate) string {
+	if dcTemplate.Meta.Namespace != "" {
+		return dcTemplate.Meta.Namespace
+	}
+	return kc.Namespace
+}
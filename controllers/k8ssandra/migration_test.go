@@ -0,0 +1,166 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	cassctlapi "github.com/k8ssandra/cass-operator/apis/control/v1alpha1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/k8ssandra"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestTemporaryDatacenterNotDecommissioned(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{{Meta: api.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+		},
+		Status: api.K8ssandraClusterStatus{
+			Datacenters: map[string]api.K8ssandraStatus{"dc1": {}, "dc1-migration": {}},
+			Migration: &api.DatacenterMigrationStatus{
+				Datacenter:          "dc1",
+				TargetContext:       "cluster-1",
+				TemporaryDatacenter: "dc1-migration",
+				Progress:            api.MigrationDecommissioningSource,
+			},
+		},
+	}
+	assert.True(t, kc.IsMigrating("dc1"))
+	assert.True(t, kc.IsTemporaryDatacenter("dc1-migration"))
+	assert.Empty(t, k8ssandra.GetDatacenterForDecommission(kc))
+
+	kc.Status.Migration.Progress = api.MigrationCompleted
+	assert.False(t, kc.IsMigrating("dc1"))
+	assert.Equal(t, "dc1-migration", k8ssandra.GetDatacenterForDecommission(kc))
+}
+
+// moveDatacenter verifies that changing the k8sContext of a datacenter moves it to the new Kubernetes cluster through
+// a temporary datacenter.
+func moveDatacenter(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	testMoveDatacenter(t, ctx, f, namespace, k8sCtx0)
+}
+
+// moveLocalDatacenter verifies that a datacenter deployed in the Kubernetes cluster of the operator, i.e. without
+// k8sContext, is removed from it when it is moved to a remote Kubernetes cluster.
+func moveLocalDatacenter(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	testMoveDatacenter(t, ctx, f, namespace, "")
+}
+
+// testMoveDatacenter moves dc1 from sourceContext to k8sCtx1. The local Kubernetes cluster of the tests is k8sCtx0.
+func testMoveDatacenter(t *testing.T, ctx context.Context, f *framework.Framework, namespace, sourceContext string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "migration-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: sourceContext, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{"system_auth", "ks1"}, nil)
+	mockMgmtApi.On(testutils.GetKeyspaceReplication, mock.Anything).Return(map[string]string{"class": cassandra.NetworkTopology, "dc1": "3"}, nil)
+	mockMgmtApi.On(testutils.AlterKeyspace, mock.Anything, mock.Anything).Return(nil)
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	sourceKey := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, sourceKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, sourceKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+
+	t.Log("move dc1 to another Kubernetes cluster")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Spec.Cassandra.Datacenters[0].K8sContext = k8sCtx1
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	t.Log("check that the temporary datacenter is created and rebuilt from dc1")
+	tempKey := framework.NewClusterKey(k8sCtx1, namespace, "dc1-migration")
+	require.Eventually(f.DatacenterExists(ctx, tempKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, tempKey)
+	require.NoError(err, "failed to set dc1-migration status ready")
+
+	tempTaskKey := framework.NewClusterKey(k8sCtx1, namespace, "dc1-migration-rebuild")
+	require.Eventually(func() bool {
+		return f.Get(ctx, tempTaskKey, &cassctlapi.CassandraTask{}) == nil
+	}, timeout, interval, "failed to get the rebuild task of dc1-migration")
+	mockMgmtApi.AssertCalled(t, testutils.AlterKeyspace, "ks1", map[string]int{"dc1": 3, "dc1-migration": 3})
+	setRebuildTaskFinished(ctx, t, f, tempTaskKey)
+
+	t.Log("check that dc1 is removed from the source Kubernetes cluster")
+	f.AssertObjectDoesNotExist(ctx, t, sourceKey, &cassdcapi.CassandraDatacenter{}, timeout, interval)
+
+	t.Log("check that dc1 is created in the target Kubernetes cluster and rebuilt")
+	targetKey := framework.NewClusterKey(k8sCtx1, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, targetKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, targetKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	targetTaskKey := framework.NewClusterKey(k8sCtx1, namespace, "dc1-rebuild")
+	require.Eventually(func() bool {
+		return f.Get(ctx, targetTaskKey, &cassctlapi.CassandraTask{}) == nil
+	}, timeout, interval, "failed to get the rebuild task of dc1")
+	task := &cassctlapi.CassandraTask{}
+	require.NoError(f.Get(ctx, targetTaskKey, task))
+	assert.Equal(t, "dc1-migration", task.Spec.Jobs[0].Arguments["source_datacenter"])
+	setRebuildTaskFinished(ctx, t, f, targetTaskKey)
+
+	t.Log("check that the temporary datacenter is removed")
+	f.AssertObjectDoesNotExist(ctx, t, tempKey, &cassdcapi.CassandraDatacenter{}, timeout, interval)
+
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		migration := kc.Status.Migration
+		return migration != nil && migration.Progress == api.MigrationCompleted
+	}, timeout, interval, "timed out waiting for the move to complete")
+	if sourceContext != "" {
+		assert.Equal(t, sourceContext, kc.Status.Migration.SourceContext)
+	}
+	assert.NotContains(t, kc.Status.Datacenters, "dc1-migration")
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
@@ -0,0 +1,187 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// reconcileDeployedDatacenters reconciles the datacenters of kc that are already deployed when
+// ParallelDatacenterReconciliation is enabled. The CassandraDatacenters are created or updated concurrently, each one
+// with its own copy of kc whose status is merged back afterwards. Desired states are computed beforehand, and keyspace
+// replication, rebuilds and upgrades are reconciled afterwards, one datacenter at a time, as they must be coordinated
+// across the cluster.
+//
+// The returned datacenters are indexed like kc.Spec.Cassandra.Datacenters, the entries of the datacenters that are not
+// deployed yet are nil. The result is only Continue if all the deployed datacenters are reconciled.
+func (r *K8ssandraClusterReconciler) reconcileDeployedDatacenters(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	seeds []corev1.Pod,
+	systemReplication *cassandra.SystemReplication,
+	logger logr.Logger) (result.ReconcileResult, []*cassdcapi.CassandraDatacenter) {
+
+	dcTemplates := kc.Spec.Cassandra.Datacenters
+	deployed := make([]bool, len(dcTemplates))
+	dcRecs := make([]*datacenterReconciliation, len(dcTemplates))
+	results := make([]result.ReconcileResult, len(dcTemplates))
+	messages := make([]string, len(dcTemplates))
+	actualDcs := make([]*cassdcapi.CassandraDatacenter, len(dcTemplates))
+
+	for idx, dcTemplate := range dcTemplates {
+		if deployed[idx] = datacenterDeployed(kc, dcTemplate.Meta.Name); deployed[idx] {
+			dcRecs[idx], results[idx] = r.prepareDatacenter(ctx, kc, idx, systemReplication, logger)
+			messages[idx] = notReadyMessage(kc)
+		}
+	}
+
+	dcKcs := make([]*api.K8ssandraCluster, len(dcTemplates))
+	wg := sync.WaitGroup{}
+	for idx := range dcTemplates {
+		if !deployed[idx] || results[idx].Completed() {
+			continue
+		}
+		idx := idx
+		dcKcs[idx] = kc.DeepCopy()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[idx], actualDcs[idx] = r.applyDatacenter(ctx, dcKcs[idx], dcRecs[idx], seeds)
+			messages[idx] = notReadyMessage(dcKcs[idx])
+		}()
+	}
+	wg.Wait()
+
+	// The first datacenters in the spec take precedence for the conditions, like they do when datacenters are
+	// reconciled sequentially.
+	initialStatus := kc.Status.DeepCopy()
+	for idx := len(dcTemplates) - 1; idx >= 0; idx-- {
+		if dcKcs[idx] != nil {
+			mergeDatacenterStatus(kc, dcKcs[idx], initialStatus, dcTemplates[idx].Meta.Name)
+		}
+	}
+
+	blockingDc := ""
+	for idx, dcTemplate := range dcTemplates {
+		if !deployed[idx] || results[idx].Completed() {
+			continue
+		}
+		if blockingDc != "" {
+			results[idx] = result.RequeueSoon(r.DefaultDelay)
+			messages[idx] = fmt.Sprintf("Waiting for CassandraDatacenter %s to be reconciled", blockingDc)
+			continue
+		}
+		results[idx] = r.reconcileReadyDatacenter(ctx, kc, actualDcs[idx], dcRecs[idx].remoteClient, dcRecs[idx].logger)
+		if results[idx].Completed() {
+			blockingDc = dcTemplate.Meta.Name
+			messages[idx] = notReadyMessage(kc)
+		}
+	}
+
+	now := metav1.Now()
+	var errs []error
+	var requeueAfter time.Duration
+	done := false
+	for idx, dcTemplate := range dcTemplates {
+		if !deployed[idx] {
+			continue
+		}
+		dcName := dcTemplate.Meta.Name
+		if !results[idx].Completed() {
+			setDatacenterReconciliation(kc, dcName, api.DatacenterReconciled, "", now)
+			continue
+		}
+		actualDcs[idx] = nil
+		if err := resultError(results[idx]); err != nil {
+			errs = append(errs, fmt.Errorf("CassandraDatacenter %s: %v", dcName, err))
+			setDatacenterReconciliation(kc, dcName, api.DatacenterReconcileFailed, err.Error(), now)
+			continue
+		}
+		setDatacenterReconciliation(kc, dcName, api.DatacenterReconciling, messages[idx], now)
+		if res, _ := results[idx].Output(); res.RequeueAfter == 0 {
+			done = true
+		} else if requeueAfter == 0 || res.RequeueAfter < requeueAfter {
+			requeueAfter = res.RequeueAfter
+		}
+	}
+
+	switch {
+	case len(errs) > 0:
+		return result.Error(utilerrors.NewAggregate(errs)), actualDcs
+	case requeueAfter > 0:
+		return result.RequeueSoon(requeueAfter), actualDcs
+	case done:
+		return result.Done(), actualDcs
+	}
+	return result.Continue(), actualDcs
+}
+
+// datacenterDeployed returns true if the CassandraDatacenter was found in a previous reconciliation.
+func datacenterDeployed(kc *api.K8ssandraCluster, dcName string) bool {
+	status, found := kc.Status.Datacenters[dcName]
+	return found && status.Cassandra != nil
+}
+
+// mergeDatacenterStatus copies the changes made to the status of dcKc, a copy of kc used to reconcile datacenter
+// dcName, back into kc. initialStatus is the status of kc when the copy was made.
+func mergeDatacenterStatus(kc, dcKc *api.K8ssandraCluster, initialStatus *api.K8ssandraClusterStatus, dcName string) {
+	if dcStatus, found := dcKc.Status.Datacenters[dcName]; found {
+		if kc.Status.Datacenters == nil {
+			kc.Status.Datacenters = make(map[string]api.K8ssandraStatus)
+		}
+		kc.Status.Datacenters[dcName] = dcStatus
+	}
+
+	for _, condition := range dcKc.Status.Conditions {
+		initial := initialStatus.GetCondition(condition.Type)
+		if initial == nil || initial.Status != condition.Status || initial.Reason != condition.Reason || initial.Message != condition.Message {
+			kc.Status.SetCondition(*condition.DeepCopy())
+		}
+	}
+}
+
+func setDatacenterReconciliation(kc *api.K8ssandraCluster, dcName string, reconcileResult api.DatacenterReconcileResult, message string, now metav1.Time) {
+	dcStatus := kc.Status.Datacenters[dcName]
+	reconciliation := &api.DatacenterReconciliationStatus{
+		Result:            reconcileResult,
+		Message:           message,
+		LastReconcileTime: &now,
+	}
+	if dcStatus.Reconciliation != nil {
+		reconciliation.ObservedGeneration = dcStatus.Reconciliation.ObservedGeneration
+	}
+	if reconcileResult == api.DatacenterReconciled {
+		reconciliation.ObservedGeneration = kc.Generation
+	}
+	dcStatus.Reconciliation = reconciliation
+	kc.Status.Datacenters[dcName] = dcStatus
+}
+
+// clearDatacenterReconciliation removes the outcomes of parallel reconciliations from the status of kc, as they are
+// not updated when datacenters are reconciled sequentially.
+func clearDatacenterReconciliation(kc *api.K8ssandraCluster) {
+	for dcName, dcStatus := range kc.Status.Datacenters {
+		if dcStatus.Reconciliation != nil {
+			dcStatus.Reconciliation = nil
+			kc.Status.Datacenters[dcName] = dcStatus
+		}
+	}
+}
+
+// notReadyMessage returns the message explaining why kc is not ready, if any.
+func notReadyMessage(kc *api.K8ssandraCluster) string {
+	if condition := kc.Status.GetCondition(api.ClusterReady); condition != nil && condition.Status == corev1.ConditionFalse {
+		return condition.Message
+	}
+	return ""
+}
@@ -0,0 +1,143 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileDatacentersInParallel verifies that with ParallelDatacenterReconciliation, changes are applied to a
+// datacenter while another one is not ready, and that the outcome is reported per datacenter.
+func reconcileDatacentersInParallel(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "parallel-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion:                    "4.0.3",
+				ParallelDatacenterReconciliation: true,
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, K8sContext: k8sCtx1, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dc1Key := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	dc2Key := framework.NewClusterKey(k8sCtx1, namespace, "dc2")
+
+	t.Log("check that dc1 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc1Key), timeout, interval)
+
+	t.Log("check that dc2 is not created before dc1 is ready")
+	require.Never(f.DatacenterExists(ctx, dc2Key), timeout, interval)
+
+	err = f.SetDatacenterStatusReady(ctx, dc1Key)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	t.Log("check that dc2 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc2Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc2Key)
+	require.NoError(err, "failed to set dc2 status ready")
+
+	t.Log("wait for the CassandraInitialized condition to be set")
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+
+	t.Log("make dc1 not ready")
+	err = f.PatchDatacenterStatus(ctx, dc1Key, func(dc *cassdcapi.CassandraDatacenter) {
+		dc.SetCondition(cassdcapi.DatacenterCondition{
+			Type:               cassdcapi.DatacenterReady,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+		})
+	})
+	require.NoError(err, "failed to patch dc1 status")
+
+	t.Log("scale up dc2")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Spec.Cassandra.Datacenters[1].Size = 4
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	t.Log("check that dc2 is updated while dc1 is not ready")
+	require.Eventually(f.NewWithDatacenter(ctx, dc2Key)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 4
+	}), timeout, interval, "timed out waiting for dc2 size to be updated")
+
+	t.Log("check that the outcome is reported per datacenter")
+	require.Eventually(func() bool {
+		kc := &api.K8ssandraCluster{}
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			t.Logf("failed to get K8ssandraCluster: %v", err)
+			return false
+		}
+		dc1 := kc.Status.Datacenters["dc1"].Reconciliation
+		dc2 := kc.Status.Datacenters["dc2"].Reconciliation
+		return dc1 != nil && dc1.Result == api.DatacenterReconciling &&
+			dc2 != nil && dc2.Result == api.DatacenterReconciled && dc2.ObservedGeneration == kc.Generation
+	}, timeout, interval, "timed out waiting for datacenters reconciliation status")
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
+
+func TestMergeDatacenterStatus(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		Status: api.K8ssandraClusterStatus{
+			Datacenters: map[string]api.K8ssandraStatus{
+				"dc1": {Cassandra: &cassdcapi.CassandraDatacenterStatus{}},
+				"dc2": {Cassandra: &cassdcapi.CassandraDatacenterStatus{}},
+			},
+		},
+	}
+	kc.Status.SetConditionStatus(api.ClusterReady, corev1.ConditionTrue, reasonReconciled, "")
+	initialStatus := kc.Status.DeepCopy()
+
+	dc1Kc := kc.DeepCopy()
+	markProgressing(dc1Kc, reasonWaitingForDatacenter, "Waiting for CassandraDatacenter dc1")
+	dc1Kc.Status.Datacenters["dc1"] = api.K8ssandraStatus{Cassandra: &cassdcapi.CassandraDatacenterStatus{NodeStatuses: cassdcapi.CassandraStatusMap{"node": {}}}}
+
+	dc2Kc := kc.DeepCopy()
+	markProgressing(dc2Kc, reasonUpdatingDatacenter, "Updating CassandraDatacenter dc2")
+
+	// Merged in reverse order so that dc1 takes precedence
+	mergeDatacenterStatus(kc, dc2Kc, initialStatus, "dc2")
+	mergeDatacenterStatus(kc, dc1Kc, initialStatus, "dc1")
+
+	assert.Len(t, kc.Status.Datacenters["dc1"].Cassandra.NodeStatuses, 1)
+	assert.Equal(t, corev1.ConditionFalse, kc.Status.GetConditionStatus(api.ClusterReady))
+	assert.Equal(t, "Waiting for CassandraDatacenter dc1", notReadyMessage(kc))
+
+	setDatacenterReconciliation(kc, "dc1", api.DatacenterReconciling, notReadyMessage(kc), metav1.Now())
+	assert.Equal(t, api.DatacenterReconciling, kc.Status.Datacenters["dc1"].Reconciliation.Result)
+
+	clearDatacenterReconciliation(kc)
+	assert.Nil(t, kc.Status.Datacenters["dc1"].Reconciliation)
+}
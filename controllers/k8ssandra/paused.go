@@ -0,0 +1,94 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/stargate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkPaused stops the reconciliation of kc when it has the PausedAnnotation. The status of the datacenters is still
+// refreshed, but nothing else is created, updated or deleted, including the finalizer of a K8ssandraCluster being
+// deleted. The CassandraDatacenters keep running, stopping them is done with the Stopped field of their template.
+func (r *K8ssandraClusterReconciler) checkPaused(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	wasPaused := kc.Status.GetConditionStatus(api.ClusterPaused) == corev1.ConditionTrue
+
+	if !annotations.IsPaused(kc) {
+		if wasPaused {
+			logger.Info("Reconciliation resumed")
+			kc.Status.SetConditionStatus(api.ClusterPaused, corev1.ConditionFalse, reasonResumed, "")
+			r.Recorder.Event(kc, corev1.EventTypeNormal, eventReasonResumed, "Reconciliation resumed")
+		}
+		return result.Continue()
+	}
+
+	logger.Info("Reconciliation is paused, only refreshing the status")
+	if !wasPaused {
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonPaused, "Reconciliation paused with the %s annotation", api.PausedAnnotation)
+	}
+	kc.Status.SetConditionStatus(api.ClusterPaused, corev1.ConditionTrue, reasonPaused,
+		fmt.Sprintf("Reconciliation paused with the %s annotation", api.PausedAnnotation))
+
+	if kc.Spec.Cassandra != nil {
+		for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+			if err := r.refreshDatacenterStatus(ctx, kc, dcTemplate); err != nil {
+				logger.Error(err, "Failed to refresh the status of the datacenter", "CassandraDatacenter", dcTemplate.Meta.Name)
+				return result.Error(err)
+			}
+		}
+	}
+	return result.Done()
+}
+
+// refreshDatacenterStatus copies the status of the CassandraDatacenter, Stargate and Reaper objects of dcTemplate, if
+// they exist, into the status of kc.
+func (r *K8ssandraClusterReconciler) refreshDatacenterStatus(ctx context.Context, kc *api.K8ssandraCluster, dcTemplate api.CassandraDatacenterTemplate) error {
+	remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+	if err != nil {
+		markRemoteClusterUnreachable(kc, reasonRemoteClientUnavailable, dcTemplate.K8sContext, err)
+		return err
+	}
+
+	namespace := dcTemplate.Meta.Namespace
+	if namespace == "" {
+		namespace = kc.Namespace
+	}
+	dc := &cassdcapi.CassandraDatacenter{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: dcTemplate.Meta.Name}, dc); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	r.setStatusForDatacenter(kc, dc)
+
+	sg := &stargateapi.Stargate{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: stargate.ResourceName(dc)}, sg); err == nil {
+		if err := r.setStatusForStargate(kc, sg, dc.Name); err != nil {
+			return err
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	rp := &reaperapi.Reaper{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: reaper.DefaultResourceName(dc)}, rp); err == nil {
+		if err := r.setStatusForReaper(kc, rp, dc.Name); err != nil {
+			return err
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pauseCluster verifies that spec changes are not applied to the datacenters of a paused K8ssandraCluster, while its
+// status keeps reflecting the status of the datacenters.
+func pauseCluster(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "paused-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dcKey := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, dcKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.ClusterReady) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for the cluster to be ready")
+
+	t.Log("pause the cluster and scale up dc1")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Annotations = map[string]string{api.PausedAnnotation: "true"}
+	kc.Spec.Cassandra.Datacenters[0].Size = 4
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.ClusterPaused) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for the Paused condition")
+	require.Eventually(f.EventRecorded(ctx, framework.ClusterKey{K8sContext: k8sCtx0, NamespacedName: kcKey}, eventReasonPaused), timeout, interval,
+		"timed out waiting for Paused event on K8ssandraCluster")
+
+	t.Log("check that dc1 is not updated while paused")
+	require.Never(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 4
+	}), timeout, interval, "dc1 should not be updated while paused")
+
+	t.Log("check that the status of dc1 is still refreshed")
+	err = f.PatchDatacenterStatus(ctx, dcKey, func(dc *cassdcapi.CassandraDatacenter) {
+		dc.Status.CassandraOperatorProgress = cassdcapi.ProgressUpdating
+	})
+	require.NoError(err, "failed to patch dc1 status")
+	require.Eventually(func() bool {
+		kc := &api.K8ssandraCluster{}
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		dcStatus := kc.Status.Datacenters["dc1"].Cassandra
+		return dcStatus != nil && dcStatus.CassandraOperatorProgress == cassdcapi.ProgressUpdating
+	}, timeout, interval, "timed out waiting for dc1 status to be refreshed")
+
+	t.Log("resume the cluster")
+	err = f.Client.Get(ctx, kcKey, kc)
+	require.NoError(err, "failed to get K8ssandraCluster")
+	patch = client.MergeFrom(kc.DeepCopy())
+	delete(kc.Annotations, api.PausedAnnotation)
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	require.Eventually(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 4
+	}), timeout, interval, "timed out waiting for dc1 size to be updated")
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.ClusterPaused) == corev1.ConditionFalse
+	}, timeout, interval, "timed out waiting for the Paused condition to be cleared")
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
@@ -0,0 +1,265 @@
+package k8ssandra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/labels"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/plan"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/stargate"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkDryRun computes the plan of kc instead of reconciling it when the DryRunAnnotation is set. The plan is removed
+// from the status once the annotation is removed.
+func (r *K8ssandraClusterReconciler) checkDryRun(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	if !annotations.HasAnnotationWithValue(kc, api.DryRunAnnotation, "true") {
+		kc.Status.Plan = nil
+		return result.Continue()
+	}
+
+	logger.Info("Dry-run mode, computing the changes without applying them")
+	newPlan, err := r.computePlan(ctx, kc, logger)
+	if err != nil {
+		logger.Error(err, "Failed to compute the plan")
+		return result.Error(err)
+	}
+
+	if oldPlan := kc.Status.Plan; oldPlan == nil ||
+		oldPlan.ObservedGeneration != newPlan.ObservedGeneration ||
+		oldPlan.RequiresRollingRestart != newPlan.RequiresRollingRestart ||
+		!equality.Semantic.DeepEqual(oldPlan.Changes, newPlan.Changes) {
+		now := metav1.Now()
+		newPlan.ComputedTime = &now
+		kc.Status.Plan = newPlan
+		r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonPlanComputed,
+			"Planned %d change(s) for generation %d, rolling restart required: %t", len(newPlan.Changes), kc.Generation, newPlan.RequiresRollingRestart)
+	}
+	return result.Done()
+}
+
+// computePlan compares the objects that reconciling kc would apply with the ones that exist in each Kubernetes
+// cluster. Objects are compared the same way they are when reconciling, i.e. with their hash annotation. Version
+// upgrades are planned as if they could start right away.
+func (r *K8ssandraClusterReconciler) computePlan(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) (*api.K8ssandraClusterPlan, error) {
+	kcPlan := &api.K8ssandraClusterPlan{ObservedGeneration: kc.Generation}
+	if kc.Spec.Cassandra == nil {
+		return kcPlan, nil
+	}
+
+	systemReplication, err := plannedSystemReplication(kc)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+		if err != nil {
+			markRemoteClusterUnreachable(kc, reasonRemoteClientUnavailable, dcTemplate.K8sContext, err)
+			return nil, err
+		}
+
+		dcConfig := cassandra.Coalesce(kc.Name, kc.Spec.Cassandra.DeepCopy(), dcTemplate.DeepCopy())
+		desiredDc, err := r.newDesiredDatacenter(ctx, kc, idx, dcConfig, systemReplication, remoteClient, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		actualDc := &cassdcapi.CassandraDatacenter{}
+		if found, err := getPlannedObject(ctx, remoteClient, utils.GetKey(desiredDc), actualDc); err != nil {
+			return nil, err
+		} else if !found {
+			kcPlan.Changes = append(kcPlan.Changes, newPlannedChange("CassandraDatacenter", dcTemplate.K8sContext, desiredDc, api.PlannedActionCreate))
+		} else if !annotations.CompareHashAnnotations(actualDc, desiredDc) {
+			change, fieldChanges, err := plannedUpdate("CassandraDatacenter", dcTemplate.K8sContext, desiredDc, actualDc.Spec, desiredDc.Spec)
+			if err != nil {
+				return nil, err
+			}
+			// The hashes differ, changes that the diff does not show are treated as requiring a restart
+			change.RequiresRollingRestart = len(fieldChanges) == 0 || plan.DatacenterRequiresRollingRestart(fieldChanges)
+			kcPlan.RequiresRollingRestart = kcPlan.RequiresRollingRestart || change.RequiresRollingRestart
+			kcPlan.Changes = append(kcPlan.Changes, *change)
+		}
+
+		changes, err := r.planStargate(ctx, kc, dcTemplate, desiredDc, remoteClient, logger)
+		if err != nil {
+			return nil, err
+		}
+		kcPlan.Changes = append(kcPlan.Changes, changes...)
+
+		changes, err = r.planReaper(ctx, kc, dcTemplate, desiredDc, remoteClient, logger)
+		if err != nil {
+			return nil, err
+		}
+		kcPlan.Changes = append(kcPlan.Changes, changes...)
+	}
+
+	// Datacenters that were removed from the spec are decommissioned
+	removedDcs := make([]string, 0)
+	for dcName, dcStatus := range kc.Status.Datacenters {
+		if dcStatus.Cassandra != nil && !specHasDatacenter(kc, dcName) && !kc.IsTemporaryDatacenter(dcName) {
+			removedDcs = append(removedDcs, dcName)
+		}
+	}
+	sort.Strings(removedDcs)
+	for _, dcName := range removedDcs {
+		kcPlan.Changes = append(kcPlan.Changes, api.PlannedChange{
+			Kind:      "CassandraDatacenter",
+			Namespace: kc.Namespace,
+			Name:      dcName,
+			Action:    api.PlannedActionDelete,
+		})
+	}
+	return kcPlan, nil
+}
+
+func (r *K8ssandraClusterReconciler) planStargate(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcTemplate api.CassandraDatacenterTemplate,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger) ([]api.PlannedChange, error) {
+
+	stargateKey := types.NamespacedName{Namespace: dc.Namespace, Name: stargate.ResourceName(dc)}
+	actualStargate := &stargateapi.Stargate{}
+	found, err := getPlannedObject(ctx, remoteClient, stargateKey, actualStargate)
+	if err != nil {
+		return nil, err
+	}
+
+	stargateTemplate := stargateTemplateFor(kc, dcTemplate, dc, logger)
+	if stargateTemplate == nil {
+		if found && labels.IsPartOf(actualStargate, utils.GetKey(kc)) {
+			return []api.PlannedChange{newPlannedChange("Stargate", dcTemplate.K8sContext, actualStargate, api.PlannedActionDelete)}, nil
+		}
+		return nil, nil
+	}
+
+	desiredStargate := r.newStargate(stargateKey, kc, stargateTemplate, dc, dcTemplate, logger)
+	annotations.AddHashAnnotation(desiredStargate)
+	if !found {
+		return []api.PlannedChange{newPlannedChange("Stargate", dcTemplate.K8sContext, desiredStargate, api.PlannedActionCreate)}, nil
+	} else if !annotations.CompareHashAnnotations(desiredStargate, actualStargate) {
+		change, _, err := plannedUpdate("Stargate", dcTemplate.K8sContext, desiredStargate, actualStargate.Spec, desiredStargate.Spec)
+		if err != nil {
+			return nil, err
+		}
+		// Any change to the spec, including one the diff does not show, restarts the pods
+		change.RequiresRollingRestart = true
+		return []api.PlannedChange{*change}, nil
+	}
+	return nil, nil
+}
+
+func (r *K8ssandraClusterReconciler) planReaper(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcTemplate api.CassandraDatacenterTemplate,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger) ([]api.PlannedChange, error) {
+
+	reaperKey := types.NamespacedName{Namespace: dc.Namespace, Name: reaper.DefaultResourceName(dc)}
+	actualReaper := &reaperapi.Reaper{}
+	found, err := getPlannedObject(ctx, remoteClient, reaperKey, actualReaper)
+	if err != nil {
+		return nil, err
+	}
+
+	reaperTemplate := reaperTemplateFor(kc, dc, logger)
+	if reaperTemplate == nil {
+		if found && labels.IsPartOf(actualReaper, utils.GetKey(kc)) {
+			return []api.PlannedChange{newPlannedChange("Reaper", dcTemplate.K8sContext, actualReaper, api.PlannedActionDelete)}, nil
+		}
+		return nil, nil
+	}
+
+	desiredReaper := reaper.NewReaper(reaperKey, kc, dc, reaperTemplate)
+	if !found {
+		return []api.PlannedChange{newPlannedChange("Reaper", dcTemplate.K8sContext, desiredReaper, api.PlannedActionCreate)}, nil
+	} else if !annotations.CompareHashAnnotations(actualReaper, desiredReaper) {
+		change, _, err := plannedUpdate("Reaper", dcTemplate.K8sContext, desiredReaper, actualReaper.Spec, desiredReaper.Spec)
+		if err != nil {
+			return nil, err
+		}
+		// Any change to the spec, including one the diff does not show, restarts the pods
+		change.RequiresRollingRestart = true
+		return []api.PlannedChange{*change}, nil
+	}
+	return nil, nil
+}
+
+// getPlannedObject gets the object with the given key, and returns false if it does not exist.
+func getPlannedObject(ctx context.Context, remoteClient client.Client, key client.ObjectKey, obj client.Object) (bool, error) {
+	if err := remoteClient.Get(ctx, key, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func newPlannedChange(kind, k8sContext string, obj client.Object, action api.PlannedAction) api.PlannedChange {
+	return api.PlannedChange{
+		Kind:       kind,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		K8sContext: k8sContext,
+		Action:     action,
+	}
+}
+
+// plannedUpdate returns the update of desired, along with the differences between actualSpec and desiredSpec.
+func plannedUpdate(kind, k8sContext string, desired client.Object, actualSpec, desiredSpec interface{}) (*api.PlannedChange, []plan.FieldChange, error) {
+	fieldChanges, err := plan.Diff(actualSpec, desiredSpec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compute the changes of %s %s: %v", kind, desired.GetName(), err)
+	}
+	change := newPlannedChange(kind, k8sContext, desired, api.PlannedActionUpdate)
+	for _, fieldChange := range fieldChanges {
+		change.Diff = append(change.Diff, fieldChange.String())
+	}
+	return &change, fieldChanges, nil
+}
+
+// plannedSystemReplication returns the replication of the system keyspaces without setting the
+// InitialSystemReplicationAnnotation, see checkInitialSystemReplication.
+func plannedSystemReplication(kc *api.K8ssandraCluster) (*cassandra.SystemReplication, error) {
+	if val := annotations.GetAnnotation(kc, api.InitialSystemReplicationAnnotation); val != "" {
+		replication := &cassandra.SystemReplication{}
+		if err := json.Unmarshal([]byte(val), replication); err != nil {
+			return nil, err
+		}
+		return replication, nil
+	}
+	replication := cassandra.ComputeInitialSystemReplication(kc)
+	return &replication, nil
+}
+
+func specHasDatacenter(kc *api.K8ssandraCluster, dcName string) bool {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName {
+			return true
+		}
+	}
+	return false
+}
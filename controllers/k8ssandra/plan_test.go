@@ -0,0 +1,203 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// planClusterChanges verifies that the changes made to a K8ssandraCluster in dry-run mode are reported in its status
+// without being applied, and that they are applied once the dry-run mode is turned off.
+func planClusterChanges(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "plan-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dcKey := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, dcKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.ClusterReady) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for the cluster to be ready")
+
+	planFor := func(generation int64) func() *api.K8ssandraClusterPlan {
+		return func() *api.K8ssandraClusterPlan {
+			kc := &api.K8ssandraCluster{}
+			if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+				t.Logf("failed to get K8ssandraCluster: %v", err)
+				return nil
+			}
+			if kc.Status.Plan == nil || kc.Status.Plan.ObservedGeneration != generation {
+				return nil
+			}
+			return kc.Status.Plan
+		}
+	}
+
+	t.Log("turn on the dry-run mode and scale up dc1")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Annotations = map[string]string{api.DryRunAnnotation: "true"}
+	kc.Spec.Cassandra.Datacenters[0].Size = 4
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	var plan *api.K8ssandraClusterPlan
+	require.Eventually(func() bool {
+		plan = planFor(kc.Generation)()
+		return plan != nil
+	}, timeout, interval, "timed out waiting for the plan")
+	require.False(plan.RequiresRollingRestart)
+	require.Len(plan.Changes, 1)
+	require.Equal(api.PlannedChange{
+		Kind:       "CassandraDatacenter",
+		Namespace:  namespace,
+		Name:       "dc1",
+		K8sContext: k8sCtx0,
+		Action:     api.PlannedActionUpdate,
+		Diff:       []string{"size: 3 -> 4"},
+	}, plan.Changes[0])
+
+	t.Log("change the Cassandra configuration")
+	err = f.Client.Get(ctx, kcKey, kc)
+	require.NoError(err, "failed to get K8ssandraCluster")
+	patch = client.MergeFrom(kc.DeepCopy())
+	kc.Spec.Cassandra.CassandraConfig = &api.CassandraConfig{
+		CassandraYaml: api.CassandraYaml{ConcurrentReads: pointer.Int(32)},
+	}
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	require.Eventually(func() bool {
+		plan = planFor(kc.Generation)()
+		return plan != nil
+	}, timeout, interval, "timed out waiting for the plan")
+	require.True(plan.RequiresRollingRestart)
+	require.Len(plan.Changes, 1)
+	require.True(plan.Changes[0].RequiresRollingRestart)
+	require.Contains(plan.Changes[0].Diff, "config.cassandra-yaml.concurrent_reads: <unset> -> 32")
+
+	t.Log("check that dc1 is not updated in dry-run mode")
+	require.Never(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 4
+	}), timeout, interval, "dc1 should not be updated in dry-run mode")
+
+	t.Log("turn off the dry-run mode")
+	err = f.Client.Get(ctx, kcKey, kc)
+	require.NoError(err, "failed to get K8ssandraCluster")
+	patch = client.MergeFrom(kc.DeepCopy())
+	delete(kc.Annotations, api.DryRunAnnotation)
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	require.Eventually(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 4
+	}), timeout, interval, "timed out waiting for dc1 size to be updated")
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.Plan == nil
+	}, timeout, interval, "timed out waiting for the plan to be removed")
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
+
+func TestComputePlan(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	remoteClient, err := testutils.NewFakeClient()
+	require.NoError(err)
+	clientCache := clientcache.New(remoteClient, remoteClient, remoteClient.Scheme())
+	clientCache.AddClient(k8sCtx1, remoteClient)
+	r := &K8ssandraClusterReconciler{ClientCache: clientCache}
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "plan", Name: "test", Generation: 2},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx1, Size: 3},
+				},
+			},
+		},
+		Status: api.K8ssandraClusterStatus{
+			Datacenters: map[string]api.K8ssandraStatus{
+				"dc2": {Cassandra: &cassdcapi.CassandraDatacenterStatus{}},
+			},
+		},
+	}
+
+	plan, err := r.computePlan(ctx, kc, logr.Discard())
+	require.NoError(err)
+	require.Equal(int64(2), plan.ObservedGeneration)
+	require.False(plan.RequiresRollingRestart)
+	require.Equal([]api.PlannedChange{
+		{Kind: "CassandraDatacenter", Namespace: "plan", Name: "dc1", K8sContext: k8sCtx1, Action: api.PlannedActionCreate},
+		{Kind: "CassandraDatacenter", Namespace: "plan", Name: "dc2", Action: api.PlannedActionDelete},
+	}, plan.Changes)
+
+	t.Log("create dc1 as planned, then scale it up and add a Stargate")
+	dcConfig := cassandra.Coalesce(kc.Name, kc.Spec.Cassandra.DeepCopy(), kc.Spec.Cassandra.Datacenters[0].DeepCopy())
+	systemReplication, err := plannedSystemReplication(kc)
+	require.NoError(err)
+	dc, err := r.newDesiredDatacenter(ctx, kc, 0, dcConfig, systemReplication, remoteClient, logr.Discard())
+	require.NoError(err)
+	require.NoError(remoteClient.Create(ctx, dc))
+	kc.Status.Datacenters = nil
+	kc.Spec.Cassandra.Datacenters[0].Size = 4
+	kc.Spec.Stargate = &stargateapi.StargateClusterTemplate{Size: 1}
+
+	plan, err = r.computePlan(ctx, kc, logr.Discard())
+	require.NoError(err)
+	require.Len(plan.Changes, 2)
+	require.Equal(api.PlannedActionUpdate, plan.Changes[0].Action)
+	require.Contains(plan.Changes[0].Diff, "size: 3 -> 4")
+	require.Equal("Stargate", plan.Changes[1].Kind)
+	require.Equal(api.PlannedActionCreate, plan.Changes[1].Action)
+	// Stargate requires this setting with Cassandra 4
+	require.True(plan.RequiresRollingRestart, "the Cassandra config of dc1 should change")
+}
@@ -29,6 +29,7 @@ import (
 	k8ssandralabels "github.com/k8ssandra/k8ssandra-operator/pkg/labels"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -60,6 +61,7 @@ func (r *K8ssandraClusterReconciler) reconcileReaperSchema(
 	)
 	if err != nil {
 		if kerrors.IsSchemaDisagreement(err) {
+			markSchemaAgreement(kc, false)
 			return result.RequeueSoon(r.DefaultDelay)
 		}
 		logger.Error(err, "Failed to ensure keyspace replication")
@@ -85,17 +87,7 @@ func (r *K8ssandraClusterReconciler) reconcileReaper(
 	}
 	logger = logger.WithValues("Reaper", reaperKey)
 
-	reaperTemplate := kc.Spec.Reaper.DeepCopy()
-	if reaperTemplate != nil {
-		if reaperTemplate.DeploymentMode == reaper.DeploymentModeSingle && getSingleReaperDcName(kc) != actualDc.Name {
-			logger.Info("DC is not Reaper DC: skipping Reaper deployment")
-			reaperTemplate = nil
-		}
-		if actualDc.Spec.Stopped {
-			logger.Info("DC is stopped: skipping Reaper deployment")
-			reaperTemplate = nil
-		}
-	}
+	reaperTemplate := reaperTemplateFor(kc, actualDc, logger)
 
 	actualReaper := &reaperapi.Reaper{}
 
@@ -104,6 +96,10 @@ func (r *K8ssandraClusterReconciler) reconcileReaper(
 		logger.Info("Reaper present for DC " + actualDc.Name)
 
 		desiredReaper := reaper.NewReaper(reaperKey, kc, actualDc, reaperTemplate)
+		if nodeRepair := nodeRepairRequest(kc, dcTemplate.Meta.Name); nodeRepair != nil {
+			desiredReaper.Spec.NodeRepair = nodeRepair
+			annotations.AddHashAnnotation(desiredReaper)
+		}
 
 		if err := remoteClient.Get(ctx, reaperKey, actualReaper); err != nil {
 			if errors.IsNotFound(err) {
@@ -112,6 +108,7 @@ func (r *K8ssandraClusterReconciler) reconcileReaper(
 					logger.Error(err, "Failed to create Reaper resource")
 					return result.Error(err)
 				} else {
+					r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonCreatedReaper, "Created Reaper %s for datacenter %s", reaperKey.Name, actualDc.Name)
 					return result.RequeueSoon(r.DefaultDelay)
 				}
 			} else {
@@ -130,6 +127,7 @@ func (r *K8ssandraClusterReconciler) reconcileReaper(
 		if !annotations.CompareHashAnnotations(actualReaper, desiredReaper) {
 			logger.Info("Updating Reaper resource")
 			resourceVersion := actualReaper.GetResourceVersion()
+			annotations.KeepPaused(actualReaper, desiredReaper)
 			desiredReaper.DeepCopyInto(actualReaper)
 			actualReaper.SetResourceVersion(resourceVersion)
 			if err := remoteClient.Update(ctx, actualReaper); err != nil {
@@ -166,6 +164,7 @@ func (r *K8ssandraClusterReconciler) reconcileReaper(
 			} else {
 				r.removeReaperStatus(kc, dcTemplate.Meta.Name)
 				logger.Info("Reaper deleted")
+				r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDeletedReaper, "Deleted Reaper %s of datacenter %s", reaperKey.Name, actualDc.Name)
 			}
 		} else {
 			logger.Info("Not deleting Reaper since it wasn't created by this controller")
@@ -174,6 +173,22 @@ func (r *K8ssandraClusterReconciler) reconcileReaper(
 	}
 }
 
+// reaperTemplateFor returns the template of the Reaper of dc, or nil if dc must not have a Reaper.
+func reaperTemplateFor(kc *api.K8ssandraCluster, dc *cassdcapi.CassandraDatacenter, logger logr.Logger) *reaperapi.ReaperClusterTemplate {
+	reaperTemplate := kc.Spec.Reaper.DeepCopy()
+	if reaperTemplate != nil {
+		if reaperTemplate.DeploymentMode == reaper.DeploymentModeSingle && getSingleReaperDcName(kc) != dc.Name {
+			logger.Info("DC is not Reaper DC: skipping Reaper deployment")
+			reaperTemplate = nil
+		}
+		if dc.Spec.Stopped {
+			logger.Info("DC is stopped: skipping Reaper deployment")
+			reaperTemplate = nil
+		}
+	}
+	return reaperTemplate
+}
+
 func (r *K8ssandraClusterReconciler) deleteReapers(
 	ctx context.Context,
 	kc *api.K8ssandraCluster,
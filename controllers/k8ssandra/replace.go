@@ -0,0 +1,328 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileNodeReplacement drives the replacement in progress, and starts the one requested with the
+// ReplaceNodeAnnotation once no other node is being replaced. cass-operator recreates the pod of the dead node, whose
+// new node bootstraps with the tokens of the dead one, then Reaper repairs the new node if it is deployed. This is
+// called before the datacenters are reconciled, since the datacenter of a dead node is not ready. The result is only
+// completed on errors; the progress follows the changes of the CassandraDatacenter and of the Reaper.
+func (r *K8ssandraClusterReconciler) reconcileNodeReplacement(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	if replacement := kc.Status.NodeReplacement; nodeReplacementInProgress(replacement) {
+		replacement = replacement.DeepCopy()
+		logger := logger.WithValues("Pod", replacement.Pod, "CassandraDatacenter", replacement.Datacenter)
+
+		var recResult result.ReconcileResult
+		if replacement.Progress == api.NodeReplacementReplacing {
+			recResult = r.checkNodeReplaced(ctx, kc, replacement, logger)
+		} else {
+			recResult = r.checkNodeRepaired(kc, replacement, logger)
+		}
+		kc.Status.NodeReplacement = replacement
+		if recResult.Completed() {
+			return recResult
+		}
+	}
+
+	return r.checkNodeReplacement(ctx, kc, logger)
+}
+
+// checkNodeReplacement asks cass-operator to replace the node requested with the ReplaceNodeAnnotation, unless
+// another node is being replaced.
+func (r *K8ssandraClusterReconciler) checkNodeReplacement(ctx context.Context, kc *api.K8ssandraCluster, logger logr.Logger) result.ReconcileResult {
+	podName, found := kc.Annotations[api.ReplaceNodeAnnotation]
+	if !found {
+		return result.Continue()
+	}
+	if nodeReplacementInProgress(kc.Status.NodeReplacement) {
+		// The requested replacement starts once this one is completed
+		logger.Info("Holding node replacement until the current one completes", "Pod", podName)
+		return result.Continue()
+	}
+	logger = logger.WithValues("Pod", podName)
+
+	dc, remoteClient, err := r.findCassandraPodDatacenter(ctx, kc, podName)
+	if err != nil {
+		logger.Error(err, "Failed to find the datacenter of the pod to replace")
+		return result.Error(err)
+	}
+
+	if dc == nil || dc.Spec.Stopped {
+		reason := "it is not a Cassandra pod of the cluster"
+		if dc != nil {
+			reason = fmt.Sprintf("CassandraDatacenter %s is stopped", dc.Name)
+		}
+		logger.Info("Rejecting node replacement", "Reason", reason)
+		if err := r.removeAnnotation(ctx, kc, api.ReplaceNodeAnnotation); err != nil {
+			return result.Error(err)
+		}
+		r.Recorder.Eventf(kc, corev1.EventTypeWarning, eventReasonNodeReplacementRejected, "Cannot replace the node of pod %s: %s", podName, reason)
+		return result.Continue()
+	}
+
+	if !utils.SliceContains(dc.Spec.ReplaceNodes, podName) {
+		patch := client.MergeFromWithOptions(dc.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		dc.Spec.ReplaceNodes = append(dc.Spec.ReplaceNodes, podName)
+		if err := remoteClient.Patch(ctx, dc, patch); err != nil {
+			logger.Error(err, "Failed to request node replacement", "CassandraDatacenter", utils.GetKey(dc))
+			return result.Error(err)
+		}
+	}
+	if err := r.removeAnnotation(ctx, kc, api.ReplaceNodeAnnotation); err != nil {
+		return result.Error(err)
+	}
+
+	now := metav1.Now()
+	kc.Status.NodeReplacement = &api.NodeReplacementStatus{
+		Pod:        podName,
+		Datacenter: dc.Name,
+		Progress:   api.NodeReplacementReplacing,
+		StartTime:  &now,
+	}
+	logger.Info("Replacing node", "CassandraDatacenter", utils.GetKey(dc))
+	r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonReplacingNode,
+		"Replacing the node of pod %s in CassandraDatacenter %s", podName, dc.Name)
+	return result.Continue()
+}
+
+// checkNodeReplaced waits for cass-operator to replace the node and for its datacenter to be ready again. The
+// replacement then moves on to the repair of the new node, if Reaper is deployed.
+func (r *K8ssandraClusterReconciler) checkNodeReplaced(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	replacement *api.NodeReplacementStatus,
+	logger logr.Logger) result.ReconcileResult {
+
+	datacenterRemoved := func() result.ReconcileResult {
+		failNodeReplacement(replacement, "the datacenter was removed")
+		logger.Info("Node replacement failed", "Reason", replacement.Message)
+		r.Recorder.Eventf(kc, corev1.EventTypeWarning, eventReasonNodeReplacementFailed,
+			"Replacement of the node of pod %s failed: %s", replacement.Pod, replacement.Message)
+		return result.Continue()
+	}
+
+	dcTemplate, found := findDatacenterTemplate(kc, replacement.Datacenter)
+	if !found {
+		return datacenterRemoved()
+	}
+	remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+	if err != nil {
+		logger.Error(err, "Failed to get remote client")
+		return result.Error(err)
+	}
+	dc := &cassdcapi.CassandraDatacenter{}
+	dcKey := client.ObjectKey{Namespace: datacenterNamespace(kc, dcTemplate), Name: dcTemplate.Meta.Name}
+	if err := remoteClient.Get(ctx, dcKey, dc); err != nil {
+		if errors.IsNotFound(err) {
+			return datacenterRemoved()
+		}
+		logger.Error(err, "Failed to get datacenter")
+		return result.Error(err)
+	}
+
+	if !nodeReplaced(dc, replacement) {
+		logger.Info("Waiting for the node to be replaced")
+		return result.Continue()
+	}
+
+	if kc.Spec.Reaper == nil {
+		completeNodeReplacement(replacement)
+		logger.Info("Node replaced")
+		r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonNodeReplaced,
+			"Replaced the node of pod %s in CassandraDatacenter %s", replacement.Pod, dc.Name)
+		return result.Continue()
+	}
+
+	pod := &corev1.Pod{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: dc.Namespace, Name: replacement.Pod}, pod); err != nil {
+		logger.Error(err, "Failed to get the pod of the replaced node")
+		return result.Error(err)
+	}
+	if pod.Status.PodIP == "" {
+		logger.Info("Waiting for the pod of the replaced node to get an IP address")
+		return result.Continue()
+	}
+
+	mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, dc, remoteClient, logger)
+	if err != nil {
+		logger.Error(err, "Failed to create ManagementApiFacade")
+		return result.Error(err)
+	}
+	keyspaces, err := replicatedKeyspaces(mgmtApi, dc.Name)
+	if err != nil {
+		logger.Error(err, "Failed to get the keyspaces to repair")
+		return result.Error(err)
+	}
+
+	replacement.Progress = api.NodeReplacementRepairing
+	replacement.Node = pod.Status.PodIP
+	replacement.Keyspaces = keyspaces
+	logger.Info("Node replaced, repairing it", "Node", replacement.Node, "Keyspaces", keyspaces)
+	r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonRepairingReplacedNode,
+		"Replaced the node of pod %s in CassandraDatacenter %s, repairing it with Reaper", replacement.Pod, dc.Name)
+	return result.Continue()
+}
+
+// checkNodeRepaired follows the repair of the replaced node in the status of the Reaper that runs it, see
+// nodeRepairRequest.
+func (r *K8ssandraClusterReconciler) checkNodeRepaired(kc *api.K8ssandraCluster, replacement *api.NodeReplacementStatus, logger logr.Logger) result.ReconcileResult {
+	if kc.Spec.Reaper == nil {
+		failNodeReplacement(replacement, "Reaper was removed before the node was repaired")
+	} else {
+		reaperStatus := kc.Status.Datacenters[nodeRepairDatacenter(kc, replacement)].Reaper
+		if reaperStatus == nil || reaperStatus.NodeRepair == nil || reaperStatus.NodeRepair.Node != replacement.Node {
+			logger.Info("Waiting for Reaper to repair the replaced node", "Node", replacement.Node)
+			return result.Continue()
+		}
+		switch repair := reaperStatus.NodeRepair; repair.Progress {
+		case reaperapi.NodeRepairCompleted:
+			completeNodeReplacement(replacement)
+			logger.Info("Replaced node repaired", "Node", replacement.Node)
+			r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonNodeReplaced,
+				"Replaced and repaired the node of pod %s in CassandraDatacenter %s", replacement.Pod, replacement.Datacenter)
+			return result.Continue()
+		case reaperapi.NodeRepairFailed:
+			failNodeReplacement(replacement, fmt.Sprintf("the repair of the replaced node failed: %s", repair.Message))
+		default:
+			logger.Info("Waiting for Reaper to repair the replaced node", "Node", replacement.Node)
+			return result.Continue()
+		}
+	}
+
+	logger.Info("Node replacement failed", "Reason", replacement.Message)
+	r.Recorder.Eventf(kc, corev1.EventTypeWarning, eventReasonNodeReplacementFailed,
+		"Replacement of the node of pod %s failed: %s", replacement.Pod, replacement.Message)
+	return result.Continue()
+}
+
+// findCassandraPodDatacenter returns the datacenter of kc that the Cassandra pod named podName belongs to, along with
+// the client of its Kubernetes cluster. A nil datacenter is returned if the pod is not found.
+func (r *K8ssandraClusterReconciler) findCassandraPodDatacenter(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	podName string) (*cassdcapi.CassandraDatacenter, client.Client, error) {
+
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
+		if err != nil {
+			return nil, nil, err
+		}
+		namespace := datacenterNamespace(kc, dcTemplate)
+
+		pod := &corev1.Pod{}
+		if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: podName}, pod); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, err
+		}
+		if pod.Labels[cassdcapi.DatacenterLabel] != dcTemplate.Meta.Name {
+			continue
+		}
+
+		dc := &cassdcapi.CassandraDatacenter{}
+		if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: dcTemplate.Meta.Name}, dc); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, nil, nil
+			}
+			return nil, nil, err
+		}
+		return dc, remoteClient, nil
+	}
+	return nil, nil, nil
+}
+
+// nodeReplaced returns true once cass-operator replaced the node of the pod, after the replacement was requested, and
+// the datacenter is ready again.
+func nodeReplaced(dc *cassdcapi.CassandraDatacenter, replacement *api.NodeReplacementStatus) bool {
+	if utils.SliceContains(dc.Spec.ReplaceNodes, replacement.Pod) || utils.SliceContains(dc.Status.NodeReplacements, replacement.Pod) {
+		return false
+	}
+	return cassandra.DatacenterReplacedNodesAfter(replacement.StartTime.Time, dc) && cassandra.DatacenterReady(dc)
+}
+
+// replicatedKeyspaces returns the keyspaces that have replicas in the datacenter dcName.
+func replicatedKeyspaces(mgmtApi cassandra.ManagementApiFacade, dcName string) ([]string, error) {
+	keyspaces, err := mgmtApi.ListKeyspaces("")
+	if err != nil {
+		return nil, err
+	}
+	var replicated []string
+	for _, keyspace := range keyspaces {
+		replication, err := mgmtApi.GetKeyspaceReplication(keyspace)
+		if err != nil {
+			return nil, err
+		}
+		if cassandra.DatacenterReplicationFactor(replication, dcName) > 0 {
+			replicated = append(replicated, keyspace)
+		}
+	}
+	return replicated, nil
+}
+
+// nodeRepairRequest returns the repair that the Reaper of the datacenter dcName must run for the node replacement in
+// progress, or nil if there is none.
+func nodeRepairRequest(kc *api.K8ssandraCluster, dcName string) *reaperapi.NodeRepairRequest {
+	replacement := kc.Status.NodeReplacement
+	if replacement == nil || replacement.Progress != api.NodeReplacementRepairing || nodeRepairDatacenter(kc, replacement) != dcName {
+		return nil
+	}
+	return &reaperapi.NodeRepairRequest{
+		Node:      replacement.Node,
+		Keyspaces: replacement.Keyspaces,
+		Cause:     fmt.Sprintf("replacement of pod %s", replacement.Pod),
+	}
+}
+
+// nodeRepairDatacenter returns the datacenter whose Reaper repairs the replaced node: the datacenter of the node,
+// unless a single Reaper is deployed for the whole cluster.
+func nodeRepairDatacenter(kc *api.K8ssandraCluster, replacement *api.NodeReplacementStatus) string {
+	if kc.Spec.Reaper != nil && kc.Spec.Reaper.DeploymentMode == reaper.DeploymentModeSingle {
+		return getSingleReaperDcName(kc)
+	}
+	return replacement.Datacenter
+}
+
+func nodeReplacementInProgress(replacement *api.NodeReplacementStatus) bool {
+	return replacement != nil &&
+		(replacement.Progress == api.NodeReplacementReplacing || replacement.Progress == api.NodeReplacementRepairing)
+}
+
+func completeNodeReplacement(replacement *api.NodeReplacementStatus) {
+	now := metav1.Now()
+	replacement.Progress = api.NodeReplacementCompleted
+	replacement.CompletionTime = &now
+}
+
+func failNodeReplacement(replacement *api.NodeReplacementStatus, message string) {
+	now := metav1.Now()
+	replacement.Progress = api.NodeReplacementFailed
+	replacement.CompletionTime = &now
+	replacement.Message = message
+}
+
+// findDatacenterTemplate returns the template of the datacenter dcName, and false if kc does not declare it.
+func findDatacenterTemplate(kc *api.K8ssandraCluster, dcName string) (api.CassandraDatacenterTemplate, bool) {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName {
+			return dcTemplate, true
+		}
+	}
+	return api.CassandraDatacenterTemplate{}, false
+}
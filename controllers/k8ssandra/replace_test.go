@@ -0,0 +1,226 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNodeReplaced(t *testing.T) {
+	startTime := metav1.Now()
+	replacement := &api.NodeReplacementStatus{Pod: "test-dc1-default-sts-0", StartTime: &startTime}
+
+	dc := &cassdcapi.CassandraDatacenter{}
+	dc.Status.CassandraOperatorProgress = cassdcapi.ProgressReady
+	dc.SetCondition(cassdcapi.DatacenterCondition{Type: cassdcapi.DatacenterReady, Status: corev1.ConditionTrue})
+	dc.Spec.ReplaceNodes = []string{"test-dc1-default-sts-0"}
+	assert.False(t, nodeReplaced(dc, replacement), "the replacement was not picked up by cass-operator yet")
+
+	dc.Spec.ReplaceNodes = nil
+	dc.Status.NodeReplacements = []string{"test-dc1-default-sts-0"}
+	dc.SetCondition(cassdcapi.DatacenterCondition{Type: cassdcapi.DatacenterReplacingNodes, Status: corev1.ConditionTrue})
+	assert.False(t, nodeReplaced(dc, replacement), "the node is being replaced")
+
+	dc.Status.NodeReplacements = nil
+	dc.SetCondition(cassdcapi.DatacenterCondition{
+		Type:               cassdcapi.DatacenterReplacingNodes,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(startTime.Add(-time.Minute)),
+	})
+	assert.False(t, nodeReplaced(dc, replacement), "the condition is from a previous replacement")
+
+	dc.SetCondition(cassdcapi.DatacenterCondition{
+		Type:               cassdcapi.DatacenterReplacingNodes,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(startTime.Add(time.Minute)),
+	})
+	assert.True(t, nodeReplaced(dc, replacement))
+}
+
+func TestNodeRepairRequest(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}},
+				},
+			},
+			Reaper: &reaperapi.ReaperClusterTemplate{DeploymentMode: reaper.DeploymentModePerDc},
+		},
+		Status: api.K8ssandraClusterStatus{
+			NodeReplacement: &api.NodeReplacementStatus{
+				Pod:        "test-dc2-default-sts-0",
+				Datacenter: "dc2",
+				Progress:   api.NodeReplacementReplacing,
+			},
+		},
+	}
+	assert.Nil(t, nodeRepairRequest(kc, "dc2"), "the node is not replaced yet")
+
+	kc.Status.NodeReplacement.Progress = api.NodeReplacementRepairing
+	kc.Status.NodeReplacement.Node = "10.0.0.1"
+	kc.Status.NodeReplacement.Keyspaces = []string{"ks1"}
+	assert.Nil(t, nodeRepairRequest(kc, "dc1"))
+	assert.Equal(t, &reaperapi.NodeRepairRequest{
+		Node:      "10.0.0.1",
+		Keyspaces: []string{"ks1"},
+		Cause:     "replacement of pod test-dc2-default-sts-0",
+	}, nodeRepairRequest(kc, "dc2"))
+
+	kc.Spec.Reaper.DeploymentMode = reaper.DeploymentModeSingle
+	assert.NotNil(t, nodeRepairRequest(kc, "dc1"), "a single Reaper repairs the nodes of all the datacenters")
+	assert.Nil(t, nodeRepairRequest(kc, "dc2"))
+
+	kc.Status.NodeReplacement.Progress = api.NodeReplacementCompleted
+	assert.Nil(t, nodeRepairRequest(kc, "dc1"))
+}
+
+// replaceNode verifies that the node requested with the ReplaceNodeAnnotation is replaced by cass-operator, and then
+// repaired by Reaper.
+func replaceNode(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "replace-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+			Reaper: &reaperapi.ReaperClusterTemplate{},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{"system", "ks1"}, nil)
+	mockMgmtApi.On(testutils.GetKeyspaceReplication, "system").Return(map[string]string{"class": "org.apache.cassandra.locator.LocalStrategy"}, nil)
+	mockMgmtApi.On(testutils.GetKeyspaceReplication, "ks1").Return(map[string]string{"class": cassandra.NetworkTopology, "dc1": "3"}, nil)
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dcKey := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, dcKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	reaperKey := framework.NewClusterKey(k8sCtx0, namespace, "replace-test-dc1-reaper")
+	require.Eventually(f.ReaperExists(ctx, reaperKey), timeout, interval)
+	err = f.SetReaperStatusReady(ctx, reaperKey)
+	require.NoError(err, "failed to set reaper status ready")
+
+	t.Log("create the pod of the dead node")
+	podKey := framework.NewClusterKey(k8sCtx0, namespace, "replace-test-dc1-default-sts-0")
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      podKey.Name,
+			Labels:    map[string]string{cassdcapi.DatacenterLabel: "dc1"},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra", Image: "cassandra"}}},
+	}
+	err = f.Create(ctx, podKey, pod)
+	require.NoError(err, "failed to create pod")
+	pod.Status.PodIP = "10.0.0.1"
+	err = f.UpdateStatus(ctx, podKey, pod)
+	require.NoError(err, "failed to update pod status")
+
+	t.Log("request the replacement of the node")
+	require.NoError(f.Client.Get(ctx, kcKey, kc))
+	patch := client.MergeFrom(kc.DeepCopy())
+	metav1.SetMetaDataAnnotation(&kc.ObjectMeta, api.ReplaceNodeAnnotation, podKey.Name)
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	require.Eventually(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return utils.SliceContains(dc.Spec.ReplaceNodes, podKey.Name)
+	}), timeout, interval, "timed out waiting for the replacement to be requested")
+
+	var replacement *api.NodeReplacementStatus
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		replacement = kc.Status.NodeReplacement
+		return replacement != nil && replacement.Progress == api.NodeReplacementReplacing
+	}, timeout, interval, "timed out waiting for the replacement to start")
+	assert.Equal(t, "dc1", replacement.Datacenter)
+	assert.NotContains(t, kc.Annotations, api.ReplaceNodeAnnotation)
+
+	t.Log("complete the replacement of the node")
+	dc := &cassdcapi.CassandraDatacenter{}
+	require.NoError(f.Get(ctx, dcKey, dc))
+	dc.Spec.ReplaceNodes = nil
+	err = f.Update(ctx, dcKey, dc)
+	require.NoError(err, "failed to update dc1")
+	err = f.PatchDatacenterStatus(ctx, dcKey, func(dc *cassdcapi.CassandraDatacenter) {
+		dc.SetCondition(cassdcapi.DatacenterCondition{
+			Type:               cassdcapi.DatacenterReplacingNodes,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.NewTime(replacement.StartTime.Add(time.Second)),
+		})
+	})
+	require.NoError(err, "failed to update dc1 status")
+
+	t.Log("check that Reaper is asked to repair the new node")
+	require.Eventually(f.NewWithReaper(ctx, reaperKey)(func(r *reaperapi.Reaper) bool {
+		repair := r.Spec.NodeRepair
+		return repair != nil && repair.Node == "10.0.0.1" && assert.ObjectsAreEqual([]string{"ks1"}, repair.Keyspaces)
+	}), timeout, interval, "timed out waiting for the node repair request")
+
+	err = f.PatchReaperStatus(ctx, reaperKey, func(r *reaperapi.Reaper) {
+		r.Status.NodeRepair = &reaperapi.NodeRepairStatus{Node: "10.0.0.1", Progress: reaperapi.NodeRepairCompleted}
+	})
+	require.NoError(err, "failed to update reaper status")
+
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		replacement = kc.Status.NodeReplacement
+		return replacement != nil && replacement.Progress == api.NodeReplacementCompleted
+	}, timeout, interval, "timed out waiting for the replacement to complete")
+	assert.NotNil(t, replacement.CompletionTime)
+
+	require.Eventually(f.NewWithReaper(ctx, reaperKey)(func(r *reaperapi.Reaper) bool {
+		return r.Spec.NodeRepair == nil
+	}), timeout, interval, "timed out waiting for the node repair request to be removed")
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
@@ -0,0 +1,215 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcileRollingRestart starts a rolling restart of the cluster when it is requested with the
+// RollingRestartAnnotation, and drives the restart in progress: the datacenters are restarted one at a time by changing
+// the RestartRevisionAnnotation of their pod template, and the next one is only restarted once the previous one is
+// ready again. This is expected to be called once all the datacenters are reconciled and ready. dcs are the
+// datacenters of kc, in the order in which they are declared.
+func (r *K8ssandraClusterReconciler) reconcileRollingRestart(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcs []*cassdcapi.CassandraDatacenter,
+	logger logr.Logger) result.ReconcileResult {
+
+	if recResult := r.checkRollingRestart(ctx, kc, dcs, logger); recResult.Completed() {
+		return recResult
+	}
+
+	restart := kc.Status.RollingRestart
+	if restart == nil || restart.Progress != api.RollingRestartRestarting {
+		return result.Continue()
+	}
+	restart = restart.DeepCopy()
+	defer func() { kc.Status.RollingRestart = restart }()
+
+	for i := range restart.Datacenters {
+		dcStatus := &restart.Datacenters[i]
+		if dcStatus.Progress == api.RollingRestartCompleted || dcStatus.Progress == api.RollingRestartSkipped {
+			continue
+		}
+
+		dc := findDatacenter(kc, dcs, dcStatus.Name)
+		if dc == nil || dc.Spec.Stopped {
+			// The datacenter was removed or stopped since the restart started
+			dcStatus.Progress = api.RollingRestartSkipped
+			continue
+		}
+
+		logger := logger.WithValues("CassandraDatacenter", utils.GetKey(dc))
+		if recResult := r.restartDatacenter(kc, restart, dcStatus, dc, logger); recResult.Completed() {
+			return recResult
+		}
+	}
+
+	logger.Info("Rolling restart completed", "Revision", restart.Revision)
+	now := metav1.Now()
+	restart.Progress = api.RollingRestartCompleted
+	restart.CompletionTime = &now
+	r.Recorder.Event(kc, corev1.EventTypeNormal, eventReasonRollingRestartCompleted, "Restarted all the datacenters")
+	if annotations.HasAnnotationWithValue(kc, api.RollingRestartAnnotation, "true") {
+		// Another restart was requested in the meantime
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+	return result.Continue()
+}
+
+// checkRollingRestart starts a new rolling restart if one is requested and the cluster is not already being restarted.
+func (r *K8ssandraClusterReconciler) checkRollingRestart(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcs []*cassdcapi.CassandraDatacenter,
+	logger logr.Logger) result.ReconcileResult {
+
+	if !annotations.HasAnnotationWithValue(kc, api.RollingRestartAnnotation, "true") {
+		return result.Continue()
+	}
+
+	restart := kc.Status.RollingRestart
+	if restart != nil && restart.Progress == api.RollingRestartRestarting {
+		// The requested restart starts once this one is completed
+		return result.Continue()
+	}
+
+	if reason := rollingRestartBlocker(kc); reason != "" {
+		logger.Info("Holding rolling restart", "Reason", reason)
+		markProgressing(kc, reasonRestartPending, fmt.Sprintf("The rolling restart is pending: %s", reason))
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	if err := r.removeAnnotation(ctx, kc, api.RollingRestartAnnotation); err != nil {
+		return result.Error(err)
+	}
+
+	revision := int64(1)
+	if restart != nil {
+		revision = restart.Revision + 1
+	}
+	// The datacenters keep the revision of their last restart until they are restarted again
+	previousRevisions := make(map[string]int64)
+	if restart != nil {
+		for _, dcStatus := range restart.Datacenters {
+			previousRevisions[dcStatus.Name] = dcStatus.Revision
+		}
+	}
+	now := metav1.Now()
+	restart = &api.RollingRestartStatus{
+		Revision:  revision,
+		Progress:  api.RollingRestartRestarting,
+		StartTime: &now,
+	}
+	for i, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		progress := api.RollingRestartPending
+		if dcs[i].Spec.Stopped {
+			progress = api.RollingRestartSkipped
+		}
+		restart.Datacenters = append(restart.Datacenters, api.DatacenterRestartStatus{
+			Name:     dcTemplate.Meta.Name,
+			Progress: progress,
+			Revision: previousRevisions[dcTemplate.Meta.Name],
+		})
+	}
+	kc.Status.RollingRestart = restart
+
+	logger.Info("Starting rolling restart", "Revision", revision)
+	r.Recorder.Event(kc, corev1.EventTypeNormal, eventReasonRollingRestartStarted, "Restarting the datacenters one at a time")
+	return result.Continue()
+}
+
+// restartDatacenter sets the revision of the restart on the datacenter status, which changes the
+// RestartRevisionAnnotation of the pod template of dc when the datacenter is reconciled, and waits for cass-operator to
+// restart the pods and for dc to be ready again. The result is not completed once dc is restarted, so that the next
+// datacenter can be restarted.
+func (r *K8ssandraClusterReconciler) restartDatacenter(
+	kc *api.K8ssandraCluster,
+	restart *api.RollingRestartStatus,
+	dcStatus *api.DatacenterRestartStatus,
+	dc *cassdcapi.CassandraDatacenter,
+	logger logr.Logger) result.ReconcileResult {
+
+	markProgressing(kc, reasonRestartingDatacenter, fmt.Sprintf("Restarting CassandraDatacenter %s", dc.Name))
+
+	if dcStatus.Progress == api.RollingRestartPending {
+		logger.Info("Restarting datacenter", "Revision", restart.Revision)
+		now := metav1.Now()
+		dcStatus.Progress = api.RollingRestartRestarting
+		dcStatus.Revision = restart.Revision
+		dcStatus.StartTime = &now
+		r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonRestartingDatacenter,
+			"Restarting CassandraDatacenter %s", dc.Name)
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	revision := strconv.FormatInt(dcStatus.Revision, 10)
+	if dc.Spec.PodTemplateSpec == nil || !annotations.HasAnnotationWithValue(dc.Spec.PodTemplateSpec, api.RestartRevisionAnnotation, revision) {
+		logger.Info("Waiting for the restart revision to be applied to the datacenter", "Revision", revision)
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	if !cassandra.DatacenterUpdatedAfter(dcStatus.StartTime.Time, dc) || !cassandra.DatacenterReady(dc) {
+		logger.Info("Waiting for datacenter to be ready after restart")
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	logger.Info("Datacenter restarted")
+	now := metav1.Now()
+	dcStatus.Progress = api.RollingRestartCompleted
+	dcStatus.CompletionTime = &now
+	return result.Continue()
+}
+
+// datacenterRestarting returns true if the rolling restart in progress is restarting the given datacenter.
+func datacenterRestarting(kc *api.K8ssandraCluster, dcName string) bool {
+	restart := kc.Status.RollingRestart
+	if restart == nil || restart.Progress != api.RollingRestartRestarting {
+		return false
+	}
+	for _, dcStatus := range restart.Datacenters {
+		if dcStatus.Name == dcName {
+			return dcStatus.Progress == api.RollingRestartRestarting
+		}
+	}
+	return false
+}
+
+// rollingRestartBlocker returns the reason why a rolling restart cannot start now, or an empty string if it can. Pods
+// that are already being restarted by a version upgrade or a credentials rotation are not restarted again.
+func rollingRestartBlocker(kc *api.K8ssandraCluster) string {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if upgrade := kc.Status.Datacenters[dcTemplate.Meta.Name].Upgrade; upgrade != nil {
+			switch upgrade.Progress {
+			case api.UpgradeUpgradingNodes, api.UpgradeUpgradingSSTables:
+				return fmt.Sprintf("waiting for the upgrade of datacenter %s to complete", dcTemplate.Meta.Name)
+			}
+		}
+	}
+	if rotation := kc.Status.CredentialsRotation; rotation != nil && rotation.Progress != api.CredentialsRotationCompleted {
+		return "waiting for the credentials rotation to complete"
+	}
+	return ""
+}
+
+// findDatacenter returns the datacenter named dcName among dcs, or nil if kc does not declare it.
+func findDatacenter(kc *api.K8ssandraCluster, dcs []*cassdcapi.CassandraDatacenter, dcName string) *cassdcapi.CassandraDatacenter {
+	for i, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName {
+			return dcs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRollingRestartBlocker(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}},
+				},
+			},
+		},
+	}
+	assert.Empty(t, rollingRestartBlocker(kc))
+
+	setUpgradeStatus(kc, "dc2", &api.DatacenterUpgradeStatus{Progress: api.UpgradeFailed})
+	assert.Empty(t, rollingRestartBlocker(kc), "a failed upgrade must not prevent restarts")
+
+	setUpgradeStatus(kc, "dc2", &api.DatacenterUpgradeStatus{Progress: api.UpgradeUpgradingNodes})
+	assert.Equal(t, "waiting for the upgrade of datacenter dc2 to complete", rollingRestartBlocker(kc))
+
+	setUpgradeStatus(kc, "dc2", &api.DatacenterUpgradeStatus{Progress: api.UpgradeCompleted})
+	kc.Status.CredentialsRotation = &api.CredentialsRotationStatus{Progress: api.CredentialsRotationRollingComponents}
+	assert.Equal(t, "waiting for the credentials rotation to complete", rollingRestartBlocker(kc))
+}
+
+// restartCluster verifies that a rolling restart requested with the annotation restarts the datacenters one at a time.
+func restartCluster(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "restart-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, K8sContext: k8sCtx1, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dc1Key := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	dc2Key := framework.NewClusterKey(k8sCtx1, namespace, "dc2")
+
+	require.Eventually(f.DatacenterExists(ctx, dc1Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc1Key)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(f.DatacenterExists(ctx, dc2Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc2Key)
+	require.NoError(err, "failed to set dc2 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+
+	t.Log("request a rolling restart")
+	patch := client.MergeFrom(kc.DeepCopy())
+	metav1.SetMetaDataAnnotation(&kc.ObjectMeta, api.RollingRestartAnnotation, "true")
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	t.Log("check that dc1 is restarted first")
+	finishDatacenterRestart(ctx, t, f, dc1Key, "1")
+	verifyDatacenterRestartProgress(ctx, t, f, kcKey, "dc2", api.RollingRestartRestarting)
+	verifyDatacenterRestartProgress(ctx, t, f, kcKey, "dc1", api.RollingRestartCompleted)
+
+	t.Log("check that dc2 is restarted next")
+	finishDatacenterRestart(ctx, t, f, dc2Key, "1")
+
+	t.Log("check that the rolling restart is completed")
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		restart := kc.Status.RollingRestart
+		return restart != nil && restart.Revision == 1 && restart.Progress == api.RollingRestartCompleted
+	}, timeout, interval, "timed out waiting for the rolling restart to complete")
+	assert.NotContains(t, kc.Annotations, api.RollingRestartAnnotation)
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
+
+func verifyDatacenterRestartProgress(ctx context.Context, t *testing.T, f *framework.Framework, kcKey client.ObjectKey, dcName string, progress api.RollingRestartProgress) {
+	require.Eventually(t, func() bool {
+		kc := &api.K8ssandraCluster{}
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			t.Logf("failed to get K8ssandraCluster: %v", err)
+			return false
+		}
+		if restart := kc.Status.RollingRestart; restart != nil {
+			for _, dcStatus := range restart.Datacenters {
+				if dcStatus.Name == dcName {
+					return dcStatus.Progress == progress
+				}
+			}
+		}
+		return false
+	}, timeout, interval, "timed out waiting for %s restart progress to be %s", dcName, progress)
+}
+
+// finishDatacenterRestart checks that the restart revision is set on the pod template of the datacenter, and
+// simulates cass-operator restarting its pods.
+func finishDatacenterRestart(ctx context.Context, t *testing.T, f *framework.Framework, key framework.ClusterKey, revision string) {
+	t.Logf("check that the restart revision of %s is set to %s", key.Name, revision)
+	require.Eventually(t, func() bool {
+		dc := &cassdcapi.CassandraDatacenter{}
+		if err := f.Get(ctx, key, dc); err != nil {
+			return false
+		}
+		return dc.Spec.PodTemplateSpec != nil && dc.Spec.PodTemplateSpec.Annotations[api.RestartRevisionAnnotation] == revision
+	}, timeout, interval, "timed out waiting for the restart revision to be set")
+
+	setDatacenterUpdated(ctx, t, f, key)
+}
@@ -0,0 +1,291 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	cassctlapi "github.com/k8ssandra/cass-operator/apis/control/v1alpha1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/stargate"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkScaleDown decides whether a reduction of the size of an existing datacenter can be applied. It is refused as
+// long as a keyspace has more replicas in the datacenter than the new number of nodes, since QUORUM requests would
+// then fail. In that case, dcConfig is reverted to the current size so that other changes to the datacenter can still
+// be applied. The keyspaces whose replication is managed by the operator are not checked since their replication
+// follows the size of the datacenter; a warning is recorded when this reduces the replication of system_auth. An
+// increase of the size is recorded in the status, so that the existing nodes are cleaned up once the new ones joined.
+func (r *K8ssandraClusterReconciler) checkScaleDown(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcConfig *cassandra.DatacenterConfig,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	dcName := dcConfig.Meta.Name
+	dcNamespace := dcConfig.Meta.Namespace
+	if dcNamespace == "" {
+		dcNamespace = kc.Namespace
+	}
+
+	actualDc := &cassdcapi.CassandraDatacenter{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: dcNamespace, Name: dcName}, actualDc); err != nil {
+		if errors.IsNotFound(err) {
+			return result.Continue()
+		}
+		logger.Error(err, "Failed to get datacenter")
+		return result.Error(err)
+	}
+
+	fromSize := actualDc.Spec.Size
+	toSize := dcConfig.Size
+	if toSize >= fromSize {
+		setScaleDownStatus(kc, dcName, nil)
+		if toSize > fromSize {
+			checkScaleUp(kc, dcName, fromSize, toSize, logger)
+		}
+		return result.Continue()
+	}
+
+	holdSize := func(keyspaces []string, message string) {
+		logger.Info("Holding datacenter scale-down", "FromSize", fromSize, "ToSize", toSize, "Reason", message)
+		dcConfig.Size = fromSize
+		setScaleDownStatus(kc, dcName, &api.DatacenterScaleDownStatus{
+			FromSize:  fromSize,
+			ToSize:    toSize,
+			Keyspaces: keyspaces,
+			Message:   message,
+		})
+	}
+
+	if actualDc.Spec.Stopped {
+		holdSize(nil, "the datacenter is stopped")
+		return result.Continue()
+	}
+	if !cassandra.DatacenterReady(actualDc) {
+		holdSize(nil, "waiting for the datacenter to be ready to check the replication of its keyspaces")
+		return result.Continue()
+	}
+
+	mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, actualDc, remoteClient, logger)
+	if err != nil {
+		holdSize(nil, "failed to connect to the datacenter")
+		return result.Error(err)
+	}
+	keyspaces, err := mgmtApi.ListKeyspaces("")
+	if err != nil {
+		logger.Error(err, "Failed to list keyspaces")
+		holdSize(nil, "failed to list the keyspaces")
+		return result.Error(err)
+	}
+
+	managedKeyspaces := operatorManagedKeyspaces(kc)
+	var overReplicated []string
+	for _, keyspace := range keyspaces {
+		if utils.SliceContains(managedKeyspaces, keyspace) {
+			continue
+		}
+		replication, err := mgmtApi.GetKeyspaceReplication(keyspace)
+		if err != nil {
+			logger.Error(err, "Failed to get keyspace replication", "Keyspace", keyspace)
+			holdSize(nil, fmt.Sprintf("failed to get the replication of keyspace %s", keyspace))
+			return result.Error(err)
+		}
+		if cassandra.DatacenterReplicationFactor(replication, dcName) > int(toSize) {
+			overReplicated = append(overReplicated, keyspace)
+		}
+	}
+
+	if len(overReplicated) > 0 {
+		message := fmt.Sprintf("keyspaces %s have more than %d replicas in the datacenter", strings.Join(overReplicated, ", "), toSize)
+		previous := kc.Status.Datacenters[dcName].ScaleDownBlocked
+		holdSize(overReplicated, message)
+		if previous == nil || previous.Message != message {
+			r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeWarning, eventReasonScaleDownRejected,
+				"Not scaling down CassandraDatacenter %s from %d to %d nodes: %s", dcName, fromSize, toSize, message)
+		}
+		return result.Continue()
+	}
+
+	setScaleDownStatus(kc, dcName, nil)
+	if replication, err := mgmtApi.GetKeyspaceReplication("system_auth"); err != nil {
+		logger.Error(err, "Failed to get system_auth replication")
+	} else if actualRf, desiredRf := cassandra.DatacenterReplicationFactor(replication, dcName), int(math.Min(3, float64(toSize))); desiredRf < actualRf {
+		logger.Info("Scaling down datacenter reduces the replication of system_auth", "FromRF", actualRf, "ToRF", desiredRf)
+		r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeWarning, eventReasonSystemAuthReplicationReduced,
+			"Scaling down CassandraDatacenter %s to %d nodes reduces the replication factor of system_auth in the datacenter from %d to %d",
+			dcName, toSize, actualRf, desiredRf)
+	}
+	return result.Continue()
+}
+
+// operatorManagedKeyspaces returns the keyspaces whose replication the operator adjusts to the size of the
+// datacenters.
+func operatorManagedKeyspaces(kc *api.K8ssandraCluster) []string {
+	keyspaces := append([]string{}, api.SystemKeyspaces...)
+	if kc.Spec.Reaper != nil {
+		keyspaces = append(keyspaces, getReaperKeyspace(kc))
+	}
+	if kc.HasStargates() {
+		keyspaces = append(keyspaces, stargate.AuthKeyspace)
+	}
+	return keyspaces
+}
+
+func setScaleDownStatus(kc *api.K8ssandraCluster, dcName string, scaleDown *api.DatacenterScaleDownStatus) {
+	if scaleDown == nil && kc.Status.Datacenters[dcName].ScaleDownBlocked == nil {
+		return
+	}
+	if kc.Status.Datacenters == nil {
+		kc.Status.Datacenters = make(map[string]api.K8ssandraStatus)
+	}
+	status := kc.Status.Datacenters[dcName]
+	status.ScaleDownBlocked = scaleDown
+	kc.Status.Datacenters[dcName] = status
+}
+
+// checkScaleUp records that dcName is being scaled up from fromSize to toSize nodes, so that the cleanup of the nodes
+// of the datacenter that cass-operator runs once the new ones have joined is tracked. A cleanup that has not
+// completed yet is superseded, since the token ranges owned by the nodes change again.
+func checkScaleUp(kc *api.K8ssandraCluster, dcName string, fromSize, toSize int32, logger logr.Logger) {
+	if cleanup := kc.Status.Datacenters[dcName].Cleanup; cleanup != nil && cleanup.Progress == api.CleanupPending {
+		if cleanup.ToSize == toSize {
+			return
+		}
+		fromSize = cleanup.FromSize
+	}
+
+	logger.Info("Datacenter is scaled up, its nodes will be cleaned up", "FromSize", fromSize, "ToSize", toSize)
+	now := metav1.Now()
+	setCleanupStatus(kc, dcName, &api.DatacenterCleanupStatus{
+		FromSize:    fromSize,
+		ToSize:      toSize,
+		Progress:    api.CleanupPending,
+		ScaleUpTime: &now,
+		Message:     "waiting for the new nodes to join the datacenter",
+	})
+}
+
+// reconcileDcCleanup tracks the cleanup that cass-operator runs on dc once it is scaled up: cass-operator creates a
+// cleanup task once the new nodes have joined, and only completes the scale-up once the task is completed. The other
+// operations on the cluster do not wait for the cleanup, the result is only completed on errors. The progress is
+// polled, see scheduledRequeue.
+func (r *K8ssandraClusterReconciler) reconcileDcCleanup(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	cleanup := kc.Status.Datacenters[dc.Name].Cleanup
+	if cleanup == nil || (cleanup.Progress != api.CleanupPending && cleanup.Progress != api.CleanupRunning) {
+		return result.Continue()
+	}
+	cleanup = cleanup.DeepCopy()
+	defer setCleanupStatus(kc, dc.Name, cleanup)
+
+	if dc.Spec.Size < cleanup.ToSize || cleanup.ScaleUpTime == nil {
+		logger.Info("Waiting for the datacenter to be scaled up before cleaning it up", "ToSize", cleanup.ToSize)
+		return result.Continue()
+	}
+
+	if cleanup.Progress == api.CleanupPending {
+		task, err := findCleanupTask(ctx, dc, remoteClient)
+		if err != nil {
+			logger.Error(err, "Failed to get the tasks of the datacenter")
+			return result.Error(err)
+		}
+		if task != nil {
+			logger.Info("Datacenter cleanup started", "Task", utils.GetKey(task))
+			now := metav1.Now()
+			cleanup.Progress = api.CleanupRunning
+			cleanup.Task = task.Name
+			cleanup.StartTime = &now
+			cleanup.Message = ""
+			r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonCleanupStarted,
+				"Cleaning up CassandraDatacenter %s after it was scaled up from %d to %d nodes", dc.Name, cleanup.FromSize, cleanup.ToSize)
+		}
+	}
+
+	if !cassandra.DatacenterScaledUpAfter(cleanup.ScaleUpTime.Time, dc) {
+		logger.Info("Waiting for cass-operator to clean up the datacenter", "Task", cleanup.Task)
+		return result.Continue()
+	}
+
+	now := metav1.Now()
+	cleanup.CompletionTime = &now
+	cleanup.Message = ""
+	if cleanup.Task != "" {
+		taskKey := client.ObjectKey{Namespace: dc.Namespace, Name: cleanup.Task}
+		task := &cassctlapi.CassandraTask{}
+		if err := remoteClient.Get(ctx, taskKey, task); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get cleanup task", "Task", taskKey)
+			return result.Error(err)
+		} else if err == nil && task.Status.Failed > 0 {
+			cleanup.Progress = api.CleanupFailed
+			cleanup.Message = fmt.Sprintf("cleanup task %s failed", taskKey.Name)
+			logger.Error(fmt.Errorf(cleanup.Message), "Datacenter cleanup failed")
+			r.recordDatacenterEvent(kc, dc, corev1.EventTypeWarning, eventReasonCleanupFailed,
+				"Cleanup of CassandraDatacenter %s failed: %s", dc.Name, cleanup.Message)
+			return result.Continue()
+		}
+	}
+
+	logger.Info("Datacenter cleanup completed", "Task", cleanup.Task)
+	cleanup.Progress = api.CleanupCompleted
+	r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonCleanupCompleted, "Cleaned up CassandraDatacenter %s", dc.Name)
+	return result.Continue()
+}
+
+// findCleanupTask returns the cleanup task that cass-operator tracks for dc, or nil if there is none.
+func findCleanupTask(ctx context.Context, dc *cassdcapi.CassandraDatacenter, remoteClient client.Client) (*cassctlapi.CassandraTask, error) {
+	for _, taskRef := range dc.Status.TrackedTasks {
+		task := &cassctlapi.CassandraTask{}
+		if err := remoteClient.Get(ctx, client.ObjectKey{Namespace: taskRef.Namespace, Name: taskRef.Name}, task); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, job := range task.Spec.Jobs {
+			if job.Command == cassctlapi.CommandCleanup {
+				return task, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// nextCleanupCheck returns when the cleanups of the datacenters of kc must be checked again, or nil if no cleanup is
+// in progress.
+func nextCleanupCheck(kc *api.K8ssandraCluster, delay time.Duration) *time.Time {
+	for _, dcStatus := range kc.Status.Datacenters {
+		if cleanup := dcStatus.Cleanup; cleanup != nil && (cleanup.Progress == api.CleanupPending || cleanup.Progress == api.CleanupRunning) {
+			next := time.Now().Add(delay)
+			return &next
+		}
+	}
+	return nil
+}
+
+func setCleanupStatus(kc *api.K8ssandraCluster, dcName string, cleanup *api.DatacenterCleanupStatus) {
+	if kc.Status.Datacenters == nil {
+		kc.Status.Datacenters = make(map[string]api.K8ssandraStatus)
+	}
+	status := kc.Status.Datacenters[dcName]
+	status.Cleanup = cleanup
+	kc.Status.Datacenters[dcName] = status
+}
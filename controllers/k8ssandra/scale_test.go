@@ -0,0 +1,365 @@
+package k8ssandra
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	cassctlapi "github.com/k8ssandra/cass-operator/apis/control/v1alpha1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMarkScaleDownStatus(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{{Meta: api.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+		},
+	}
+	assert.False(t, markScaleDownStatus(kc))
+
+	setScaleDownStatus(kc, "dc1", &api.DatacenterScaleDownStatus{FromSize: 3, ToSize: 2, Message: "keyspaces ks1 have more than 2 replicas in the datacenter"})
+	assert.True(t, markScaleDownStatus(kc))
+	assert.Equal(t, corev1.ConditionTrue, kc.Status.GetConditionStatus(api.ClusterDegraded))
+	assert.Equal(t, corev1.ConditionFalse, kc.Status.GetConditionStatus(api.ClusterReady))
+	assert.Equal(t, "Scale-down of datacenter dc1 from 3 to 2 nodes: keyspaces ks1 have more than 2 replicas in the datacenter",
+		kc.Status.GetCondition(api.ClusterDegraded).Message)
+
+	setScaleDownStatus(kc, "dc1", nil)
+	assert.False(t, markScaleDownStatus(kc))
+}
+
+func TestCheckScaleUp(t *testing.T) {
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{{Meta: api.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+		},
+	}
+	logger := logr.Discard()
+
+	checkScaleUp(kc, "dc1", 3, 4, logger)
+	cleanup := kc.Status.Datacenters["dc1"].Cleanup
+	require.NotNil(t, cleanup)
+	assert.Equal(t, api.CleanupPending, cleanup.Progress)
+	assert.Equal(t, int32(3), cleanup.FromSize)
+	assert.Equal(t, int32(4), cleanup.ToSize)
+
+	checkScaleUp(kc, "dc1", 4, 6, logger)
+	cleanup = kc.Status.Datacenters["dc1"].Cleanup
+	assert.Equal(t, int32(3), cleanup.FromSize, "a pending cleanup must cover the whole scale-up")
+	assert.Equal(t, int32(6), cleanup.ToSize)
+
+	cleanup.Progress = api.CleanupRunning
+	checkScaleUp(kc, "dc1", 6, 7, logger)
+	cleanup = kc.Status.Datacenters["dc1"].Cleanup
+	assert.Equal(t, api.CleanupPending, cleanup.Progress, "a running cleanup must be superseded")
+	assert.Equal(t, int32(6), cleanup.FromSize)
+}
+
+func TestNextCleanupCheck(t *testing.T) {
+	kc := &api.K8ssandraCluster{}
+	assert.Nil(t, nextCleanupCheck(kc, time.Minute))
+
+	setCleanupStatus(kc, "dc1", &api.DatacenterCleanupStatus{Progress: api.CleanupCompleted})
+	assert.Nil(t, nextCleanupCheck(kc, time.Minute))
+
+	setCleanupStatus(kc, "dc2", &api.DatacenterCleanupStatus{Progress: api.CleanupPending})
+	next := nextCleanupCheck(kc, time.Minute)
+	require.NotNil(t, next)
+	assert.True(t, next.Before(time.Now().Add(2*time.Minute)))
+}
+
+func TestReconcileDcCleanup(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, cassdcapi.AddToScheme(scheme))
+	require.NoError(t, cassctlapi.AddToScheme(scheme))
+
+	scaleUpTime := metav1.NewTime(time.Now().Add(-time.Minute))
+	dc := &cassdcapi.CassandraDatacenter{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "dc1"},
+		Spec:       cassdcapi.CassandraDatacenterSpec{Size: 4},
+		Status: cassdcapi.CassandraDatacenterStatus{
+			TrackedTasks: []corev1.ObjectReference{{Namespace: "test", Name: "cleanup-1"}},
+		},
+	}
+	task := &cassctlapi.CassandraTask{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cleanup-1"},
+		Spec: cassctlapi.CassandraTaskSpec{
+			Jobs: []cassctlapi.CassandraJob{{Name: "cleanup-dc1", Command: cassctlapi.CommandCleanup}},
+		},
+	}
+	remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(task).Build()
+
+	r := &K8ssandraClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{{Meta: api.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+		},
+	}
+	setCleanupStatus(kc, "dc1", &api.DatacenterCleanupStatus{FromSize: 3, ToSize: 4, Progress: api.CleanupPending, ScaleUpTime: &scaleUpTime})
+	ctx := context.Background()
+	logger := logr.Discard()
+
+	t.Log("check that the cleanup task of cass-operator is tracked")
+	recResult := r.reconcileDcCleanup(ctx, kc, dc, remoteClient, logger)
+	assert.False(t, recResult.Completed())
+	cleanup := kc.Status.Datacenters["dc1"].Cleanup
+	assert.Equal(t, api.CleanupRunning, cleanup.Progress)
+	assert.Equal(t, "cleanup-1", cleanup.Task)
+
+	t.Log("check that the cleanup completes with the scale-up")
+	dc.SetCondition(cassdcapi.DatacenterCondition{
+		Type:               cassdcapi.DatacenterScalingUp,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+	})
+	dc.Status.TrackedTasks = nil
+	r.reconcileDcCleanup(ctx, kc, dc, remoteClient, logger)
+	cleanup = kc.Status.Datacenters["dc1"].Cleanup
+	assert.Equal(t, api.CleanupCompleted, cleanup.Progress)
+	assert.NotNil(t, cleanup.CompletionTime)
+
+	t.Log("check that a failed cleanup task is reported")
+	task.Status.Failed = 1
+	require.NoError(t, remoteClient.Update(ctx, task))
+	setCleanupStatus(kc, "dc1", &api.DatacenterCleanupStatus{FromSize: 3, ToSize: 4, Progress: api.CleanupRunning, ScaleUpTime: &scaleUpTime, Task: "cleanup-1"})
+	r.reconcileDcCleanup(ctx, kc, dc, remoteClient, logger)
+	assert.Equal(t, api.CleanupFailed, kc.Status.Datacenters["dc1"].Cleanup.Progress)
+}
+
+// scaleDownDatacenter verifies that a datacenter is not scaled down below the replication factor of its keyspaces.
+func scaleDownDatacenter(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "scale-down-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	ks1Rf := int32(3)
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{"system_auth", "ks1"}, nil)
+	mockMgmtApi.On(testutils.GetKeyspaceReplication, "system_auth").Return(map[string]string{"class": cassandra.NetworkTopology, "dc1": "3"}, nil)
+	mockMgmtApi.On(testutils.GetKeyspaceReplication, "ks1").Return(func(string) map[string]string {
+		return map[string]string{"class": cassandra.NetworkTopology, "dc1": strconv.Itoa(int(atomic.LoadInt32(&ks1Rf)))}
+	}, nil)
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dcKey := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, dcKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+
+	t.Log("scale dc1 down to 2 nodes")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Spec.Cassandra.Datacenters[0].Size = 2
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	t.Log("check that the scale-down is blocked by ks1")
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		scaleDown := kc.Status.Datacenters["dc1"].ScaleDownBlocked
+		return scaleDown != nil && assert.ObjectsAreEqual([]string{"ks1"}, scaleDown.Keyspaces)
+	}, timeout, interval, "timed out waiting for the scale-down to be blocked")
+	assert.Equal(t, corev1.ConditionTrue, kc.Status.GetConditionStatus(api.ClusterDegraded))
+
+	dc := &cassdcapi.CassandraDatacenter{}
+	err = f.Get(ctx, dcKey, dc)
+	require.NoError(err, "failed to get dc1")
+	assert.Equal(t, int32(3), dc.Spec.Size)
+
+	t.Log("reduce the replication of ks1")
+	atomic.StoreInt32(&ks1Rf, 2)
+	// Trigger a reconciliation, the replication of keyspaces is not watched
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to update dc1 status")
+
+	t.Log("check that dc1 is scaled down")
+	require.Eventually(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 2
+	}), timeout, interval, "timed out waiting for dc1 to be scaled down")
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		return kc.Status.Datacenters["dc1"].ScaleDownBlocked == nil
+	}, timeout, interval, "timed out waiting for the scale-down status to be cleared")
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
+
+// cleanupAfterScaleUp verifies that the nodes of a datacenter are cleaned up once it is scaled up.
+func cleanupAfterScaleUp(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "cleanup-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "4.0.3",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{"system_auth", "ks1"}, nil)
+	mockMgmtApi.On(testutils.GetKeyspaceReplication, mock.Anything).Return(map[string]string{"class": cassandra.NetworkTopology, "dc1": "3"}, nil)
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dcKey := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	require.Eventually(f.DatacenterExists(ctx, dcKey), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dcKey)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+	assert.Nil(t, kc.Status.Datacenters["dc1"].Cleanup, "no cleanup is needed when a datacenter is created")
+
+	t.Log("scale dc1 up to 4 nodes")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Spec.Cassandra.Datacenters[0].Size = 4
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	require.Eventually(f.NewWithDatacenter(ctx, dcKey)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.Size == 4
+	}), timeout, interval, "timed out waiting for dc1 to be scaled up")
+	var cleanup *api.DatacenterCleanupStatus
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		cleanup = kc.Status.Datacenters["dc1"].Cleanup
+		return cleanup != nil && cleanup.Progress == api.CleanupPending
+	}, timeout, interval, "timed out waiting for the cleanup to be pending")
+	assert.Equal(t, int32(3), cleanup.FromSize)
+	assert.Equal(t, int32(4), cleanup.ToSize)
+
+	t.Log("simulate the cleanup task of cass-operator")
+	taskKey := framework.NewClusterKey(k8sCtx0, namespace, "cleanup-1")
+	task := &cassctlapi.CassandraTask{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: taskKey.Name},
+		Spec: cassctlapi.CassandraTaskSpec{
+			Datacenter: corev1.ObjectReference{Namespace: namespace, Name: "dc1"},
+			Jobs:       []cassctlapi.CassandraJob{{Name: "cleanup-dc1", Command: cassctlapi.CommandCleanup}},
+		},
+	}
+	err = f.Create(ctx, taskKey, task)
+	require.NoError(err, "failed to create cleanup task")
+	err = f.PatchDatacenterStatus(ctx, dcKey, func(dc *cassdcapi.CassandraDatacenter) {
+		dc.Status.TrackedTasks = []corev1.ObjectReference{{Namespace: namespace, Name: taskKey.Name}}
+	})
+	require.NoError(err, "failed to update dc1 status")
+
+	t.Log("check that the cleanup task is tracked")
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		cleanup = kc.Status.Datacenters["dc1"].Cleanup
+		return cleanup != nil && cleanup.Progress == api.CleanupRunning
+	}, timeout, interval, "timed out waiting for the cleanup to start")
+	assert.Equal(t, taskKey.Name, cleanup.Task)
+
+	t.Log("complete the cleanup and the scale-up of dc1")
+	err = f.PatchDatacenterStatus(ctx, dcKey, func(dc *cassdcapi.CassandraDatacenter) {
+		dc.Status.TrackedTasks = nil
+		dc.SetCondition(cassdcapi.DatacenterCondition{
+			Type:               cassdcapi.DatacenterScalingUp,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.NewTime(cleanup.ScaleUpTime.Add(time.Second)),
+		})
+	})
+	require.NoError(err, "failed to update dc1 status")
+
+	require.Eventually(func() bool {
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		cleanup = kc.Status.Datacenters["dc1"].Cleanup
+		return cleanup != nil && cleanup.Progress == api.CleanupCompleted
+	}, timeout, interval, "timed out waiting for the cleanup to complete")
+	assert.NotNil(t, cleanup.CompletionTime)
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
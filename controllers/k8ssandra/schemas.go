@@ -17,6 +17,7 @@ import (
 	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/stargate"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -59,8 +60,8 @@ func (r *K8ssandraClusterReconciler) checkSchemas(
 		status.DecommissionProgress = api.DecommDeleting
 		kc.Status.Datacenters[decommDcName] = status
 		return result.RequeueSoon(r.DefaultDelay)
-	} else if annotations.HasAnnotationWithValue(kc, api.RebuildDcAnnotation, dc.Name) {
-		if recResult := r.updateUserKeyspacesReplication(kc, dc, mgmtApi, logger); recResult.Completed() {
+	} else if kc.IsRebuilding(dc.Name) {
+		if recResult := r.updateUserKeyspacesReplication(ctx, kc, dc, mgmtApi, logger); recResult.Completed() {
 			return recResult
 		}
 	}
@@ -68,6 +69,49 @@ func (r *K8ssandraClusterReconciler) checkSchemas(
 	return result.Continue()
 }
 
+// checkReplicationReady is called once the replication of the system, Stargate and Reaper keyspaces has been updated
+// through each running datacenter of kc. It checks through the first of them that the nodes agree on the schema, and
+// only then sets the ReplicationReady and SchemaAgreement conditions. Both conditions are left untouched if no
+// datacenter is running.
+func (r *K8ssandraClusterReconciler) checkReplicationReady(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcs []*cassdcapi.CassandraDatacenter,
+	logger logr.Logger) result.ReconcileResult {
+
+	for i, dc := range dcs {
+		if dc.Spec.Stopped || !cassandra.DatacenterReady(dc) {
+			continue
+		}
+
+		remoteClient, err := r.ClientCache.GetRemoteClient(kc.Spec.Cassandra.Datacenters[i].K8sContext)
+		if err != nil {
+			logger.Error(err, "Failed to get remote client")
+			return result.Error(err)
+		}
+		mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, dc, remoteClient, logger)
+		if err != nil {
+			return result.Error(err)
+		}
+
+		if agreement, err := mgmtApi.HasSchemaAgreement(); err != nil {
+			logger.Error(err, "Failed to check schema agreement")
+			kc.Status.SetConditionStatus(api.ClusterSchemaAgreement, corev1.ConditionUnknown, reasonSchemaCheckFailed, err.Error())
+			return result.Error(err)
+		} else if !agreement {
+			logger.Info("Waiting for schema agreement")
+			markSchemaAgreement(kc, false)
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+
+		markSchemaAgreement(kc, true)
+		kc.Status.SetConditionStatus(api.ClusterReplicationReady, corev1.ConditionTrue, reasonReplicationUpdated, "")
+		return result.Continue()
+	}
+
+	return result.Continue()
+}
+
 func (r *K8ssandraClusterReconciler) checkSchemaAgreement(mgmtApi cassandra.ManagementApiFacade, logger logr.Logger) result.ReconcileResult {
 	versions, err := mgmtApi.GetSchemaVersions()
 	if err != nil {
@@ -163,6 +207,7 @@ func (r *K8ssandraClusterReconciler) updateReplicationOfSystemKeyspaces(
 	for _, ks := range api.SystemKeyspaces {
 		if err := mgmtApi.EnsureKeyspaceReplication(ks, replication); err != nil {
 			if kerrors.IsSchemaDisagreement(err) {
+				markSchemaAgreement(kc, false)
 				return result.RequeueSoon(r.DefaultDelay)
 			}
 			logger.Error(err, "Failed to update replication", "keyspace", ks)
@@ -180,8 +225,10 @@ func (r *K8ssandraClusterReconciler) updateReplicationOfSystemKeyspaces(
 // cluster that has dc1 and if the annotation specifies changes for both dc1 and dc2, only
 // changes for dc2 will be applied. Replication for all user-defined keyspaces must be
 // specified; otherwise an error is returned. This is required to avoid surprises for the
-// user.
+// user. Keyspaces managed through CassandraKeyspace objects are excluded since their
+// replication is reconciled by the CassandraKeyspace controller.
 func (r *K8ssandraClusterReconciler) updateUserKeyspacesReplication(
+	ctx context.Context,
 	kc *api.K8ssandraCluster,
 	dc *cassdcapi.CassandraDatacenter,
 	mgmtApi cassandra.ManagementApiFacade,
@@ -201,6 +248,15 @@ func (r *K8ssandraClusterReconciler) updateUserKeyspacesReplication(
 		return result.Error(err)
 	}
 
+	managedKeyspaces, err := getManagedKeyspaces(ctx, r.Client, kc)
+	if err != nil {
+		logger.Error(err, "Failed to get managed keyspaces")
+		return result.Error(err)
+	}
+	for _, ks := range managedKeyspaces {
+		userKeyspaces = utils.RemoveValue(userKeyspaces, ks)
+	}
+
 	replication, err := cassandra.ParseReplication([]byte(jsonReplication))
 	if err != nil {
 		logger.Error(err, "Failed to parse replication")
@@ -235,6 +291,7 @@ func (r *K8ssandraClusterReconciler) updateUserKeyspacesReplication(
 		}
 		if err = ensureKeyspaceReplication(mgmtApi, ks, dc.Name, replicationFactor); err != nil {
 			if kerrors.IsSchemaDisagreement(err) {
+				markSchemaAgreement(kc, false)
 				return result.RequeueSoon(r.DefaultDelay)
 			}
 			logger.Error(err, "Keyspace replication check failed", "Keyspace", ks)
@@ -269,6 +326,7 @@ func (r *K8ssandraClusterReconciler) updateUserKeyspacesReplicationForDecommissi
 			delete(replication, decommDc)
 			if err = mgmtApi.AlterKeyspace(ks, replication); err != nil {
 				if kerrors.IsSchemaDisagreement(err) {
+					markSchemaAgreement(kc, false)
 					return result.RequeueSoon(r.DefaultDelay)
 				}
 				return result.Error(fmt.Errorf("failed to update replication for keyspace (%s): %v", ks, err))
@@ -342,7 +400,11 @@ func getKeyspaceReplication(mgmtApi cassandra.ManagementApiFacade, ks string) (m
 	if err != nil {
 		return nil, err
 	}
+	return parseKeyspaceReplication(settings)
+}
 
+// parseKeyspaceReplication converts the replication settings of a keyspace to a map of DCs to their replica counts.
+func parseKeyspaceReplication(settings map[string]string) (map[string]int, error) {
 	replication := make(map[string]int)
 	for k, v := range settings {
 		if k == "class" {
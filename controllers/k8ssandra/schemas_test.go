@@ -0,0 +1,70 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCheckReplicationReady(t *testing.T) {
+	agreement := false
+	mgmtApi := testutils.NewFakeManagementApiFacade()
+	mgmtApi.On(testutils.HasSchemaAgreement).Return(func() bool { return agreement }, nil)
+	mgmtApiFactory := &testutils.FakeManagementApiFactory{}
+	mgmtApiFactory.SetT(t)
+	mgmtApiFactory.SetAdapter(func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mgmtApi, nil
+	})
+	c := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+	r := &K8ssandraClusterReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		ClientCache:      clientcache.New(c, c, runtime.NewScheme()),
+		ManagementApi:    mgmtApiFactory,
+	}
+
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}},
+				},
+			},
+		},
+	}
+	dc1 := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Name: "dc1"}, Spec: cassdcapi.CassandraDatacenterSpec{Stopped: true}}
+	dc2 := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Name: "dc2"}}
+	dcs := []*cassdcapi.CassandraDatacenter{dc1, dc2}
+
+	t.Log("the conditions are not set if no datacenter is running")
+	assert.False(t, r.checkReplicationReady(context.Background(), kc, dcs, logr.Discard()).Completed())
+	assert.Equal(t, corev1.ConditionUnknown, kc.Status.GetConditionStatus(api.ClusterReplicationReady))
+	assert.Equal(t, corev1.ConditionUnknown, kc.Status.GetConditionStatus(api.ClusterSchemaAgreement))
+	mgmtApi.AssertNotCalled(t, testutils.HasSchemaAgreement)
+
+	t.Log("the replication is not ready until the nodes agree on the schema")
+	dc2.SetCondition(cassdcapi.DatacenterCondition{Type: cassdcapi.DatacenterReady, Status: corev1.ConditionTrue})
+	dc2.Status.CassandraOperatorProgress = cassdcapi.ProgressReady
+	assert.True(t, r.checkReplicationReady(context.Background(), kc, dcs, logr.Discard()).Completed())
+	assert.Equal(t, corev1.ConditionUnknown, kc.Status.GetConditionStatus(api.ClusterReplicationReady))
+	assert.Equal(t, corev1.ConditionFalse, kc.Status.GetConditionStatus(api.ClusterSchemaAgreement))
+
+	agreement = true
+	assert.False(t, r.checkReplicationReady(context.Background(), kc, dcs, logr.Discard()).Completed())
+	assert.Equal(t, corev1.ConditionTrue, kc.Status.GetConditionStatus(api.ClusterReplicationReady))
+	assert.Equal(t, corev1.ConditionTrue, kc.Status.GetConditionStatus(api.ClusterSchemaAgreement))
+}
@@ -20,37 +20,52 @@ func (r *K8ssandraClusterReconciler) findSeeds(ctx context.Context, kc *api.K8ss
 	pods := make([]corev1.Pod, 0)
 
 	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
-		remoteClient, err := r.ClientCache.GetRemoteClient(dcTemplate.K8sContext)
-		if err != nil {
-			logger.Error(err, "Failed to get remote client", "K8sContext", dcTemplate.K8sContext)
-			return nil, err
-		}
-
 		namespace := kc.Namespace
 		if dcTemplate.Meta.Namespace != "" {
 			namespace = dcTemplate.Meta.Namespace
 		}
 
-		list := &corev1.PodList{}
-		selector := map[string]string{
-			cassdcapi.ClusterLabel:    kc.Name,
-			cassdcapi.DatacenterLabel: dcTemplate.Meta.Name,
-			cassdcapi.SeedNodeLabel:   "true",
-		}
-
 		dcKey := client.ObjectKey{Namespace: namespace, Name: dcTemplate.Meta.Name}
-
-		if err := remoteClient.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels(selector)); err != nil {
-			logger.Error(err, "Failed to get seed pods", "K8sContext", dcTemplate.K8sContext, "DC", dcKey)
+		dcPods, err := r.findDatacenterSeeds(ctx, kc, dcKey, dcTemplate.K8sContext, logger)
+		if err != nil {
 			return nil, err
 		}
 
-		pods = append(pods, list.Items...)
+		pods = append(pods, dcPods...)
 	}
 
 	return pods, nil
 }
 
+// findDatacenterSeeds returns the pods labeled as seeds of the datacenter dcKey, in the Kubernetes cluster k8sContext.
+func (r *K8ssandraClusterReconciler) findDatacenterSeeds(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcKey client.ObjectKey,
+	k8sContext string,
+	logger logr.Logger) ([]corev1.Pod, error) {
+
+	remoteClient, err := r.ClientCache.GetRemoteClient(k8sContext)
+	if err != nil {
+		logger.Error(err, "Failed to get remote client", "K8sContext", k8sContext)
+		return nil, err
+	}
+
+	list := &corev1.PodList{}
+	selector := map[string]string{
+		cassdcapi.ClusterLabel:    kc.Name,
+		cassdcapi.DatacenterLabel: dcKey.Name,
+		cassdcapi.SeedNodeLabel:   "true",
+	}
+
+	if err := remoteClient.List(ctx, list, client.InNamespace(dcKey.Namespace), client.MatchingLabels(selector)); err != nil {
+		logger.Error(err, "Failed to get seed pods", "K8sContext", k8sContext, "DC", dcKey)
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
 func (r *K8ssandraClusterReconciler) reconcileSeedsEndpoints(
 	ctx context.Context,
 	dc *cassdcapi.CassandraDatacenter,
@@ -30,7 +30,7 @@ func (r *K8ssandraClusterReconciler) reconcileStargate(
 ) result.ReconcileResult {
 
 	kcKey := client.ObjectKey{Namespace: kc.Namespace, Name: kc.Name}
-	stargateTemplate := dcTemplate.Stargate.Coalesce(kc.Spec.Stargate)
+	stargateTemplate := stargateTemplateFor(kc, dcTemplate, actualDc, logger)
 	stargateKey := types.NamespacedName{
 		Namespace: actualDc.Namespace,
 		Name:      stargate.ResourceName(actualDc),
@@ -38,11 +38,6 @@ func (r *K8ssandraClusterReconciler) reconcileStargate(
 	actualStargate := &stargateapi.Stargate{}
 	logger = logger.WithValues("Stargate", stargateKey)
 
-	if actualDc.Spec.Stopped && stargateTemplate != nil {
-		logger.Info("DC is stopped: skipping Stargate deployment")
-		stargateTemplate = nil
-	}
-
 	if stargateTemplate != nil {
 		logger.Info("Reconcile Stargate")
 		desiredStargate := r.newStargate(stargateKey, kc, stargateTemplate, actualDc, dcTemplate, logger)
@@ -55,6 +50,7 @@ func (r *K8ssandraClusterReconciler) reconcileStargate(
 					logger.Error(err, "Failed to create Stargate resource")
 					return result.Error(err)
 				} else {
+					r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonCreatedStargate, "Created Stargate %s for datacenter %s", stargateKey.Name, actualDc.Name)
 					return result.RequeueSoon(r.DefaultDelay)
 				}
 			} else {
@@ -69,6 +65,7 @@ func (r *K8ssandraClusterReconciler) reconcileStargate(
 			if !annotations.CompareHashAnnotations(desiredStargate, actualStargate) {
 				logger.Info("Updating Stargate")
 				resourceVersion := actualStargate.GetResourceVersion()
+				annotations.KeepPaused(actualStargate, desiredStargate)
 				desiredStargate.DeepCopyInto(actualStargate)
 				actualStargate.SetResourceVersion(resourceVersion)
 				if err = remoteClient.Update(ctx, actualStargate); err == nil {
@@ -102,6 +99,7 @@ func (r *K8ssandraClusterReconciler) reconcileStargate(
 			} else {
 				r.removeStargateStatus(kc, dcTemplate.Meta.Name)
 				logger.Info("Stargate deleted")
+				r.Recorder.Eventf(kc, corev1.EventTypeNormal, eventReasonDeletedStargate, "Deleted Stargate %s of datacenter %s", stargateKey.Name, actualDc.Name)
 			}
 		} else {
 			logger.Info("Not deleting Stargate since it wasn't created by this controller")
@@ -110,6 +108,16 @@ func (r *K8ssandraClusterReconciler) reconcileStargate(
 	return result.Continue()
 }
 
+// stargateTemplateFor returns the template of the Stargate of dc, or nil if dc must not have a Stargate.
+func stargateTemplateFor(kc *api.K8ssandraCluster, dcTemplate api.CassandraDatacenterTemplate, dc *cassdcapi.CassandraDatacenter, logger logr.Logger) *stargateapi.StargateDatacenterTemplate {
+	stargateTemplate := dcTemplate.Stargate.Coalesce(kc.Spec.Stargate)
+	if dc.Spec.Stopped && stargateTemplate != nil {
+		logger.Info("DC is stopped: skipping Stargate deployment")
+		stargateTemplate = nil
+	}
+	return stargateTemplate
+}
+
 // TODO move to stargate package
 func (r *K8ssandraClusterReconciler) newStargate(stargateKey types.NamespacedName, kc *api.K8ssandraCluster, stargateTemplate *stargateapi.StargateDatacenterTemplate, actualDc *cassdcapi.CassandraDatacenter, dcTemplate api.CassandraDatacenterTemplate, logger logr.Logger) *stargateapi.Stargate {
 	cassandraEncryption := stargateapi.CassandraEncryption{}
@@ -145,6 +153,10 @@ func (r *K8ssandraClusterReconciler) newStargate(stargateKey types.NamespacedNam
 			CassandraEncryption:        &cassandraEncryption,
 		},
 	}
+	// Stargate nodes cache the credentials of the roles, they are restarted after a credentials rotation
+	if revision := kc.CredentialsRevision(); revision != "" {
+		desiredStargate.Annotations[api.CredentialsRevisionAnnotation] = revision
+	}
 	return desiredStargate
 }
 
@@ -193,6 +205,7 @@ func (r *K8ssandraClusterReconciler) reconcileStargateAuthSchema(
 
 	if err := stargate.ReconcileAuthKeyspace(mgmtApi, replication, logger); err != nil {
 		if kerrors.IsSchemaDisagreement(err) {
+			markSchemaAgreement(kc, false)
 			return result.RequeueSoon(r.DefaultDelay)
 		}
 		return result.Error(err)
@@ -145,6 +145,7 @@ func stopDcTestSetup(t *testing.T, f *framework.Framework, ctx context.Context,
 // panic. This ensures that stopping a DC does not modify the desired replication of keyspaces in the cluster.
 func stopDcManagementApiReset(replication map[string]int) {
 	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
 	// Only accept calls to EnsureKeyspaceReplication with the desired replication for system keyspaces:
 	for _, keyspace := range api.SystemKeyspaces {
 		mockMgmtApi.On(testutils.EnsureKeyspaceReplication, keyspace, replication).Return(nil)
@@ -0,0 +1,401 @@
+package k8ssandra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	"github.com/k8ssandra/cass-operator/pkg/httphelper"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/result"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// sstablesUpgradeTimeout is how long the SSTables of a datacenter may take to be upgraded before the upgrade is
+	// considered failed.
+	sstablesUpgradeTimeout = 24 * time.Hour
+
+	// The statuses of the management API jobs.
+	jobStatusCompleted = "COMPLETED"
+	jobStatusError     = "ERROR"
+)
+
+// checkVersionUpgrade decides whether a change of the Cassandra version of an existing datacenter can be applied now.
+// Upgrades are rolled out one datacenter at a time, only when the datacenter is ready and the cluster is in schema
+// agreement. As long as the upgrade cannot start, dcConfig is reverted to the version and image currently deployed so
+// that other changes to the datacenter can still be applied.
+func (r *K8ssandraClusterReconciler) checkVersionUpgrade(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dcConfig *cassandra.DatacenterConfig,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	dcName := dcConfig.Meta.Name
+	dcNamespace := dcConfig.Meta.Namespace
+	if dcNamespace == "" {
+		dcNamespace = kc.Namespace
+	}
+
+	actualDc := &cassdcapi.CassandraDatacenter{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: dcNamespace, Name: dcName}, actualDc); err != nil {
+		if errors.IsNotFound(err) {
+			return result.Continue()
+		}
+		logger.Error(err, "Failed to get datacenter")
+		return result.Error(err)
+	}
+	r.setStatusForDatacenter(kc, actualDc)
+
+	if recResult := r.checkResumeUpgradeAnnotation(ctx, kc, actualDc, remoteClient, logger); recResult.Completed() {
+		return recResult
+	}
+
+	fromVersion := actualDc.Spec.ServerVersion
+	toVersion := dcConfig.ServerVersion
+	upgrade := kc.Status.Datacenters[dcName].Upgrade
+
+	if fromVersion == toVersion {
+		releaseMaintenance(kc, dcName, api.MaintenanceUpgrade)
+		if upgrade != nil && (upgrade.Progress == api.UpgradePending || (upgrade.Progress == api.UpgradeFailed && upgrade.ToVersion != toVersion)) {
+			// The version change was reverted before the upgrade could start
+			setUpgradeStatus(kc, dcName, nil)
+		}
+		return result.Continue()
+	}
+
+	if upgrade != nil && upgrade.ToVersion == toVersion && upgrade.Progress == api.UpgradeUpgradingNodes {
+		// The upgrade already started but the new version could not be pushed to the datacenter yet
+		return result.Continue()
+	}
+
+	holdVersion := func(progress api.UpgradeProgress, message string) {
+		logger.Info("Holding Cassandra version upgrade", "FromVersion", fromVersion, "ToVersion", toVersion, "Reason", message)
+		dcConfig.ServerVersion = actualDc.Spec.ServerVersion
+		dcConfig.ServerImage = actualDc.Spec.ServerImage
+		setUpgradeStatus(kc, dcName, &api.DatacenterUpgradeStatus{
+			FromVersion: fromVersion,
+			ToVersion:   toVersion,
+			Progress:    progress,
+			Message:     message,
+		})
+	}
+
+	if upgrade != nil && upgrade.ToVersion == toVersion && upgrade.Progress == api.UpgradeFailed {
+		holdVersion(api.UpgradeFailed, upgrade.Message)
+		return result.Continue()
+	}
+
+	if err := cassandra.ValidateUpgrade(fromVersion, toVersion); err != nil {
+		holdVersion(api.UpgradeFailed, err.Error())
+		r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeWarning, eventReasonUpgradeRejected,
+			"Rejected the upgrade of CassandraDatacenter %s: %v", dcName, err)
+		return result.Continue()
+	}
+
+	if otherDcName, otherUpgrade := findBlockingUpgrade(kc, dcName); otherUpgrade != nil {
+		if otherUpgrade.Progress == api.UpgradeFailed {
+			holdVersion(api.UpgradePending, fmt.Sprintf("upgrades are paused because the upgrade of datacenter %s failed", otherDcName))
+		} else {
+			holdVersion(api.UpgradePending, fmt.Sprintf("waiting for the upgrade of datacenter %s to complete", otherDcName))
+		}
+		return result.Continue()
+	}
+
+	if actualDc.Spec.Stopped || dcConfig.Stopped {
+		holdVersion(api.UpgradePending, "the datacenter is stopped")
+		return result.Continue()
+	}
+
+	if !cassandra.DatacenterReady(actualDc) {
+		holdVersion(api.UpgradePending, "waiting for the datacenter to be ready")
+		return result.Continue()
+	}
+
+	mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, actualDc, remoteClient, logger)
+	if err != nil {
+		return result.Error(err)
+	}
+	if agreement, err := mgmtApi.HasSchemaAgreement(); err != nil {
+		logger.Error(err, "Failed to check schema agreement")
+		kc.Status.SetConditionStatus(api.ClusterSchemaAgreement, corev1.ConditionUnknown, reasonSchemaCheckFailed, err.Error())
+		holdVersion(api.UpgradePending, "failed to check schema agreement")
+		return result.RequeueSoon(r.DefaultDelay)
+	} else if !agreement {
+		markSchemaAgreement(kc, false)
+		holdVersion(api.UpgradePending, "waiting for schema agreement")
+		return result.RequeueSoon(r.DefaultDelay)
+	}
+
+	if _, held := r.holdForMaintenance(kc, dcName, api.MaintenanceUpgrade, maintenanceWindowFor(kc, dcName), logger); held {
+		holdVersion(api.UpgradePending, "waiting for the maintenance window to open")
+		return result.Continue()
+	}
+
+	logger.Info("Starting Cassandra version upgrade", "FromVersion", fromVersion, "ToVersion", toVersion)
+	r.recordDatacenterEvent(kc, actualDc, corev1.EventTypeNormal, eventReasonUpgradeStarted,
+		"Upgrading CassandraDatacenter %s from %s to %s", dcName, fromVersion, toVersion)
+	now := metav1.Now()
+	setUpgradeStatus(kc, dcName, &api.DatacenterUpgradeStatus{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Progress:    api.UpgradeUpgradingNodes,
+		StartTime:   &now,
+	})
+	return result.Continue()
+}
+
+// reconcileDcUpgrade drives the upgrade of a datacenter once the new version has been pushed to it: it waits for all
+// the pods to be restarted and for the cluster to be in schema agreement, then upgrades SSTables if the release series
+// changed. dc is expected to be ready.
+func (r *K8ssandraClusterReconciler) reconcileDcUpgrade(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	upgrade := kc.Status.Datacenters[dc.Name].Upgrade
+	if upgrade == nil {
+		return result.Continue()
+	}
+	upgrade = upgrade.DeepCopy()
+
+	switch upgrade.Progress {
+	case api.UpgradeUpgradingNodes:
+		if upgrade.StartTime != nil && !cassandra.DatacenterUpdatedAfter(upgrade.StartTime.Time, dc) {
+			logger.Info("Waiting for datacenter pods to be upgraded", "ToVersion", upgrade.ToVersion)
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+
+		mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, dc, remoteClient, logger)
+		if err != nil {
+			return result.Error(err)
+		}
+		if agreement, err := mgmtApi.HasSchemaAgreement(); err != nil {
+			logger.Error(err, "Failed to check schema agreement")
+			kc.Status.SetConditionStatus(api.ClusterSchemaAgreement, corev1.ConditionUnknown, reasonSchemaCheckFailed, err.Error())
+			return result.Error(err)
+		} else if !agreement {
+			markSchemaAgreement(kc, false)
+			logger.Info("Waiting for schema agreement after datacenter upgrade")
+			return result.RequeueSoon(r.DefaultDelay)
+		}
+
+		if !cassandra.RequiresSSTablesUpgrade(upgrade.FromVersion, upgrade.ToVersion) {
+			r.completeUpgrade(kc, dc, upgrade, logger)
+			return result.Continue()
+		}
+
+		logger.Info("Upgrading SSTables")
+		now := metav1.Now()
+		upgrade.Progress = api.UpgradeUpgradingSSTables
+		upgrade.SSTablesStartTime = &now
+		setUpgradeStatus(kc, dc.Name, upgrade)
+		r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonUpgradingSSTables,
+			"Upgrading the SSTables of CassandraDatacenter %s", dc.Name)
+		fallthrough
+
+	case api.UpgradeUpgradingSSTables:
+		return r.upgradeSSTables(ctx, kc, dc, upgrade, remoteClient, logger)
+	}
+
+	return result.Continue()
+}
+
+// upgradeSSTables starts an upgradesstables job through the management API of each pod of dc and waits for all of
+// them to complete. The upgrade fails if a job fails or if the jobs do not complete within sstablesUpgradeTimeout.
+func (r *K8ssandraClusterReconciler) upgradeSSTables(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	upgrade *api.DatacenterUpgradeStatus,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	pods := &corev1.PodList{}
+	if err := remoteClient.List(ctx, pods, client.InNamespace(dc.Namespace), client.MatchingLabels{cassdcapi.DatacenterLabel: dc.Name}); err != nil {
+		logger.Error(err, "Failed to list datacenter pods")
+		return result.Error(err)
+	}
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+
+	mgmtApi, err := r.ManagementApi.NewManagementApiFacade(ctx, dc, remoteClient, logger)
+	if err != nil {
+		return result.Error(err)
+	}
+
+	if upgrade.SSTablesJobs == nil {
+		upgrade.SSTablesJobs = make(map[string]string)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if utils.SliceContains(upgrade.SSTablesUpgradedPods, pod.Name) {
+			continue
+		}
+
+		jobId, found := upgrade.SSTablesJobs[pod.Name]
+		if !found {
+			jobId, err = mgmtApi.UpgradeSSTables(pod)
+			if err != nil {
+				if reqErr, ok := err.(*httphelper.RequestError); ok && reqErr.NotFound() {
+					return r.failUpgrade(kc, dc, upgrade, fmt.Sprintf("the management API of pod %s does not support asynchronous SSTables upgrades", pod.Name), logger)
+				}
+				logger.Error(err, "Failed to start SSTables upgrade", "Pod", pod.Name)
+				continue
+			}
+			logger.Info("Started SSTables upgrade", "Pod", pod.Name, "Job", jobId)
+			upgrade.SSTablesJobs[pod.Name] = jobId
+			continue
+		}
+
+		job, err := mgmtApi.GetJobDetails(pod, jobId)
+		if err != nil {
+			logger.Error(err, "Failed to get SSTables upgrade job", "Pod", pod.Name, "Job", jobId)
+			continue
+		}
+		switch job.Status {
+		case jobStatusCompleted:
+			logger.Info("SSTables upgrade completed", "Pod", pod.Name)
+			delete(upgrade.SSTablesJobs, pod.Name)
+			upgrade.SSTablesUpgradedPods = append(upgrade.SSTablesUpgradedPods, pod.Name)
+		case jobStatusError:
+			return r.failUpgrade(kc, dc, upgrade, fmt.Sprintf("upgradesstables failed on pod %s: %s", pod.Name, job.Error), logger)
+		case "":
+			// The pod restarted and lost track of the job, start it again
+			logger.Info("SSTables upgrade job not found, restarting it", "Pod", pod.Name, "Job", jobId)
+			delete(upgrade.SSTablesJobs, pod.Name)
+		}
+	}
+	setUpgradeStatus(kc, dc.Name, upgrade)
+
+	pending := 0
+	for _, pod := range pods.Items {
+		if !utils.SliceContains(upgrade.SSTablesUpgradedPods, pod.Name) {
+			pending++
+		}
+	}
+	if len(pods.Items) > 0 && pending == 0 {
+		upgrade.SSTablesJobs = nil
+		r.completeUpgrade(kc, dc, upgrade, logger)
+		return result.Continue()
+	}
+
+	if upgrade.SSTablesStartTime != nil && time.Since(upgrade.SSTablesStartTime.Time) > sstablesUpgradeTimeout {
+		return r.failUpgrade(kc, dc, upgrade, fmt.Sprintf("upgradesstables did not complete within %s", sstablesUpgradeTimeout), logger)
+	}
+
+	logger.Info("Waiting for SSTables upgrade to complete", "PendingPods", pending)
+	return result.RequeueSoon(r.DefaultDelay)
+}
+
+func (r *K8ssandraClusterReconciler) failUpgrade(
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	upgrade *api.DatacenterUpgradeStatus,
+	message string,
+	logger logr.Logger) result.ReconcileResult {
+
+	upgrade.Progress = api.UpgradeFailed
+	upgrade.Message = message
+	upgrade.SSTablesJobs = nil
+	setUpgradeStatus(kc, dc.Name, upgrade)
+	logger.Error(fmt.Errorf(message), "Cassandra version upgrade failed, pausing upgrades")
+	r.recordDatacenterEvent(kc, dc, corev1.EventTypeWarning, eventReasonUpgradeFailed,
+		"Upgrade of CassandraDatacenter %s to %s failed: %s", dc.Name, upgrade.ToVersion, upgrade.Message)
+	return result.Continue()
+}
+
+// checkResumeUpgradeAnnotation handles the ResumeUpgradeAnnotation for dc. If the upgrade of dc failed after its
+// nodes were upgraded, the upgrade resumes from the schema agreement check and the SSTables upgrade is retried on
+// the pods where it did not complete. If it failed before, the upgrade is reevaluated from scratch.
+func (r *K8ssandraClusterReconciler) checkResumeUpgradeAnnotation(
+	ctx context.Context,
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	remoteClient client.Client,
+	logger logr.Logger) result.ReconcileResult {
+
+	if !annotations.HasAnnotationWithValue(kc, api.ResumeUpgradeAnnotation, dc.Name) {
+		return result.Continue()
+	}
+
+	if err := r.removeAnnotation(ctx, kc, api.ResumeUpgradeAnnotation); err != nil {
+		return result.Error(err)
+	}
+
+	upgrade := kc.Status.Datacenters[dc.Name].Upgrade
+	if upgrade == nil || upgrade.Progress != api.UpgradeFailed {
+		return result.Continue()
+	}
+
+	logger.Info("Resuming Cassandra version upgrade", "ToVersion", upgrade.ToVersion)
+	r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonUpgradeResumed,
+		"Resuming the upgrade of CassandraDatacenter %s to %s", dc.Name, upgrade.ToVersion)
+	if dc.Spec.ServerVersion != upgrade.ToVersion {
+		setUpgradeStatus(kc, dc.Name, nil)
+		return result.Continue()
+	}
+
+	// The pods whose SSTables were upgraded are not upgraded again
+	upgrade = upgrade.DeepCopy()
+	upgrade.Progress = api.UpgradeUpgradingNodes
+	upgrade.Message = ""
+	upgrade.SSTablesStartTime = nil
+	upgrade.SSTablesJobs = nil
+	setUpgradeStatus(kc, dc.Name, upgrade)
+	return result.Continue()
+}
+
+// findBlockingUpgrade returns the first datacenter other than dcName whose upgrade is in progress or failed, along
+// with its upgrade status.
+func findBlockingUpgrade(kc *api.K8ssandraCluster, dcName string) (string, *api.DatacenterUpgradeStatus) {
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName {
+			continue
+		}
+		if upgrade := kc.Status.Datacenters[dcTemplate.Meta.Name].Upgrade; upgrade != nil {
+			switch upgrade.Progress {
+			case api.UpgradeUpgradingNodes, api.UpgradeUpgradingSSTables, api.UpgradeFailed:
+				return dcTemplate.Meta.Name, upgrade
+			}
+		}
+	}
+	return "", nil
+}
+
+func (r *K8ssandraClusterReconciler) completeUpgrade(
+	kc *api.K8ssandraCluster,
+	dc *cassdcapi.CassandraDatacenter,
+	upgrade *api.DatacenterUpgradeStatus,
+	logger logr.Logger) {
+
+	logger.Info("Cassandra version upgrade completed", "ToVersion", upgrade.ToVersion)
+	now := metav1.Now()
+	upgrade.Progress = api.UpgradeCompleted
+	upgrade.CompletionTime = &now
+	upgrade.Message = ""
+	setUpgradeStatus(kc, dc.Name, upgrade)
+	r.recordDatacenterEvent(kc, dc, corev1.EventTypeNormal, eventReasonUpgradeCompleted,
+		"Upgraded CassandraDatacenter %s to %s", dc.Name, upgrade.ToVersion)
+}
+
+func setUpgradeStatus(kc *api.K8ssandraCluster, dcName string, upgrade *api.DatacenterUpgradeStatus) {
+	if kc.Status.Datacenters == nil {
+		kc.Status.Datacenters = make(map[string]api.K8ssandraStatus)
+	}
+	status := kc.Status.Datacenters[dcName]
+	status.Upgrade = upgrade
+	kc.Status.Datacenters[dcName] = status
+}
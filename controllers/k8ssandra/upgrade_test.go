@@ -0,0 +1,280 @@
+package k8ssandra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	"github.com/k8ssandra/cass-operator/pkg/httphelper"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"github.com/k8ssandra/k8ssandra-operator/test/framework"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+)
+
+// upgradeCassandraVersion verifies that a change of the Cassandra version is rolled out one DC at a time, and that
+// SSTables are upgraded in a DC before the next one gets upgraded.
+func upgradeCassandraVersion(t *testing.T, ctx context.Context, f *framework.Framework, namespace string) {
+	require := require.New(t)
+
+	mockMgmtApi := testutils.NewFakeManagementApiFacade()
+	mockMgmtApi.On(testutils.EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	mockMgmtApi.On(testutils.ListKeyspaces, "").Return([]string{}, nil)
+	mockMgmtApi.On(testutils.GetSchemaVersions).Return(map[string][]string{"fake": {"test"}}, nil)
+	mockMgmtApi.On(testutils.HasSchemaAgreement).Return(true, nil)
+	mockMgmtApi.On(testutils.UpgradeSSTables, mock.Anything).Return("upgrade-job", nil)
+	mockMgmtApi.On(testutils.GetJobDetails, mock.Anything, "upgrade-job").Return(&httphelper.JobDetails{Id: "upgrade-job", Status: jobStatusCompleted}, nil)
+
+	adapter := func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mockMgmtApi, nil
+	}
+	managementApiFactory.SetAdapter(adapter)
+
+	kc := &api.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "upgrade-test",
+		},
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				ServerVersion: "3.11.11",
+				StorageConfig: &cassdcapi.StorageConfig{
+					CassandraDataVolumeClaimSpec: &corev1.PersistentVolumeClaimSpec{StorageClassName: &defaultStorageClass},
+				},
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, K8sContext: k8sCtx0, Size: 3},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, K8sContext: k8sCtx1, Size: 3},
+				},
+			},
+		},
+	}
+	kcKey := utils.GetKey(kc)
+
+	err := f.Client.Create(ctx, kc)
+	require.NoError(err, "failed to create K8ssandraCluster")
+
+	verifySuperuserSecretCreated(ctx, t, f, kc)
+	verifyReplicatedSecretReconciled(ctx, t, f, kc)
+
+	dc1Key := framework.NewClusterKey(k8sCtx0, namespace, "dc1")
+	dc2Key := framework.NewClusterKey(k8sCtx1, namespace, "dc2")
+
+	t.Log("check that dc1 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc1Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc1Key)
+	require.NoError(err, "failed to set dc1 status ready")
+
+	t.Log("check that dc2 was created")
+	require.Eventually(f.DatacenterExists(ctx, dc2Key), timeout, interval)
+	err = f.SetDatacenterStatusReady(ctx, dc2Key)
+	require.NoError(err, "failed to set dc2 status ready")
+
+	t.Log("wait for the CassandraInitialized condition to be set")
+	require.Eventually(func() bool {
+		err := f.Client.Get(ctx, kcKey, kc)
+		return err == nil && kc.Status.GetConditionStatus(api.CassandraInitialized) == corev1.ConditionTrue
+	}, timeout, interval, "timed out waiting for CassandraInitialized condition check")
+
+	t.Log("upgrade the cluster to 4.0.3")
+	patch := client.MergeFrom(kc.DeepCopy())
+	kc.Spec.Cassandra.ServerVersion = "4.0.3"
+	err = f.Client.Patch(ctx, kc, patch)
+	require.NoError(err, "failed to patch K8ssandraCluster")
+
+	t.Log("check that dc1 is upgraded first")
+	require.Eventually(f.NewWithDatacenter(ctx, dc1Key)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.ServerVersion == "4.0.3"
+	}), timeout, interval, "timed out waiting for dc1 version to be updated")
+	verifyDatacenterUpgradeProgress(ctx, t, f, kcKey, "dc1", api.UpgradeUpgradingNodes)
+	verifyDatacenterUpgradeProgress(ctx, t, f, kcKey, "dc2", api.UpgradePending)
+
+	dc2 := &cassdcapi.CassandraDatacenter{}
+	err = f.Get(ctx, dc2Key, dc2)
+	require.NoError(err, "failed to get dc2")
+	assert.Equal(t, "3.11.11", dc2.Spec.ServerVersion, "dc2 should not be upgraded before dc1 is done")
+
+	setDatacenterUpdated(ctx, t, f, dc1Key)
+	verifySSTablesUpgraded(ctx, t, f, kcKey, dc1Key, mockMgmtApi)
+	verifyDatacenterUpgradeProgress(ctx, t, f, kcKey, "dc1", api.UpgradeCompleted)
+
+	t.Log("check that dc2 is upgraded next")
+	require.Eventually(f.NewWithDatacenter(ctx, dc2Key)(func(dc *cassdcapi.CassandraDatacenter) bool {
+		return dc.Spec.ServerVersion == "4.0.3"
+	}), timeout, interval, "timed out waiting for dc2 version to be updated")
+	verifyDatacenterUpgradeProgress(ctx, t, f, kcKey, "dc2", api.UpgradeUpgradingNodes)
+
+	setDatacenterUpdated(ctx, t, f, dc2Key)
+	verifySSTablesUpgraded(ctx, t, f, kcKey, dc2Key, mockMgmtApi)
+	verifyDatacenterUpgradeProgress(ctx, t, f, kcKey, "dc2", api.UpgradeCompleted)
+
+	err = f.DeleteK8ssandraCluster(ctx, kcKey)
+	require.NoError(err, "failed to delete K8ssandraCluster")
+}
+
+func verifyDatacenterUpgradeProgress(ctx context.Context, t *testing.T, f *framework.Framework, kcKey client.ObjectKey, dcName string, progress api.UpgradeProgress) {
+	require.Eventually(t, func() bool {
+		kc := &api.K8ssandraCluster{}
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			t.Logf("failed to get K8ssandraCluster: %v", err)
+			return false
+		}
+		upgrade := kc.Status.Datacenters[dcName].Upgrade
+		return upgrade != nil && upgrade.Progress == progress
+	}, timeout, interval, "timed out waiting for %s upgrade progress to be %s", dcName, progress)
+}
+
+// setDatacenterUpdated simulates cass-operator finishing the rolling restart of the datacenter.
+func setDatacenterUpdated(ctx context.Context, t *testing.T, f *framework.Framework, key framework.ClusterKey) {
+	// Timestamps are persisted with a one second precision, make sure that the update is seen as happening after the
+	// upgrade started.
+	updated := metav1.NewTime(time.Now().Add(time.Second))
+	err := f.PatchDatacenterStatus(ctx, key, func(dc *cassdcapi.CassandraDatacenter) {
+		dc.SetCondition(cassdcapi.DatacenterCondition{
+			Type:               cassdcapi.DatacenterUpdating,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: updated,
+		})
+	})
+	require.NoError(t, err, "failed to set datacenter updated")
+}
+
+// verifySSTablesUpgraded creates a pod for the datacenter and checks that its SSTables are upgraded through the
+// management API.
+func verifySSTablesUpgraded(ctx context.Context, t *testing.T, f *framework.Framework, kcKey client.ObjectKey, dcKey framework.ClusterKey, mgmtApi *testutils.FakeManagementApiFacade) {
+	podKey := framework.NewClusterKey(dcKey.K8sContext, dcKey.Namespace, dcKey.Name+"-default-sts-0")
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: podKey.Namespace,
+			Name:      podKey.Name,
+			Labels:    map[string]string{cassdcapi.DatacenterLabel: dcKey.Name},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra", Image: "cassandra"}}},
+	}
+	err := f.Create(ctx, podKey, pod)
+	require.NoError(t, err, "failed to create pod")
+
+	require.Eventually(t, func() bool {
+		kc := &api.K8ssandraCluster{}
+		if err := f.Client.Get(ctx, kcKey, kc); err != nil {
+			return false
+		}
+		upgrade := kc.Status.Datacenters[dcKey.Name].Upgrade
+		return upgrade != nil && utils.SliceContains(upgrade.SSTablesUpgradedPods, podKey.Name)
+	}, timeout, interval, "timed out waiting for the SSTables of pod %s to be upgraded", podKey.Name)
+
+	assert.True(t, mgmtApi.GetFirstCall(testutils.UpgradeSSTables, mock.MatchedBy(func(p *corev1.Pod) bool {
+		return p.Name == podKey.Name
+	})) > -1, "expected the SSTables of pod %s to be upgraded", podKey.Name)
+}
+
+func TestUpgradeSSTables(t *testing.T) {
+	ctx := context.Background()
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	dc := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dc1"}}
+	newPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{cassdcapi.DatacenterLabel: "dc1"},
+		}}
+	}
+	remoteClient := fake.NewClientBuilder().WithScheme(s).WithObjects(newPod("pod-0"), newPod("pod-1")).Build()
+
+	// The status of the job of each pod, jobs are named after their pod
+	jobStatuses := map[string]string{}
+	mgmtApi := testutils.NewFakeManagementApiFacade()
+	mgmtApi.On(testutils.UpgradeSSTables, mock.Anything).Return(func(pod *corev1.Pod) string {
+		jobStatuses[pod.Name] = "WAITING"
+		return pod.Name
+	}, nil)
+	mgmtApi.On(testutils.GetJobDetails, mock.Anything, mock.Anything).Return(func(pod *corev1.Pod, jobId string) *httphelper.JobDetails {
+		return &httphelper.JobDetails{Id: jobId, Status: jobStatuses[jobId], Error: "disk full"}
+	}, nil)
+	mgmtApiFactory := &testutils.FakeManagementApiFactory{}
+	mgmtApiFactory.SetT(t)
+	mgmtApiFactory.SetAdapter(func(ctx context.Context, datacenter *cassdcapi.CassandraDatacenter, client client.Client, logger logr.Logger) (cassandra.ManagementApiFacade, error) {
+		return mgmtApi, nil
+	})
+
+	r := &K8ssandraClusterReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		ManagementApi:    mgmtApiFactory,
+		Recorder:         record.NewFakeRecorder(100),
+	}
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{{Meta: api.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+		},
+	}
+	now := metav1.Now()
+	setUpgradeStatus(kc, "dc1", &api.DatacenterUpgradeStatus{
+		FromVersion:       "3.11.11",
+		ToVersion:         "4.0.3",
+		Progress:          api.UpgradeUpgradingSSTables,
+		SSTablesStartTime: &now,
+	})
+	upgradeSSTables := func() *api.DatacenterUpgradeStatus {
+		r.reconcileDcUpgrade(ctx, kc, dc, remoteClient, logr.Discard())
+		return kc.Status.Datacenters["dc1"].Upgrade
+	}
+
+	t.Log("a job is started on each pod")
+	upgrade := upgradeSSTables()
+	assert.Equal(t, api.UpgradeUpgradingSSTables, upgrade.Progress)
+	assert.Equal(t, map[string]string{"pod-0": "pod-0", "pod-1": "pod-1"}, upgrade.SSTablesJobs)
+
+	t.Log("completed jobs are recorded and lost jobs are started again")
+	jobStatuses["pod-0"] = jobStatusCompleted
+	delete(jobStatuses, "pod-1")
+	upgrade = upgradeSSTables()
+	assert.Equal(t, []string{"pod-0"}, upgrade.SSTablesUpgradedPods)
+	assert.Empty(t, upgrade.SSTablesJobs)
+	upgrade = upgradeSSTables()
+	assert.Equal(t, map[string]string{"pod-1": "pod-1"}, upgrade.SSTablesJobs)
+
+	t.Log("the upgrade fails if a job fails")
+	jobStatuses["pod-1"] = jobStatusError
+	upgrade = upgradeSSTables()
+	assert.Equal(t, api.UpgradeFailed, upgrade.Progress)
+	assert.Equal(t, "upgradesstables failed on pod pod-1: disk full", upgrade.Message)
+
+	t.Log("the upgrade fails if the jobs do not complete in time")
+	started := metav1.NewTime(time.Now().Add(-sstablesUpgradeTimeout - time.Minute))
+	upgrade.Progress = api.UpgradeUpgradingSSTables
+	upgrade.Message = ""
+	upgrade.SSTablesStartTime = &started
+	setUpgradeStatus(kc, "dc1", upgrade)
+	jobStatuses["pod-1"] = "WAITING"
+	upgrade = upgradeSSTables()
+	assert.Equal(t, api.UpgradeFailed, upgrade.Progress)
+	assert.Contains(t, upgrade.Message, "did not complete within")
+
+	t.Log("the upgrade completes once all the pods are upgraded")
+	upgrade.Progress = api.UpgradeUpgradingSSTables
+	upgrade.SSTablesStartTime = &now
+	setUpgradeStatus(kc, "dc1", upgrade)
+	upgrade = upgradeSSTables()
+	assert.Equal(t, map[string]string{"pod-1": "pod-1"}, upgrade.SSTablesJobs, "the job of pod-1 is started again")
+	jobStatuses["pod-1"] = jobStatusCompleted
+	upgrade = upgradeSSTables()
+	assert.Equal(t, api.UpgradeCompleted, upgrade.Progress)
+	assert.Equal(t, []string{"pod-0", "pod-1"}, upgrade.SSTablesUpgradedPods)
+}
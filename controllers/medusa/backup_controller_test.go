@@ -7,21 +7,31 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	k8ss "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	api "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	replicationapi "github.com/k8ssandra/k8ssandra-operator/apis/replication/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
 	"github.com/k8ssandra/k8ssandra-operator/test/framework"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const (
@@ -144,6 +154,16 @@ func testBackupDatacenter(t *testing.T, ctx context.Context, f *framework.Framew
 		fmt.Sprintf("%s:%d", getPodIpAddress(2), backupSidecarPort): {defaultBackupName},
 	}, medusaClientFactory.GetRequestedBackups())
 
+	t.Log("delete the backup and verify that it is deleted from the storage backend")
+	backupKey := framework.ClusterKey{K8sContext: k8sCtx0, NamespacedName: types.NamespacedName{Namespace: namespace, Name: defaultBackupName}}
+	backup := &api.CassandraBackup{}
+	err = f.Get(ctx, backupKey, backup)
+	require.NoError(err, "failed to get CassandraBackup")
+	err = f.Client.Delete(ctx, backup)
+	require.NoError(err, "failed to delete CassandraBackup")
+	verifyObjectDoesNotExist(ctx, t, f, backupKey, &api.CassandraBackup{})
+	require.Equal([]string{defaultBackupName}, medusaClientFactory.GetDeletedBackups())
+
 	err = f.DeleteK8ssandraCluster(ctx, client.ObjectKey{Namespace: kc.Namespace, Name: kc.Name})
 	require.NoError(err, "failed to delete K8ssandraCluster")
 	verifyObjectDoesNotExist(ctx, t, f, dc1Key, &cassdcapi.CassandraDatacenter{})
@@ -293,10 +313,13 @@ func NewMedusaClientFactory() *fakeMedusaClientFactory {
 }
 
 func (f *fakeMedusaClientFactory) NewClient(address string) (medusa.Client, error) {
-	medusaClient := newFakeMedusaClient()
 	f.clientsMutex.Lock()
-	f.clients[address] = medusaClient
-	f.clientsMutex.Unlock()
+	defer f.clientsMutex.Unlock()
+	medusaClient, found := f.clients[address]
+	if !found {
+		medusaClient = newFakeMedusaClient()
+		f.clients[address] = medusaClient
+	}
 	return medusaClient, nil
 }
 
@@ -308,8 +331,24 @@ func (f *fakeMedusaClientFactory) GetRequestedBackups() map[string][]string {
 	return requestedBackups
 }
 
+func (f *fakeMedusaClientFactory) GetDeletedBackups() []string {
+	deletedBackups := make([]string, 0)
+	for _, v := range f.clients {
+		deletedBackups = append(deletedBackups, v.DeletedBackups...)
+	}
+	return deletedBackups
+}
+
 type fakeMedusaClient struct {
 	RequestedBackups []string
+	DeletedBackups   []string
+	// ExistingBackups are in the storage bucket without having been requested, e.g. created with the medusa CLI.
+	ExistingBackups []*medusa.BackupSummary
+	// BackupStates overrides the state reported by BackupStatus, which is SUCCESS for the requested backups.
+	BackupStates map[string]medusa.StatusType
+	// Legacy makes the client behave like Medusa before 0.12: AsyncBackup is not implemented, and BackupStatus only
+	// reports a finish time.
+	Legacy bool
 }
 
 func newFakeMedusaClient() *fakeMedusaClient {
@@ -325,12 +364,44 @@ func (c *fakeMedusaClient) CreateBackup(ctx context.Context, name string, backup
 	return nil
 }
 
+func (c *fakeMedusaClient) AsyncBackup(ctx context.Context, name string, backupType string) error {
+	if c.Legacy {
+		return status.Error(codes.Unimplemented, "unknown method AsyncBackup")
+	}
+	return c.CreateBackup(ctx, name, backupType)
+}
+
 func (c *fakeMedusaClient) GetBackups(ctx context.Context) ([]*medusa.BackupSummary, error) {
-	return nil, nil
+	backups := make([]*medusa.BackupSummary, 0)
+	for _, summary := range c.ExistingBackups {
+		if !utils.SliceContains(c.DeletedBackups, summary.BackupName) {
+			backups = append(backups, summary)
+		}
+	}
+	for _, name := range c.RequestedBackups {
+		if !utils.SliceContains(c.DeletedBackups, name) {
+			backups = append(backups, &medusa.BackupSummary{BackupName: name})
+		}
+	}
+	return backups, nil
+}
+
+func (c *fakeMedusaClient) DeleteBackup(ctx context.Context, name string) error {
+	c.DeletedBackups = append(c.DeletedBackups, name)
+	return nil
 }
 
 func (c *fakeMedusaClient) BackupStatus(ctx context.Context, name string) (*medusa.BackupStatusResponse, error) {
-	return nil, nil
+	if state, found := c.BackupStates[name]; found {
+		return &medusa.BackupStatusResponse{Status: state}, nil
+	}
+	if !utils.SliceContains(c.RequestedBackups, name) {
+		return nil, status.Errorf(codes.NotFound, "backup %s not found", name)
+	}
+	if c.Legacy {
+		return &medusa.BackupStatusResponse{FinishTime: "2022-03-10T01:10:00"}, nil
+	}
+	return &medusa.BackupStatusResponse{Status: medusa.StatusType_SUCCESS}, nil
 }
 
 func findDatacenterCondition(status *cassdcapi.CassandraDatacenterStatus, condType cassdcapi.DatacenterConditionType) *cassdcapi.DatacenterCondition {
@@ -383,3 +454,262 @@ func createDatacenterPods(t *testing.T, ctx context.Context, dc *cassdcapi.Cassa
 		}
 	}
 }
+
+func TestCassandraBackupDeletion(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	dc := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dc1"}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-dc1-default-sts-0", Labels: map[string]string{cassdcapi.DatacenterLabel: "dc1"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+		Status:     corev1.PodStatus{PodIP: getPodIpAddress(0)},
+	}
+	newBackup := func(name string, started bool) *api.CassandraBackup {
+		backup := &api.CassandraBackup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Finalizers: []string{cassandraBackupFinalizer}},
+			Spec:       api.CassandraBackupSpec{Name: name, CassandraDatacenter: "dc1"},
+		}
+		if started {
+			backup.Status.StartTime = metav1.Now()
+			backup.Status.FinishTime = metav1.Now()
+		}
+		return backup
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(dc, pod, newBackup("backup1", true), newBackup("backup2", false)).Build()
+
+	clientFactory := NewMedusaClientFactory()
+	medusaClient, _ := clientFactory.NewClient(fmt.Sprintf("%s:%d", getPodIpAddress(0), backupSidecarPort))
+	require.NoError(t, medusaClient.CreateBackup(ctx, "backup1", string(api.FullBackup)))
+
+	r := &CassandraBackupReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+	}
+	deleteBackup := func(name string) {
+		key := types.NamespacedName{Namespace: "default", Name: name}
+		backup := &api.CassandraBackup{}
+		require.NoError(t, c.Get(ctx, key, backup))
+		require.NoError(t, c.Delete(ctx, backup))
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		assert.True(t, errors.IsNotFound(c.Get(ctx, key, backup)), "the finalizer of %s must be removed", name)
+	}
+
+	deleteBackup("backup1")
+	assert.Equal(t, []string{"backup1"}, clientFactory.GetDeletedBackups())
+
+	deleteBackup("backup2")
+	assert.Equal(t, []string{"backup1"}, clientFactory.GetDeletedBackups(), "a backup that did not start has no data to delete")
+}
+
+// TestCassandraBackupDeletionOfClusterBackup verifies that deleting the CassandraBackup of one datacenter of a
+// CassandraClusterBackup leaves the data of the cluster backup in the storage bucket, for the other datacenters.
+func TestCassandraBackupDeletionOfClusterBackup(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	objects := []client.Object{}
+	for i, dcName := range []string{"dc1", "dc2"} {
+		objects = append(objects,
+			&cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: dcName}},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("test-%s-default-sts-0", dcName), Labels: map[string]string{cassdcapi.DatacenterLabel: dcName}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+				Status:     corev1.PodStatus{PodIP: getPodIpAddress(i)},
+			})
+		backup := &api.CassandraBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "default",
+				Name:       "nightly-" + dcName,
+				Finalizers: []string{cassandraBackupFinalizer},
+				Labels:     map[string]string{api.ClusterBackupNameLabel: "nightly", api.ClusterBackupNamespaceLabel: "default"},
+			},
+			Spec: api.CassandraBackupSpec{Name: "nightly", CassandraDatacenter: dcName},
+		}
+		backup.Status.StartTime = metav1.Now()
+		backup.Status.FinishTime = metav1.Now()
+		objects = append(objects, backup)
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+
+	clientFactory := NewMedusaClientFactory()
+	for i := range []string{"dc1", "dc2"} {
+		medusaClient, _ := clientFactory.NewClient(fmt.Sprintf("%s:%d", getPodIpAddress(i), backupSidecarPort))
+		require.NoError(t, medusaClient.CreateBackup(ctx, "nightly", string(api.FullBackup)))
+	}
+
+	r := &CassandraBackupReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+	}
+	key := types.NamespacedName{Namespace: "default", Name: "nightly-dc1"}
+	backup := &api.CassandraBackup{}
+	require.NoError(t, c.Get(ctx, key, backup))
+	require.NoError(t, c.Delete(ctx, backup))
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	assert.True(t, errors.IsNotFound(c.Get(ctx, key, backup)), "the finalizer of nightly-dc1 must be removed")
+	assert.Empty(t, clientFactory.GetDeletedBackups(), "the cluster backup must be left in the storage bucket")
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "default", Name: "nightly-dc2"}, backup))
+	assert.Nil(t, backup.DeletionTimestamp)
+}
+
+func TestCassandraBackupProgress(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	objects := []client.Object{&cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dc1"}}}
+	podNames := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("test-dc1-default-sts-%d", i), Labels: map[string]string{cassdcapi.DatacenterLabel: "dc1"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+			Status:     corev1.PodStatus{PodIP: getPodIpAddress(i)},
+		}
+		objects = append(objects, pod)
+		podNames = append(podNames, pod.Name)
+	}
+	backupKey := types.NamespacedName{Namespace: "default", Name: "backup1"}
+	objects = append(objects, &api.CassandraBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backup1"},
+		Spec: api.CassandraBackupSpec{
+			Name:                "backup1",
+			CassandraDatacenter: "dc1",
+			Timeout:             &metav1.Duration{Duration: time.Hour},
+		},
+	})
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+
+	clientFactory := NewMedusaClientFactory()
+	medusaClients := make([]*fakeMedusaClient, 0)
+	for i := 0; i < 3; i++ {
+		medusaClient, _ := clientFactory.NewClient(fmt.Sprintf("%s:%d", getPodIpAddress(i), backupSidecarPort))
+		medusaClients = append(medusaClients, medusaClient.(*fakeMedusaClient))
+	}
+	// Every reconcile uses a new reconciler, as if the operator restarted in between
+	reconcile := func() *api.CassandraBackup {
+		r := &CassandraBackupReconciler{
+			ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+			Client:           c,
+			Scheme:           s,
+			Recorder:         record.NewFakeRecorder(10),
+			ClientFactory:    clientFactory,
+		}
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: backupKey})
+		require.NoError(t, err)
+		backup := &api.CassandraBackup{}
+		require.NoError(t, c.Get(ctx, backupKey, backup))
+		return backup
+	}
+
+	t.Log("the backup is started on all the pods")
+	medusaClients[1].BackupStates = map[string]medusa.StatusType{"backup1": medusa.StatusType_IN_PROGRESS}
+	backup := reconcile()
+	assert.False(t, backup.Status.StartTime.IsZero())
+	assert.Equal(t, podNames, backup.Status.InProgress)
+	assert.Equal(t, map[string][]string{
+		fmt.Sprintf("%s:%d", getPodIpAddress(0), backupSidecarPort): {"backup1"},
+		fmt.Sprintf("%s:%d", getPodIpAddress(1), backupSidecarPort): {},
+		fmt.Sprintf("%s:%d", getPodIpAddress(2), backupSidecarPort): {"backup1"},
+	}, clientFactory.GetRequestedBackups(), "the backup must not be started again where it is running")
+
+	t.Log("the progress is polled from Medusa")
+	medusaClients[2].BackupStates = map[string]medusa.StatusType{"backup1": medusa.StatusType_FAILED}
+	backup = reconcile()
+	assert.Equal(t, []string{podNames[1]}, backup.Status.InProgress)
+	assert.Equal(t, []string{podNames[0]}, backup.Status.Finished)
+	assert.Equal(t, []string{podNames[2]}, backup.Status.Failed)
+	assert.True(t, backup.Status.FinishTime.IsZero())
+
+	backup = reconcile()
+	assert.Equal(t, []string{podNames[1]}, backup.Status.InProgress)
+
+	t.Log("the pods that do not finish in time fail")
+	patch := client.MergeFrom(backup.DeepCopy())
+	backup.Status.StartTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	require.NoError(t, c.Status().Patch(ctx, backup, patch))
+
+	backup = reconcile()
+	assert.Empty(t, backup.Status.InProgress)
+	assert.Equal(t, []string{podNames[2], podNames[1]}, backup.Status.Failed)
+	assert.False(t, backup.Status.FinishTime.IsZero())
+	assert.Equal(t, corev1.ConditionTrue, backup.Status.GetConditionStatus(api.BackupFailed))
+}
+
+func TestCassandraBackupLegacyMedusa(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-dc1-default-sts-0", Labels: map[string]string{cassdcapi.DatacenterLabel: "dc1"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+		Status:     corev1.PodStatus{PodIP: getPodIpAddress(0)},
+	}
+	backupKey := types.NamespacedName{Namespace: "default", Name: "legacy-backup"}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dc1"}},
+		pod,
+		&api.CassandraBackup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "legacy-backup"},
+			Spec:       api.CassandraBackupSpec{Name: "legacy-backup", CassandraDatacenter: "dc1"},
+		},
+	).Build()
+
+	addr := fmt.Sprintf("%s:%d", getPodIpAddress(0), backupSidecarPort)
+	clientFactory := NewMedusaClientFactory()
+	medusaClient, _ := clientFactory.NewClient(addr)
+	medusaClient.(*fakeMedusaClient).Legacy = true
+	r := &CassandraBackupReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+	}
+	reconcile := func() *api.CassandraBackup {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: backupKey})
+		require.NoError(t, err)
+		backup := &api.CassandraBackup{}
+		require.NoError(t, c.Get(ctx, backupKey, backup))
+		return backup
+	}
+
+	t.Log("the backup runs with the synchronous Backup RPC")
+	backup := reconcile()
+	assert.Equal(t, []string{pod.Name}, backup.Status.InProgress)
+	require.Eventually(t, func() bool {
+		_, running := syncBackups.Load(syncBackupKey(addr, "legacy-backup"))
+		return !running
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"legacy-backup"}, clientFactory.GetRequestedBackups()[addr])
+
+	t.Log("the finish time reported by BackupStatus finishes the backup")
+	backup = reconcile()
+	assert.Empty(t, backup.Status.InProgress)
+	assert.Equal(t, []string{pod.Name}, backup.Status.Finished)
+	assert.False(t, backup.Status.FinishTime.IsZero())
+}
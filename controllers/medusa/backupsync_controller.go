@@ -0,0 +1,289 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package medusa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const DefaultBackupSyncInterval = 5 * time.Minute
+
+// Reasons of the events recorded by the backup synchronization controller.
+const (
+	eventReasonBackupSynced  = "BackupSynced"
+	eventReasonBackupRemoved = "BackupRemoved"
+)
+
+// BackupSyncReconciler lists the backups in the storage bucket of Medusa through the sidecar of a CassandraDatacenter,
+// and creates a read-only CassandraBackup for each of those that the operator does not know about, e.g. because they
+// were taken by another cluster or with the medusa CLI. These CassandraBackups can then be restored.
+type BackupSyncReconciler struct {
+	*config.ReconcilerConfig
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	medusa.ClientFactory
+
+	// SyncInterval is the time between two synchronizations of the backups of a datacenter. The default is
+	// DefaultBackupSyncInterval.
+	SyncInterval time.Duration
+}
+
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cassandra.datastax.com,namespace="k8ssandra",resources=cassandradatacenters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace="k8ssandra",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+func (r *BackupSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("CassandraDatacenter", req.NamespacedName)
+
+	cassdc := &cassdcapi.CassandraDatacenter{}
+	if err := r.Get(ctx, req.NamespacedName, cassdc); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get CassandraDatacenter")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	pod, err := r.medusaPod(ctx, cassdc)
+	if err != nil {
+		logger.Error(err, "Failed to list the pods of the CassandraDatacenter")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	if pod == nil {
+		// Either Medusa is not deployed, or the pods are not running yet
+		logger.V(1).Info("No Medusa sidecar to get the backups from")
+		return ctrl.Result{RequeueAfter: r.syncInterval()}, nil
+	}
+
+	summaries, err := r.getBackups(ctx, pod)
+	if err != nil {
+		logger.Error(err, "Failed to get the backups from Medusa", "CassandraPod", pod.Name)
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	if err := r.syncBackups(ctx, cassdc, summaries, logger); err != nil {
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	return ctrl.Result{RequeueAfter: r.syncInterval()}, nil
+}
+
+func (r *BackupSyncReconciler) syncInterval() time.Duration {
+	if r.SyncInterval > 0 {
+		return r.SyncInterval
+	}
+	return DefaultBackupSyncInterval
+}
+
+// medusaPod returns a running pod of cassdc with a Medusa sidecar, or nil if there is none.
+func (r *BackupSyncReconciler) medusaPod(ctx context.Context, cassdc *cassdcapi.CassandraDatacenter) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	labels := client.MatchingLabels{cassdcapi.DatacenterLabel: cassdc.Name}
+	if err := r.List(ctx, pods, client.InNamespace(cassdc.Namespace), labels); err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if hasMedusaSidecar(&pod) && pod.Status.PodIP != "" {
+			return &pod, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *BackupSyncReconciler) getBackups(ctx context.Context, pod *corev1.Pod) ([]*medusa.BackupSummary, error) {
+	addr := fmt.Sprintf("%s:%d", pod.Status.PodIP, backupSidecarPort)
+	medusaClient, err := r.NewClient(addr)
+	if err != nil {
+		return nil, err
+	}
+	defer medusaClient.Close()
+	return medusaClient.GetBackups(ctx)
+}
+
+// syncBackups makes the synchronized CassandraBackups of cassdc match summaries: the backups that have no
+// CassandraBackup yet are created, with the datacenter name appended if another datacenter took the name already, the
+// summaries of the existing ones are updated, and those that are no longer in the bucket are deleted. CassandraBackups
+// created by the operator are left alone.
+func (r *BackupSyncReconciler) syncBackups(
+	ctx context.Context,
+	cassdc *cassdcapi.CassandraDatacenter,
+	summaries []*medusa.BackupSummary,
+	logger logr.Logger) error {
+
+	backups := &medusaapi.CassandraBackupList{}
+	if err := r.List(ctx, backups, client.InNamespace(cassdc.Namespace)); err != nil {
+		logger.Error(err, "Failed to list CassandraBackups")
+		return err
+	}
+	// Other datacenters can share the namespace, and the same backup name, e.g. with a CassandraClusterBackup, so only
+	// the backups of cassdc are matched against the bucket. All the object names are taken though.
+	byBackupName := make(map[string]*medusaapi.CassandraBackup)
+	byObjectName := make(map[string]*medusaapi.CassandraBackup)
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		if backup.Spec.CassandraDatacenter == cassdc.Name {
+			byBackupName[backup.Spec.Name] = backup
+		}
+		byObjectName[backup.Name] = backup
+	}
+
+	inBucket := make(map[string]bool)
+	for _, summary := range summaries {
+		inBucket[summary.BackupName] = true
+
+		backup, found := byBackupName[summary.BackupName]
+		if !found {
+			name := backupObjectName(summary.BackupName)
+			if existing, found := byObjectName[name]; found && existing.Spec.CassandraDatacenter != cassdc.Name {
+				name = backupObjectName(fmt.Sprintf("%s-%s", summary.BackupName, cassdc.Name))
+			}
+			if name == "" {
+				logger.Info("Cannot derive a CassandraBackup name from the backup name, skipping it", "Backup", summary.BackupName)
+				continue
+			}
+			if existing, found := byObjectName[name]; found {
+				logger.Info("The CassandraBackup name of the backup is taken by another backup, skipping it",
+					"Backup", summary.BackupName, "CassandraBackup", name, "OtherBackup", existing.Spec.Name)
+				continue
+			}
+			backup = &medusaapi.CassandraBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: cassdc.Namespace,
+					Name:      name,
+					Labels:    map[string]string{medusaapi.BackupSyncedLabel: "true"},
+				},
+				Spec: medusaapi.CassandraBackupSpec{
+					Name:                summary.BackupName,
+					CassandraDatacenter: cassdc.Name,
+				},
+			}
+			logger.Info("Creating CassandraBackup for backup found in the storage bucket", "Backup", summary.BackupName, "CassandraBackup", name)
+			if err := r.Create(ctx, backup); err != nil {
+				logger.Error(err, "Failed to create CassandraBackup", "CassandraBackup", name)
+				return err
+			}
+			byObjectName[name] = backup
+			r.Recorder.Eventf(cassdc, corev1.EventTypeNormal, eventReasonBackupSynced,
+				"Created CassandraBackup %s for backup %s found in the storage bucket", name, summary.BackupName)
+		} else if !backup.IsSynced() {
+			continue
+		}
+
+		original := backup.DeepCopy()
+		setBackupSummary(backup, summary)
+		if equality.Semantic.DeepEqual(backup.Status, original.Status) {
+			continue
+		}
+		patch := client.MergeFrom(original)
+		if err := r.Status().Patch(ctx, backup, patch); err != nil {
+			logger.Error(err, "Failed to patch CassandraBackup status", "CassandraBackup", backup.Name)
+			return err
+		}
+	}
+
+	for _, backup := range byBackupName {
+		if !backup.IsSynced() || inBucket[backup.Spec.Name] || backup.DeletionTimestamp != nil {
+			continue
+		}
+		logger.Info("Deleting CassandraBackup of backup no longer in the storage bucket", "Backup", backup.Spec.Name, "CassandraBackup", backup.Name)
+		if err := r.Delete(ctx, backup); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete CassandraBackup", "CassandraBackup", backup.Name)
+			return err
+		}
+		r.Recorder.Eventf(cassdc, corev1.EventTypeNormal, eventReasonBackupRemoved,
+			"Deleted CassandraBackup %s, backup %s is no longer in the storage bucket", backup.Name, backup.Spec.Name)
+	}
+	return nil
+}
+
+// setBackupSummary sets the status of the synchronized backup from summary. Medusa reports Unix times in seconds, and
+// a zero finish time for a backup that has not finished on all the nodes.
+func setBackupSummary(backup *medusaapi.CassandraBackup, summary *medusa.BackupSummary) {
+	backup.Status.StartTime = metav1.Time{}
+	if summary.StartTime > 0 {
+		backup.Status.StartTime = metav1.NewTime(time.Unix(summary.StartTime, 0))
+	}
+	backup.Status.FinishTime = metav1.Time{}
+	if summary.FinishTime > 0 {
+		backup.Status.FinishTime = metav1.NewTime(time.Unix(summary.FinishTime, 0))
+	}
+
+	status := medusaapi.BackupIncomplete
+	if summary.TotalNodes > 0 && summary.FinishedNodes >= summary.TotalNodes {
+		status = medusaapi.BackupComplete
+	}
+	nodes := make([]medusaapi.BackupNode, 0, len(summary.Nodes))
+	for _, node := range summary.Nodes {
+		nodes = append(nodes, medusaapi.BackupNode{Host: node.Host, Datacenter: node.Datacenter, Rack: node.Rack})
+	}
+	backup.Status.Summary = &medusaapi.BackupSummary{
+		TotalNodes:    summary.TotalNodes,
+		FinishedNodes: summary.FinishedNodes,
+		Status:        status,
+		Nodes:         nodes,
+	}
+}
+
+// backupObjectName turns the Medusa backup name into a valid object name: lowercase, with the characters that are not
+// allowed replaced by dashes. It returns an empty string if there is no such name.
+func backupObjectName(backupName string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '.' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(backupName))
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		name = name[:validation.DNS1123SubdomainMaxLength]
+	}
+	name = strings.Trim(name, "-.")
+	if len(validation.IsDNS1123Subdomain(name)) > 0 {
+		return ""
+	}
+	return name
+}
+
+func (r *BackupSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The datacenters are requeued periodically, changes to their status do not need to trigger a synchronization.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("medusabackupsync").
+		For(&cassdcapi.CassandraDatacenter{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,209 @@
+package medusa
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBackupObjectName(t *testing.T) {
+	assert.Equal(t, "backup-2022-03-10", backupObjectName("backup-2022-03-10"))
+	assert.Equal(t, "nightly-2022-03-10-01-00", backupObjectName("Nightly_2022-03-10 01:00"))
+	assert.Equal(t, "backup", backupObjectName("_backup_"))
+	assert.Equal(t, "", backupObjectName("__"))
+}
+
+func TestBackupSync(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	dc := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dc1"}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-dc1-default-sts-0", Labels: map[string]string{cassdcapi.DatacenterLabel: "dc1"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+		Status:     corev1.PodStatus{PodIP: getPodIpAddress(0)},
+	}
+	// Created by the operator, its backup is in the bucket already
+	operatorBackup := &api.CassandraBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backup1"},
+		Spec:       api.CassandraBackupSpec{Name: "backup1", CassandraDatacenter: "dc1"},
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(dc, pod, operatorBackup).Build()
+
+	clientFactory := NewMedusaClientFactory()
+	medusaClient, _ := clientFactory.NewClient(fmt.Sprintf("%s:%d", getPodIpAddress(0), backupSidecarPort))
+	fakeClient := medusaClient.(*fakeMedusaClient)
+	fakeClient.RequestedBackups = []string{"backup1"}
+	startTime := time.Date(2022, 3, 10, 1, 0, 0, 0, time.UTC)
+	fakeClient.ExistingBackups = []*medusa.BackupSummary{
+		{
+			BackupName:    "CLI_backup",
+			StartTime:     startTime.Unix(),
+			FinishTime:    startTime.Add(10 * time.Minute).Unix(),
+			TotalNodes:    1,
+			FinishedNodes: 1,
+			Nodes:         []*medusa.BackupNode{{Host: "10.0.0.1", Datacenter: "dc1", Rack: "r1", Tokens: []int64{42}}},
+		},
+		{BackupName: "running", StartTime: startTime.Unix(), TotalNodes: 3, FinishedNodes: 1},
+	}
+
+	r := &BackupSyncReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+		SyncInterval:     time.Minute,
+	}
+	reconcile := func() {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "dc1"}})
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, result.RequeueAfter)
+	}
+	listBackups := func() map[string]api.CassandraBackup {
+		backups := &api.CassandraBackupList{}
+		require.NoError(t, c.List(ctx, backups))
+		byName := make(map[string]api.CassandraBackup)
+		for _, backup := range backups.Items {
+			byName[backup.Name] = backup
+		}
+		return byName
+	}
+
+	t.Log("the backups unknown to the operator are synchronized")
+	reconcile()
+	backups := listBackups()
+	require.Len(t, backups, 3)
+	backup := backups["backup1"]
+	assert.False(t, backup.IsSynced())
+	assert.Nil(t, backup.Status.Summary)
+
+	backup = backups["cli-backup"]
+	assert.True(t, backup.IsSynced())
+	assert.Equal(t, "CLI_backup", backup.Spec.Name)
+	assert.Equal(t, "dc1", backup.Spec.CassandraDatacenter)
+	assert.True(t, startTime.Equal(backup.Status.StartTime.Time))
+	assert.True(t, startTime.Add(10*time.Minute).Equal(backup.Status.FinishTime.Time))
+	assert.Equal(t, &api.BackupSummary{
+		TotalNodes:    1,
+		FinishedNodes: 1,
+		Status:        api.BackupComplete,
+		Nodes:         []api.BackupNode{{Host: "10.0.0.1", Datacenter: "dc1", Rack: "r1"}},
+	}, backup.Status.Summary)
+
+	backup = backups["running"]
+	assert.True(t, backup.Status.FinishTime.IsZero())
+	assert.Equal(t, api.BackupIncomplete, backup.Status.Summary.Status)
+
+	t.Log("the summary of synchronized backups is updated")
+	fakeClient.ExistingBackups[1].FinishedNodes = 3
+	fakeClient.ExistingBackups[1].FinishTime = startTime.Add(time.Hour).Unix()
+	reconcile()
+	backup = listBackups()["running"]
+	assert.Equal(t, api.BackupComplete, backup.Status.Summary.Status)
+	assert.True(t, startTime.Add(time.Hour).Equal(backup.Status.FinishTime.Time))
+
+	t.Log("synchronized backups that left the bucket are deleted, the others are kept")
+	fakeClient.DeletedBackups = []string{"CLI_backup", "backup1"}
+	reconcile()
+	backups = listBackups()
+	assert.Len(t, backups, 2)
+	assert.Contains(t, backups, "backup1")
+	assert.Contains(t, backups, "running")
+	assert.Equal(t, []string{"CLI_backup", "backup1"}, fakeClient.DeletedBackups, "the sync must not delete backups from the bucket")
+
+	t.Log("synchronized backups are ignored by the CassandraBackup controller")
+	backupReconciler := &CassandraBackupReconciler{
+		ReconcilerConfig: r.ReconcilerConfig,
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+	}
+	_, err := backupReconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "running"}})
+	require.NoError(t, err)
+	backup = listBackups()["running"]
+	assert.Empty(t, backup.Finalizers)
+	assert.Equal(t, []string{"backup1"}, fakeClient.RequestedBackups, "no backup must be started")
+}
+
+func TestBackupSyncSharedNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	objects := []client.Object{}
+	clientFactory := NewMedusaClientFactory()
+	medusaClients := make(map[string]*fakeMedusaClient)
+	for i, dcName := range []string{"dc1", "dc2"} {
+		objects = append(objects,
+			&cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: dcName}},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: dcName + "-default-sts-0", Labels: map[string]string{cassdcapi.DatacenterLabel: dcName}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+				Status:     corev1.PodStatus{PodIP: getPodIpAddress(i)},
+			})
+		medusaClient, _ := clientFactory.NewClient(fmt.Sprintf("%s:%d", getPodIpAddress(i), backupSidecarPort))
+		medusaClients[dcName] = medusaClient.(*fakeMedusaClient)
+		medusaClients[dcName].ExistingBackups = []*medusa.BackupSummary{{BackupName: "shared", TotalNodes: 1, FinishedNodes: 1}}
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()
+
+	r := &BackupSyncReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+		SyncInterval:     time.Minute,
+	}
+	reconcile := func(dcName string) {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: dcName}})
+		require.NoError(t, err)
+	}
+	listBackups := func() map[string]string {
+		backups := &api.CassandraBackupList{}
+		require.NoError(t, c.List(ctx, backups))
+		datacenters := make(map[string]string)
+		for _, backup := range backups.Items {
+			datacenters[backup.Name] = backup.Spec.CassandraDatacenter
+		}
+		return datacenters
+	}
+
+	t.Log("each datacenter gets its own CassandraBackup of a backup name they share")
+	reconcile("dc1")
+	reconcile("dc2")
+	reconcile("dc1")
+	assert.Equal(t, map[string]string{"shared": "dc1", "shared-dc2": "dc2"}, listBackups())
+
+	t.Log("a backup that left the bucket of a datacenter is only deleted for that datacenter")
+	medusaClients["dc2"].ExistingBackups = nil
+	reconcile("dc2")
+	reconcile("dc1")
+	assert.Equal(t, map[string]string{"shared": "dc1"}, listBackups())
+}
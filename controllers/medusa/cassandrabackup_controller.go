@@ -21,42 +21,66 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	operrors "github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 )
 
 const (
 	backupSidecarPort = 50051
 	backupSidecarName = "medusa"
+
+	cassandraBackupFinalizer = "cassandrabackup.medusa.k8ssandra.io/finalizer"
+
+	defaultBackupTimeout = 24 * time.Hour
+)
+
+// Reasons of the events recorded by the CassandraBackup controller.
+const (
+	eventReasonMedusaNotDeployed = "MedusaNotDeployed"
+	eventReasonBackupStarted     = "BackupStarted"
+	eventReasonPodBackupFailed   = "PodBackupFailed"
+	eventReasonBackupFinished    = "BackupFinished"
+	eventReasonBackupFailed      = "BackupFailed"
+	eventReasonBackupDeleted     = "BackupDeleted"
+	eventReasonBackupNotDeleted  = "BackupNotDeleted"
 )
 
 // CassandraBackupReconciler reconciles a CassandraBackup object
 type CassandraBackupReconciler struct {
 	*config.ReconcilerConfig
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 	medusa.ClientFactory
 }
 
 // +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups/finalizers,verbs=update
 // +kubebuilder:rbac:groups=medusa.datastax.com,namespace="k8ssandra",resources=cassandradatacenters,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",namespace="k8ssandra",resources=pods;services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
 
 func (r *CassandraBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx).WithValues("cassandrabackup", req.NamespacedName)
@@ -75,43 +99,32 @@ func (r *CassandraBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	backup := instance.DeepCopy()
 
-	// If there is anything in progress, simply requeue the request
-	if len(backup.Status.InProgress) > 0 {
-		logger.Info("CassandraBackup is being processed already", "Backup", req.NamespacedName)
-		return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
+	// Synchronized backups exist in the bucket already. They get no finalizer, so that deleting them leaves the
+	// remote backup alone.
+	if backup.IsSynced() {
+		logger.Info("The backup was synchronized from the storage bucket, there is nothing to do")
+		return ctrl.Result{}, nil
 	}
 
-	// If the backup is already finished, there is nothing to do.
-	if backupFinished(backup) {
-		logger.Info("Backup operation is already finished")
-		return ctrl.Result{Requeue: false}, nil
+	if backup.DeletionTimestamp != nil {
+		return r.checkDeletion(ctx, backup, logger)
 	}
 
-	// First check to see if the backup is already in progress
-	if !backup.Status.StartTime.IsZero() {
-		// If there is anything in progress, simply requeue the request
-		if len(backup.Status.InProgress) > 0 {
-			logger.Info("Backups already in progress")
-			return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
-		}
-
-		logger.Info("backup complete")
-
-		// Set the finish time
-		// Note that the time here is not accurate, but that is ok. For now we are just
-		// using it as a completion marker.
+	if !controllerutil.ContainsFinalizer(backup, cassandraBackupFinalizer) {
 		patch := client.MergeFrom(backup.DeepCopy())
-		backup.Status.FinishTime = metav1.Now()
-		if err := r.Status().Patch(ctx, backup, patch); err != nil {
-			logger.Error(err, "failed to patch status with finish time")
+		controllerutil.AddFinalizer(backup, cassandraBackupFinalizer)
+		if err := r.Patch(ctx, backup, patch); err != nil {
+			logger.Error(err, "Failed to add finalizer")
 			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
 		}
+	}
 
+	// If the backup is already finished, there is nothing to do.
+	if backupFinished(backup) {
+		logger.Info("Backup operation is already finished")
 		return ctrl.Result{Requeue: false}, nil
 	}
 
-	logger.Info("Backups have not been started yet")
-
 	cassdcKey := types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.CassandraDatacenter}
 	cassdc := &cassdcapi.CassandraDatacenter{}
 	err = r.Get(ctx, cassdcKey, cassdc)
@@ -126,10 +139,19 @@ func (r *CassandraBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
 	}
 
+	// The backup runs in the Medusa sidecars, its progress is polled until all the pods are done. The status is
+	// rebuilt from what Medusa reports, so that a backup survives restarts of the operator.
+	if !backup.Status.StartTime.IsZero() {
+		return r.trackBackupProgress(ctx, backup, pods, logger)
+	}
+
+	logger.Info("Backups have not been started yet")
+
 	// Make sure that Medusa is deployed
 	if !isMedusaDeployed(pods) {
-		// TODO generate event and/or update status to indicate error condition
+		// TODO update status to indicate error condition
 		logger.Error(operrors.BackupSidecarNotFound, "medusa is not deployed", "CassandraDatacenter", cassdcKey)
+		r.Recorder.Eventf(backup, corev1.EventTypeWarning, eventReasonMedusaNotDeployed, "Medusa is not deployed in CassandraDatacenter %s", cassdc.Name)
 		return ctrl.Result{RequeueAfter: r.LongDelay}, operrors.BackupSidecarNotFound
 	}
 
@@ -151,47 +173,165 @@ func (r *CassandraBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	logger.Info("Starting backups")
-	// Do the actual backup in the background
-	go func() {
-		wg := sync.WaitGroup{}
+	r.Recorder.Eventf(backup, corev1.EventTypeNormal, eventReasonBackupStarted, "Starting backup %s on %d pods", backup.Spec.Name, len(pods))
+	return r.trackBackupProgress(ctx, backup, pods, logger)
+}
 
-		// Mutex to prevent concurrent updates to the backup.Status object
-		backupMutex := sync.Mutex{}
-		patch := client.MergeFrom(backup.DeepCopy())
+// trackBackupProgress polls the status of the backup in the Medusa sidecar of each pod that is still in progress, and
+// starts the backup on the pods where Medusa does not know about it, e.g. because the sidecar restarted. The pods that
+// have not finished before the timeout of the backup are marked as failed. The backup is finished once no pod is in
+// progress.
+func (r *CassandraBackupReconciler) trackBackupProgress(
+	ctx context.Context,
+	backup *medusaapi.CassandraBackup,
+	pods []corev1.Pod,
+	logger logr.Logger) (ctrl.Result, error) {
+
+	patch := client.MergeFrom(backup.DeepCopy())
+	deadline := backup.Status.StartTime.Add(backupTimeout(backup))
+	timedOut := time.Now().After(deadline)
+
+	for _, podName := range append([]string{}, backup.Status.InProgress...) {
+		pod := findPod(pods, podName)
+		state := medusa.StatusType_UNKNOWN
+		if pod != nil && pod.Status.PodIP != "" {
+			var err error
+			if state, err = getBackupState(ctx, backup, pod, r.ClientFactory); err != nil {
+				// The status is polled again on the next reconcile, the backup must not be started twice
+				logger.Error(err, "Failed to get the backup status", "CassandraPod", podName)
+				state = medusa.StatusType_IN_PROGRESS
+			}
+		}
 
-		for _, p := range pods {
-			pod := p
-			wg.Add(1)
-			go func() {
-				logger.Info("starting backup", "CassandraPod", pod.Name)
-				succeeded := false
-				if err := doBackup(ctx, backup.Spec.Name, backup.Spec.Type, &pod, r.ClientFactory); err == nil {
-					logger.Info("finished backup", "CassandraPod", pod.Name)
-					succeeded = true
-				} else {
-					logger.Error(err, "backup failed", "CassandraPod", pod.Name)
-				}
-				backupMutex.Lock()
-				defer backupMutex.Unlock()
-				defer wg.Done()
-				backup.Status.InProgress = utils.RemoveValue(backup.Status.InProgress, pod.Name)
-				if succeeded {
-					backup.Status.Finished = append(backup.Status.Finished, pod.Name)
-				} else {
-					backup.Status.Failed = append(backup.Status.Failed, pod.Name)
-				}
-			}()
+		switch {
+		case state == medusa.StatusType_SUCCESS:
+			logger.Info("finished backup", "CassandraPod", podName)
+			backup.Status.InProgress = utils.RemoveValue(backup.Status.InProgress, podName)
+			backup.Status.Finished = append(backup.Status.Finished, podName)
+		case state == medusa.StatusType_FAILED || timedOut:
+			err := fmt.Errorf("the backup failed in the Medusa sidecar")
+			if state != medusa.StatusType_FAILED {
+				err = fmt.Errorf("the backup did not finish before %s", deadline.Format(time.RFC3339))
+			}
+			logger.Error(err, "backup failed", "CassandraPod", podName)
+			r.Recorder.Eventf(backup, corev1.EventTypeWarning, eventReasonPodBackupFailed, "Backup failed on pod %s: %v", podName, err)
+			backup.Status.InProgress = utils.RemoveValue(backup.Status.InProgress, podName)
+			backup.Status.Failed = append(backup.Status.Failed, podName)
+		case state == medusa.StatusType_UNKNOWN && pod != nil && pod.Status.PodIP != "":
+			logger.Info("starting backup", "CassandraPod", podName)
+			if err := doBackup(ctx, backup.Spec.Name, backup.Spec.Type, pod, r.ClientFactory); err != nil {
+				logger.Error(err, "Failed to start the backup", "CassandraPod", podName)
+			}
 		}
-		wg.Wait()
+	}
+
+	if len(backup.Status.InProgress) == 0 {
 		logger.Info("finished backup operations")
-		if err := r.Status().Patch(context.Background(), backup, patch); err != nil {
-			logger.Error(err, "failed to patch status", "Backup", fmt.Sprintf("%s/%s", backup.Name, backup.Namespace))
+		backup.Status.FinishTime = metav1.Now()
+		if len(backup.Status.Failed) > 0 {
+			message := fmt.Sprintf("Backup %s failed on %d of %d pods", backup.Spec.Name, len(backup.Status.Failed), len(backup.Status.Failed)+len(backup.Status.Finished))
+			backup.Status.SetFailed(true, message)
+			r.Recorder.Event(backup, corev1.EventTypeWarning, eventReasonBackupFailed, message)
+		} else {
+			message := fmt.Sprintf("Backup %s finished on %d pods", backup.Spec.Name, len(backup.Status.Finished))
+			backup.Status.SetFailed(false, message)
+			r.Recorder.Event(backup, corev1.EventTypeNormal, eventReasonBackupFinished, message)
 		}
-	}()
+	}
 
+	if err := r.Status().Patch(ctx, backup, patch); err != nil {
+		logger.Error(err, "failed to patch status", "Backup", fmt.Sprintf("%s/%s", backup.Name, backup.Namespace))
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	if backupFinished(backup) {
+		recordBackupMetrics(backup)
+		return ctrl.Result{}, nil
+	}
 	return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
 }
 
+// checkDeletion deletes the data of the backup from the storage backend before removing the finalizer. There is
+// nothing to delete if the backup never started, and nothing that can delete it once the datacenter is gone. The data
+// of the backup of a CassandraClusterBackup is shared with the other datacenters, so it is left to the finalizer of
+// the cluster backup.
+func (r *CassandraBackupReconciler) checkDeletion(ctx context.Context, backup *medusaapi.CassandraBackup, logger logr.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(backup, cassandraBackupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if len(backup.Status.InProgress) > 0 {
+		cassdcKey := types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.CassandraDatacenter}
+		cassdc := &cassdcapi.CassandraDatacenter{}
+		if err := r.Get(ctx, cassdcKey, cassdc); err == nil {
+			logger.Info("Waiting for the backup to finish before deleting it")
+			pods, err := r.getCassandraDatacenterPods(ctx, cassdc, logger)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+			}
+			if result, err := r.trackBackupProgress(ctx, backup, pods, logger); err != nil || !backupFinished(backup) {
+				return result, err
+			}
+		} else if !errors.IsNotFound(err) {
+			logger.Error(err, "failed to get cassandradatacenter", "CassandraDatacenter", cassdcKey)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+	}
+
+	if !backup.Status.StartTime.IsZero() && backup.Spec.Name != "" && !backup.IsClusterBackup() {
+		if err := r.deleteRemoteBackup(ctx, backup, logger); err != nil {
+			logger.Error(err, "Failed to delete the backup from the storage backend")
+			r.Recorder.Eventf(backup, corev1.EventTypeWarning, eventReasonBackupNotDeleted, "Failed to delete backup %s from the storage backend: %v", backup.Spec.Name, err)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+	}
+
+	patch := client.MergeFrom(backup.DeepCopy())
+	controllerutil.RemoveFinalizer(backup, cassandraBackupFinalizer)
+	if err := r.Patch(ctx, backup, patch); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// deleteRemoteBackup deletes the backup from the storage backend through the Medusa sidecar of a pod of the
+// datacenter. Medusa deletes the backup of all the nodes at once.
+func (r *CassandraBackupReconciler) deleteRemoteBackup(ctx context.Context, backup *medusaapi.CassandraBackup, logger logr.Logger) error {
+	cassdcKey := types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.CassandraDatacenter}
+	cassdc := &cassdcapi.CassandraDatacenter{}
+	if err := r.Get(ctx, cassdcKey, cassdc); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("CassandraDatacenter not found, the backup is left in the storage backend", "CassandraDatacenter", cassdcKey)
+			r.Recorder.Eventf(backup, corev1.EventTypeWarning, eventReasonBackupNotDeleted,
+				"CassandraDatacenter %s not found, backup %s is left in the storage backend", cassdcKey.Name, backup.Spec.Name)
+			return nil
+		}
+		return err
+	}
+
+	pods, err := r.getCassandraDatacenterPods(ctx, cassdc, logger)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		if !hasMedusaSidecar(&pod) || pod.Status.PodIP == "" {
+			continue
+		}
+		deleted, err := doDeleteBackup(ctx, backup.Spec.Name, &pod, r.ClientFactory)
+		if err != nil {
+			return err
+		}
+		if deleted {
+			logger.Info("Deleted the backup from the storage backend", "CassandraPod", pod.Name)
+			r.Recorder.Eventf(backup, corev1.EventTypeNormal, eventReasonBackupDeleted, "Deleted backup %s from the storage backend", backup.Spec.Name)
+		} else {
+			logger.Info("The backup is not in the storage backend", "CassandraPod", pod.Name)
+		}
+		return nil
+	}
+	return operrors.BackupSidecarNotFound
+}
+
 func (r *CassandraBackupReconciler) addCassdcSpecToStatus(ctx context.Context, backup *medusaapi.CassandraBackup, cassdc *cassdcapi.CassandraDatacenter) error {
 	templateSpec := medusaapi.CassandraDatacenterTemplateSpec{
 		// TODO The following properties need to be configurable for accessing and managing the cluster:
@@ -276,14 +416,120 @@ func hasMedusaSidecar(pod *corev1.Pod) bool {
 	return false
 }
 
+// syncBackups tracks the backups started with the synchronous Backup RPC of the Medusa versions that do not have
+// AsyncBackup, keyed by sidecar address and backup name. A backup is IN_PROGRESS until the call returns, and FAILED
+// if it returned an error.
+var syncBackups sync.Map
+
+func syncBackupKey(addr, name string) string {
+	return addr + "/" + name
+}
+
+// doBackup starts the backup in the Medusa sidecar of pod, without waiting for it to finish. Medusa versions before
+// 0.12 do not implement AsyncBackup, their synchronous Backup RPC is called in the background instead.
 func doBackup(ctx context.Context, name string, backupType medusaapi.BackupType, pod *corev1.Pod, clientFactory medusa.ClientFactory) error {
 	addr := fmt.Sprintf("%s:%d", pod.Status.PodIP, backupSidecarPort)
-	if medusaClient, err := clientFactory.NewClient(addr); err != nil {
+	key := syncBackupKey(addr, name)
+	if _, found := syncBackups.Load(key); found {
+		return nil
+	}
+	medusaClient, err := clientFactory.NewClient(addr)
+	if err != nil {
 		return err
-	} else {
+	}
+	if err = medusaClient.AsyncBackup(ctx, name, string(backupType)); status.Code(err) != codes.Unimplemented {
+		medusaClient.Close()
+		return err
+	}
+
+	syncBackups.Store(key, medusa.StatusType_IN_PROGRESS)
+	go func() {
 		defer medusaClient.Close()
-		return medusaClient.CreateBackup(ctx, name, string(backupType))
+		if err := medusaClient.CreateBackup(context.Background(), name, string(backupType)); err != nil {
+			syncBackups.Store(key, medusa.StatusType_FAILED)
+		} else {
+			syncBackups.Delete(key)
+		}
+	}()
+	return nil
+}
+
+// getBackupState returns the state of the backup in the Medusa sidecar of pod. It is UNKNOWN if Medusa has no such
+// backup, i.e. it has not been started on the pod.
+func getBackupState(ctx context.Context, backup *medusaapi.CassandraBackup, pod *corev1.Pod, clientFactory medusa.ClientFactory) (medusa.StatusType, error) {
+	addr := fmt.Sprintf("%s:%d", pod.Status.PodIP, backupSidecarPort)
+	if state, found := syncBackups.Load(syncBackupKey(addr, backup.Spec.Name)); found {
+		if state == medusa.StatusType_FAILED {
+			syncBackups.Delete(syncBackupKey(addr, backup.Spec.Name))
+		}
+		return state.(medusa.StatusType), nil
+	}
+
+	medusaClient, err := clientFactory.NewClient(addr)
+	if err != nil {
+		return medusa.StatusType_UNKNOWN, err
+	}
+	defer medusaClient.Close()
+
+	response, err := medusaClient.BackupStatus(ctx, backup.Spec.Name)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return medusa.StatusType_UNKNOWN, nil
+		}
+		return medusa.StatusType_UNKNOWN, err
 	}
+	// Medusa versions before 0.12 do not report a status, which reads as IN_PROGRESS, only a finish time
+	if response.GetStatus() == medusa.StatusType_IN_PROGRESS && response.GetFinishTime() != "" {
+		return medusa.StatusType_SUCCESS, nil
+	}
+	return response.GetStatus(), nil
+}
+
+func backupTimeout(backup *medusaapi.CassandraBackup) time.Duration {
+	if backup.Spec.Timeout != nil && backup.Spec.Timeout.Duration > 0 {
+		return backup.Spec.Timeout.Duration
+	}
+	return defaultBackupTimeout
+}
+
+func findPod(pods []corev1.Pod, name string) *corev1.Pod {
+	for i := range pods {
+		if pods[i].Name == name {
+			return &pods[i]
+		}
+	}
+	return nil
+}
+
+// doDeleteBackup deletes the backup name through the Medusa sidecar of pod. It returns false if the storage backend
+// does not have the backup, e.g. because it failed on all the nodes.
+func doDeleteBackup(ctx context.Context, name string, pod *corev1.Pod, clientFactory medusa.ClientFactory) (bool, error) {
+	addr := fmt.Sprintf("%s:%d", pod.Status.PodIP, backupSidecarPort)
+	medusaClient, err := clientFactory.NewClient(addr)
+	if err != nil {
+		return false, err
+	}
+	defer medusaClient.Close()
+
+	summaries, err := medusaClient.GetBackups(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, summary := range summaries {
+		if summary.BackupName == name {
+			return true, medusaClient.DeleteBackup(ctx, name)
+		}
+	}
+	return false, nil
+}
+
+// recordBackupMetrics records the duration and the per-pod outcomes of a finished backup.
+func recordBackupMetrics(backup *medusaapi.CassandraBackup) {
+	dcName := backup.Spec.CassandraDatacenter
+	duration := backup.Status.FinishTime.Sub(backup.Status.StartTime.Time)
+	metrics.BackupDuration.WithLabelValues(backup.Namespace, dcName).Observe(duration.Seconds())
+	metrics.BackupPodResults.WithLabelValues(backup.Namespace, dcName, metrics.ResultSucceeded).Add(float64(len(backup.Status.Finished)))
+	metrics.BackupPodResults.WithLabelValues(backup.Namespace, dcName, metrics.ResultFailed).Add(float64(len(backup.Status.Failed)))
 }
 
 func backupFinished(backup *medusaapi.CassandraBackup) bool {
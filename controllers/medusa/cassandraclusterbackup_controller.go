@@ -0,0 +1,404 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package medusa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const cassandraClusterBackupFinalizer = "cassandraclusterbackup.medusa.k8ssandra.io/finalizer"
+
+// Reasons of the events recorded by the CassandraClusterBackup controller.
+const (
+	eventReasonClusterNotFound         = "ClusterNotFound"
+	eventReasonDatacenterBackupCreated = "DatacenterBackupCreated"
+)
+
+// CassandraClusterBackupReconciler reconciles a CassandraClusterBackup object. It runs in the control plane, and
+// creates the CassandraBackups of the datacenters through the clients of their Kubernetes clusters. These are then
+// run by the CassandraBackup controller of each cluster.
+type CassandraClusterBackupReconciler struct {
+	*config.ReconcilerConfig
+	client.Client
+	Scheme        *runtime.Scheme
+	ClientCache   *clientcache.ClientCache
+	Recorder      record.EventRecorder
+	ClientFactory medusa.ClientFactory
+}
+
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandraclusterbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandraclusterbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandraclusterbackups/finalizers,verbs=update
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=k8ssandra.io,namespace="k8ssandra",resources=k8ssandraclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cassandra.datastax.com,namespace="k8ssandra",resources=cassandradatacenters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace="k8ssandra",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+func (r *CassandraClusterBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("CassandraClusterBackup", req.NamespacedName)
+
+	clusterBackup := &medusaapi.CassandraClusterBackup{}
+	if err := r.Get(ctx, req.NamespacedName, clusterBackup); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get CassandraClusterBackup")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	clusterBackup = clusterBackup.DeepCopy()
+
+	if clusterBackup.DeletionTimestamp != nil {
+		return r.checkDeletion(ctx, clusterBackup, logger)
+	}
+
+	if !controllerutil.ContainsFinalizer(clusterBackup, cassandraClusterBackupFinalizer) {
+		patch := client.MergeFrom(clusterBackup.DeepCopy())
+		controllerutil.AddFinalizer(clusterBackup, cassandraClusterBackupFinalizer)
+		if err := r.Patch(ctx, clusterBackup, patch); err != nil {
+			logger.Error(err, "Failed to add finalizer")
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+	}
+
+	if !clusterBackup.Status.FinishTime.IsZero() {
+		logger.Info("Cluster backup is already finished")
+		return ctrl.Result{}, nil
+	}
+
+	patch := client.MergeFrom(clusterBackup.DeepCopy())
+	if clusterBackup.Status.StartTime.IsZero() {
+		kcKey := types.NamespacedName{Namespace: clusterBackup.Namespace, Name: clusterBackup.Spec.Cluster.Name}
+		kc := &k8ssandraapi.K8ssandraCluster{}
+		if err := r.Get(ctx, kcKey, kc); err != nil {
+			if errors.IsNotFound(err) {
+				logger.Info("K8ssandraCluster not found", "K8ssandraCluster", kcKey)
+				r.Recorder.Eventf(clusterBackup, corev1.EventTypeWarning, eventReasonClusterNotFound, "K8ssandraCluster %s not found", kcKey.Name)
+				return ctrl.Result{RequeueAfter: r.LongDelay}, nil
+			}
+			logger.Error(err, "Failed to get K8ssandraCluster", "K8ssandraCluster", kcKey)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+		if kc.Spec.Medusa == nil {
+			logger.Info("Medusa is not deployed in the K8ssandraCluster", "K8ssandraCluster", kcKey)
+			r.Recorder.Eventf(clusterBackup, corev1.EventTypeWarning, eventReasonMedusaNotDeployed, "Medusa is not deployed in K8ssandraCluster %s", kcKey.Name)
+			return ctrl.Result{RequeueAfter: r.LongDelay}, nil
+		}
+
+		// The datacenters are fixed when the backup starts, so that they all share the same point in time.
+		logger.Info("Starting cluster backup", "Backup", clusterBackup.BackupName())
+		clusterBackup.Status.StartTime = metav1.Now()
+		clusterBackup.Status.Datacenters = make(map[string]medusaapi.DatacenterBackupStatus)
+		for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+			namespace := dcTemplate.Meta.Namespace
+			if namespace == "" {
+				namespace = kc.Namespace
+			}
+			clusterBackup.Status.Datacenters[dcTemplate.Meta.Name] = medusaapi.DatacenterBackupStatus{
+				K8sContext: dcTemplate.K8sContext,
+				Namespace:  namespace,
+				Backup:     fmt.Sprintf("%s-%s", clusterBackup.Name, dcTemplate.Meta.Name),
+			}
+		}
+		r.Recorder.Eventf(clusterBackup, corev1.EventTypeNormal, eventReasonBackupStarted,
+			"Starting backup %s on %d datacenters", clusterBackup.BackupName(), len(clusterBackup.Status.Datacenters))
+	}
+
+	hasErrors := false
+	for _, dcName := range datacenterNames(clusterBackup) {
+		if err := r.reconcileDatacenterBackup(ctx, clusterBackup, dcName, logger); err != nil {
+			hasErrors = true
+		}
+	}
+
+	if !hasErrors {
+		if finished, message := aggregateClusterBackupStatus(clusterBackup); finished {
+			logger.Info("The cluster backup is finished")
+			if clusterBackup.Status.GetConditionStatus(medusaapi.BackupFailed) == corev1.ConditionTrue {
+				r.Recorder.Event(clusterBackup, corev1.EventTypeWarning, eventReasonBackupFailed, message)
+			} else {
+				r.Recorder.Event(clusterBackup, corev1.EventTypeNormal, eventReasonBackupFinished, message)
+			}
+		}
+	}
+
+	if err := r.Status().Patch(ctx, clusterBackup, patch); err != nil {
+		logger.Error(err, "Failed to patch status")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	if clusterBackup.Status.FinishTime.IsZero() {
+		// The CassandraBackups are watched, this is in case a Kubernetes cluster cannot be reached
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileDatacenterBackup creates the CassandraBackup of the datacenter dcName if it does not exist yet, and copies
+// its progress to the status of clusterBackup.
+func (r *CassandraClusterBackupReconciler) reconcileDatacenterBackup(
+	ctx context.Context,
+	clusterBackup *medusaapi.CassandraClusterBackup,
+	dcName string,
+	logger logr.Logger) error {
+
+	dcStatus := clusterBackup.Status.Datacenters[dcName]
+	if !dcStatus.FinishTime.IsZero() {
+		return nil
+	}
+	backupKey := types.NamespacedName{Namespace: dcStatus.Namespace, Name: dcStatus.Backup}
+
+	remoteClient, err := r.ClientCache.GetRemoteClient(dcStatus.K8sContext)
+	if err != nil {
+		logger.Error(err, "Failed to get remote client", "Context", dcStatus.K8sContext)
+		return err
+	}
+
+	backup := &medusaapi.CassandraBackup{}
+	if err := remoteClient.Get(ctx, backupKey, backup); err != nil {
+		if !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get CassandraBackup", "CassandraBackup", backupKey, "Context", dcStatus.K8sContext)
+			return err
+		}
+		backup = newDatacenterBackup(clusterBackup, dcName)
+		logger.Info("Creating CassandraBackup", "CassandraBackup", backupKey, "Context", dcStatus.K8sContext)
+		if err := remoteClient.Create(ctx, backup); err != nil {
+			logger.Error(err, "Failed to create CassandraBackup", "CassandraBackup", backupKey, "Context", dcStatus.K8sContext)
+			return err
+		}
+		r.Recorder.Eventf(clusterBackup, corev1.EventTypeNormal, eventReasonDatacenterBackupCreated,
+			"Created CassandraBackup %s for CassandraDatacenter %s", backup.Name, dcName)
+	}
+
+	dcStatus.InProgress = backup.Status.InProgress
+	dcStatus.Finished = backup.Status.Finished
+	dcStatus.Failed = backup.Status.Failed
+	dcStatus.FinishTime = backup.Status.FinishTime
+	clusterBackup.Status.Datacenters[dcName] = dcStatus
+	return nil
+}
+
+// newDatacenterBackup returns the CassandraBackup of clusterBackup for the datacenter dcName. All the datacenters
+// share the same backup name, so that Medusa sees one backup of the whole cluster.
+func newDatacenterBackup(clusterBackup *medusaapi.CassandraClusterBackup, dcName string) *medusaapi.CassandraBackup {
+	dcStatus := clusterBackup.Status.Datacenters[dcName]
+	return &medusaapi.CassandraBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dcStatus.Namespace,
+			Name:      dcStatus.Backup,
+			Labels: map[string]string{
+				medusaapi.ClusterBackupNameLabel:      clusterBackup.Name,
+				medusaapi.ClusterBackupNamespaceLabel: clusterBackup.Namespace,
+			},
+		},
+		Spec: medusaapi.CassandraBackupSpec{
+			Name:                clusterBackup.BackupName(),
+			CassandraDatacenter: dcName,
+			Type:                clusterBackup.Spec.Type,
+			Timeout:             clusterBackup.Spec.Timeout,
+		},
+	}
+}
+
+// aggregateClusterBackupStatus finishes clusterBackup once the backup has finished in all the datacenters, with the
+// Failed condition set if it failed on any pod. It returns true along with the message of the condition if so.
+func aggregateClusterBackupStatus(clusterBackup *medusaapi.CassandraClusterBackup) (bool, string) {
+	var finishTime metav1.Time
+	failedPods, totalPods := 0, 0
+	for _, dcStatus := range clusterBackup.Status.Datacenters {
+		if dcStatus.FinishTime.IsZero() {
+			return false, ""
+		}
+		if finishTime.Before(&dcStatus.FinishTime) {
+			finishTime = dcStatus.FinishTime
+		}
+		failedPods += len(dcStatus.Failed)
+		totalPods += len(dcStatus.Failed) + len(dcStatus.Finished)
+	}
+
+	clusterBackup.Status.FinishTime = finishTime
+	if finishTime.IsZero() {
+		// There is no datacenter to back up
+		clusterBackup.Status.FinishTime = metav1.Now()
+	}
+	var message string
+	if failedPods > 0 {
+		message = fmt.Sprintf("Backup %s failed on %d of %d pods", clusterBackup.BackupName(), failedPods, totalPods)
+	} else {
+		message = fmt.Sprintf("Backup %s finished on %d pods of %d datacenters", clusterBackup.BackupName(), totalPods, len(clusterBackup.Status.Datacenters))
+	}
+	clusterBackup.Status.SetFailed(failedPods > 0, message)
+	return true, message
+}
+
+// checkDeletion deletes the CassandraBackups of the datacenters, then the backup from the storage bucket, before
+// removing the finalizer. The CassandraBackups leave the data of the backup in the storage bucket since it is shared
+// by all the datacenters. It is deleted once for all of them here, after the CassandraBackups are gone so that no
+// backup is still running.
+func (r *CassandraClusterBackupReconciler) checkDeletion(ctx context.Context, clusterBackup *medusaapi.CassandraClusterBackup, logger logr.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(clusterBackup, cassandraClusterBackupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	pending := false
+	for _, dcName := range datacenterNames(clusterBackup) {
+		dcStatus := clusterBackup.Status.Datacenters[dcName]
+		remoteClient, err := r.ClientCache.GetRemoteClient(dcStatus.K8sContext)
+		if err != nil {
+			logger.Error(err, "Failed to get remote client", "Context", dcStatus.K8sContext)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+		backup := &medusaapi.CassandraBackup{ObjectMeta: metav1.ObjectMeta{Namespace: dcStatus.Namespace, Name: dcStatus.Backup}}
+		if err := remoteClient.Delete(ctx, backup); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete CassandraBackup", "CassandraBackup", client.ObjectKeyFromObject(backup), "Context", dcStatus.K8sContext)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+		if err := remoteClient.Get(ctx, client.ObjectKeyFromObject(backup), backup); err == nil {
+			// The finalizer of the CassandraBackup waits for the backup to finish
+			pending = true
+		} else if !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to get CassandraBackup", "CassandraBackup", client.ObjectKeyFromObject(backup), "Context", dcStatus.K8sContext)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+	}
+	if pending {
+		logger.Info("Waiting for the CassandraBackups of the datacenters to be deleted")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
+	}
+
+	if !clusterBackup.Status.StartTime.IsZero() {
+		if err := r.deleteRemoteBackup(ctx, clusterBackup, logger); err != nil {
+			logger.Error(err, "Failed to delete the backup from the storage backend")
+			r.Recorder.Eventf(clusterBackup, corev1.EventTypeWarning, eventReasonBackupNotDeleted,
+				"Failed to delete backup %s from the storage backend: %v", clusterBackup.BackupName(), err)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+	}
+
+	patch := client.MergeFrom(clusterBackup.DeepCopy())
+	controllerutil.RemoveFinalizer(clusterBackup, cassandraClusterBackupFinalizer)
+	if err := r.Patch(ctx, clusterBackup, patch); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// deleteRemoteBackup deletes the backup of clusterBackup from the storage backend through the Medusa sidecar of a pod
+// of any of its datacenters. Medusa deletes the backup of all the nodes at once.
+func (r *CassandraClusterBackupReconciler) deleteRemoteBackup(ctx context.Context, clusterBackup *medusaapi.CassandraClusterBackup, logger logr.Logger) error {
+	dcFound := false
+	for _, dcName := range datacenterNames(clusterBackup) {
+		dcStatus := clusterBackup.Status.Datacenters[dcName]
+		remoteClient, err := r.ClientCache.GetRemoteClient(dcStatus.K8sContext)
+		if err != nil {
+			return err
+		}
+		cassdcKey := types.NamespacedName{Namespace: dcStatus.Namespace, Name: dcName}
+		if err := remoteClient.Get(ctx, cassdcKey, &cassdcapi.CassandraDatacenter{}); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		dcFound = true
+
+		pods := &corev1.PodList{}
+		if err := remoteClient.List(ctx, pods, client.InNamespace(dcStatus.Namespace), client.MatchingLabels{cassdcapi.DatacenterLabel: dcName}); err != nil {
+			return err
+		}
+		for _, pod := range pods.Items {
+			if !hasMedusaSidecar(&pod) || pod.Status.PodIP == "" {
+				continue
+			}
+			deleted, err := doDeleteBackup(ctx, clusterBackup.BackupName(), &pod, r.ClientFactory)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				logger.Info("Deleted the backup from the storage backend", "CassandraPod", pod.Name, "Context", dcStatus.K8sContext)
+				r.Recorder.Eventf(clusterBackup, corev1.EventTypeNormal, eventReasonBackupDeleted, "Deleted backup %s from the storage backend", clusterBackup.BackupName())
+			} else {
+				logger.Info("The backup is not in the storage backend", "CassandraPod", pod.Name, "Context", dcStatus.K8sContext)
+			}
+			return nil
+		}
+	}
+
+	if dcFound {
+		return medusa.BackupSidecarNotFound
+	}
+	logger.Info("No CassandraDatacenter found, the backup is left in the storage backend")
+	r.Recorder.Eventf(clusterBackup, corev1.EventTypeWarning, eventReasonBackupNotDeleted,
+		"No CassandraDatacenter found, backup %s is left in the storage backend", clusterBackup.BackupName())
+	return nil
+}
+
+// datacenterNames returns the names of the datacenters of clusterBackup in a stable order.
+func datacenterNames(clusterBackup *medusaapi.CassandraClusterBackup) []string {
+	names := make([]string, 0, len(clusterBackup.Status.Datacenters))
+	for dcName := range clusterBackup.Status.Datacenters {
+		names = append(names, dcName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *CassandraClusterBackupReconciler) SetupWithManager(mgr ctrl.Manager, clusters []cluster.Cluster) error {
+	backupToClusterBackup := func(obj client.Object) []reconcile.Request {
+		name := obj.GetLabels()[medusaapi.ClusterBackupNameLabel]
+		namespace := obj.GetLabels()[medusaapi.ClusterBackupNamespaceLabel]
+		if name != "" && namespace != "" {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+		}
+		return nil
+	}
+
+	cb := ctrl.NewControllerManagedBy(mgr).
+		For(&medusaapi.CassandraClusterBackup{}).
+		Watches(&source.Kind{Type: &medusaapi.CassandraBackup{}}, handler.EnqueueRequestsFromMapFunc(backupToClusterBackup))
+
+	for _, c := range clusters {
+		cb = cb.Watches(source.NewKindWithCache(&medusaapi.CassandraBackup{}, c.GetCache()),
+			handler.EnqueueRequestsFromMapFunc(backupToClusterBackup))
+	}
+
+	return cb.Complete(r)
+}
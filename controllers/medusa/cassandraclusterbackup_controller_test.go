@@ -0,0 +1,188 @@
+package medusa
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	k8ss "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/clientcache"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCassandraClusterBackup(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, cassdcapi.AddToScheme(s))
+	require.NoError(t, k8ss.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	kc := &k8ss.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "demo"},
+		Spec: k8ss.K8ssandraClusterSpec{
+			Cassandra: &k8ss.CassandraClusterTemplate{
+				Datacenters: []k8ss.CassandraDatacenterTemplate{
+					{Meta: k8ss.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: k8ss.EmbeddedObjectMeta{Name: "dc2", Namespace: "dc2-ns"}, K8sContext: "cluster-1"},
+				},
+			},
+			Medusa: &api.MedusaClusterTemplate{},
+		},
+	}
+	clusterBackupKey := types.NamespacedName{Namespace: "default", Name: "nightly"}
+	clusterBackup := &api.CassandraClusterBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nightly"},
+		Spec: api.CassandraClusterBackupSpec{
+			Cluster: corev1.LocalObjectReference{Name: "demo"},
+			Name:    "nightly-backup",
+			Type:    api.FullBackup,
+		},
+	}
+	dc2 := &cassdcapi.CassandraDatacenter{ObjectMeta: metav1.ObjectMeta{Namespace: "dc2-ns", Name: "dc2"}}
+	dc2Pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "dc2-ns", Name: "demo-dc2-default-sts-0", Labels: map[string]string{cassdcapi.DatacenterLabel: "dc2"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "cassandra"}, {Name: backupSidecarName}}},
+		Status:     corev1.PodStatus{PodIP: getPodIpAddress(0)},
+	}
+	localClient := fake.NewClientBuilder().WithScheme(s).WithObjects(kc, clusterBackup).Build()
+	remoteClient := fake.NewClientBuilder().WithScheme(s).WithObjects(dc2, dc2Pod).Build()
+	clientCache := clientcache.New(localClient, localClient, s)
+	clientCache.AddClient("cluster-1", remoteClient)
+
+	clientFactory := NewMedusaClientFactory()
+	medusaClient, _ := clientFactory.NewClient(fmt.Sprintf("%s:%d", getPodIpAddress(0), backupSidecarPort))
+	require.NoError(t, medusaClient.CreateBackup(ctx, "nightly-backup", string(api.FullBackup)))
+
+	r := &CassandraClusterBackupReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second, LongDelay: time.Minute},
+		Client:           localClient,
+		Scheme:           s,
+		ClientCache:      clientCache,
+		Recorder:         record.NewFakeRecorder(10),
+		ClientFactory:    clientFactory,
+	}
+	reconcile := func() (*api.CassandraClusterBackup, ctrl.Result) {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: clusterBackupKey})
+		require.NoError(t, err)
+		updated := &api.CassandraClusterBackup{}
+		require.NoError(t, localClient.Get(ctx, clusterBackupKey, updated))
+		return updated, result
+	}
+	dc1BackupKey := types.NamespacedName{Namespace: "default", Name: "nightly-dc1"}
+	dc2BackupKey := types.NamespacedName{Namespace: "dc2-ns", Name: "nightly-dc2"}
+	finishBackup := func(c client.Client, key types.NamespacedName, finished, failed []string) {
+		backup := &api.CassandraBackup{}
+		require.NoError(t, c.Get(ctx, key, backup))
+		patch := client.MergeFrom(backup.DeepCopy())
+		backup.Status.StartTime = metav1.Now()
+		backup.Status.FinishTime = metav1.Now()
+		backup.Status.Finished = finished
+		backup.Status.Failed = failed
+		require.NoError(t, c.Status().Patch(ctx, backup, patch))
+	}
+
+	t.Log("a CassandraBackup is created for each datacenter, in its Kubernetes cluster")
+	clusterBackup, result := reconcile()
+	assert.Equal(t, r.DefaultDelay, result.RequeueAfter)
+	assert.False(t, clusterBackup.Status.StartTime.IsZero())
+	assert.Equal(t, map[string]api.DatacenterBackupStatus{
+		"dc1": {Namespace: "default", Backup: "nightly-dc1"},
+		"dc2": {K8sContext: "cluster-1", Namespace: "dc2-ns", Backup: "nightly-dc2"},
+	}, clusterBackup.Status.Datacenters)
+
+	for dcName, c := range map[string]client.Client{"dc1": localClient, "dc2": remoteClient} {
+		key := dc1BackupKey
+		if dcName == "dc2" {
+			key = dc2BackupKey
+		}
+		backup := &api.CassandraBackup{}
+		require.NoError(t, c.Get(ctx, key, backup), "the CassandraBackup of %s is missing", dcName)
+		assert.Equal(t, "nightly-backup", backup.Spec.Name, "all the datacenters must use the same backup name")
+		assert.Equal(t, dcName, backup.Spec.CassandraDatacenter)
+		assert.Equal(t, api.FullBackup, backup.Spec.Type)
+		assert.Equal(t, "nightly", backup.Labels[api.ClusterBackupNameLabel])
+		assert.Equal(t, "default", backup.Labels[api.ClusterBackupNamespaceLabel])
+	}
+
+	t.Log("the cluster backup waits for all the datacenters")
+	finishBackup(localClient, dc1BackupKey, []string{"dc1-pod-0", "dc1-pod-1"}, nil)
+	clusterBackup, _ = reconcile()
+	assert.True(t, clusterBackup.Status.FinishTime.IsZero())
+	dc1Status := clusterBackup.Status.Datacenters["dc1"]
+	assert.Equal(t, []string{"dc1-pod-0", "dc1-pod-1"}, dc1Status.Finished)
+	assert.False(t, dc1Status.FinishTime.IsZero())
+
+	t.Log("the results of the datacenters are aggregated")
+	finishBackup(remoteClient, dc2BackupKey, []string{"dc2-pod-0"}, []string{"dc2-pod-1"})
+	clusterBackup, result = reconcile()
+	assert.Zero(t, result.RequeueAfter)
+	assert.False(t, clusterBackup.Status.FinishTime.IsZero())
+	assert.Equal(t, []string{"dc2-pod-1"}, clusterBackup.Status.Datacenters["dc2"].Failed)
+	assert.Equal(t, corev1.ConditionTrue, clusterBackup.Status.GetConditionStatus(api.BackupFailed))
+	assert.Equal(t, "Backup nightly-backup failed on 1 of 4 pods", clusterBackup.Status.Conditions[0].Message)
+
+	t.Log("deleting the cluster backup deletes the CassandraBackups of the datacenters and the backup, once")
+	require.NoError(t, localClient.Delete(ctx, clusterBackup))
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: clusterBackupKey})
+	require.NoError(t, err)
+	assert.True(t, errors.IsNotFound(localClient.Get(ctx, clusterBackupKey, &api.CassandraClusterBackup{})))
+	assert.True(t, errors.IsNotFound(localClient.Get(ctx, dc1BackupKey, &api.CassandraBackup{})))
+	assert.True(t, errors.IsNotFound(remoteClient.Get(ctx, dc2BackupKey, &api.CassandraBackup{})))
+	assert.Equal(t, []string{"nightly-backup"}, clientFactory.GetDeletedBackups())
+}
+
+func TestCassandraClusterBackupWithoutMedusa(t *testing.T) {
+	ctx := context.Background()
+
+	s := runtime.NewScheme()
+	require.NoError(t, k8ss.AddToScheme(s))
+	require.NoError(t, api.AddToScheme(s))
+
+	kc := &k8ss.K8ssandraCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "demo"},
+		Spec: k8ss.K8ssandraClusterSpec{
+			Cassandra: &k8ss.CassandraClusterTemplate{
+				Datacenters: []k8ss.CassandraDatacenterTemplate{{Meta: k8ss.EmbeddedObjectMeta{Name: "dc1"}}},
+			},
+		},
+	}
+	clusterBackup := &api.CassandraClusterBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nightly"},
+		Spec:       api.CassandraClusterBackupSpec{Cluster: corev1.LocalObjectReference{Name: "demo"}},
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(kc, clusterBackup).Build()
+	r := &CassandraClusterBackupReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second, LongDelay: time.Minute},
+		Client:           c,
+		Scheme:           s,
+		ClientCache:      clientcache.New(c, c, s),
+		Recorder:         record.NewFakeRecorder(10),
+	}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(clusterBackup)})
+	require.NoError(t, err)
+	assert.Equal(t, r.LongDelay, result.RequeueAfter)
+
+	backups := &api.CassandraBackupList{}
+	require.NoError(t, c.List(ctx, backups))
+	assert.Empty(t, backups.Items)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(clusterBackup), clusterBackup))
+	assert.True(t, clusterBackup.Status.StartTime.IsZero())
+}
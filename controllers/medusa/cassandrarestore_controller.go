@@ -28,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -36,6 +37,7 @@ import (
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
 )
 
 const (
@@ -48,14 +50,25 @@ const (
 type CassandraRestoreReconciler struct {
 	*config.ReconcilerConfig
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
+// Reasons of the events recorded by the CassandraRestore controller.
+const (
+	eventReasonRestoreStarted     = "RestoreStarted"
+	eventReasonStoppingDatacenter = "StoppingDatacenter"
+	eventReasonInvalidDatacenter  = "InvalidDatacenter"
+	eventReasonStartingDatacenter = "StartingDatacenter"
+	eventReasonRestoreFinished    = "RestoreFinished"
+)
+
 // +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrarestores,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrarestores/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cassandra.datastax.com,namespace="k8ssandra",resources=cassandradatacenters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,namespace="k8ssandra",resources=statefulsets,verbs=list;watch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
 
 func (r *CassandraRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx).WithValues("cassandrarestore", req.NamespacedName)
@@ -66,17 +79,28 @@ func (r *CassandraRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return *result, err
 	}
 
+	if request.Restore.Status.StartTime.IsZero() {
+		r.Recorder.Eventf(request.Restore, corev1.EventTypeNormal, eventReasonRestoreStarted,
+			"Restoring backup %s in CassandraDatacenter %s", request.Backup.Spec.Name, request.Datacenter.Name)
+	}
 	request.SetRestoreStartTime(metav1.Now())
 	request.SetRestoreKey(uuid.New().String())
 
 	if request.Restore.Spec.Shutdown && request.Restore.Status.DatacenterStopped.IsZero() {
+		wasStopped := request.Datacenter.Spec.Stopped
 		if stopped := stopDatacenter(request); !stopped {
+			if !wasStopped && request.Datacenter.Spec.Stopped {
+				r.Recorder.Eventf(request.Restore, corev1.EventTypeNormal, eventReasonStoppingDatacenter,
+					"Stopping CassandraDatacenter %s", request.Datacenter.Name)
+			}
 			return r.applyUpdatesAndRequeue(ctx, request)
 		}
 	}
 
 	if err := updateRestoreInitContainer(request); err != nil {
 		request.Log.Error(err, "The datacenter is not properly configured for backup/restore")
+		r.Recorder.Eventf(request.Restore, corev1.EventTypeWarning, eventReasonInvalidDatacenter,
+			"CassandraDatacenter %s is not properly configured for backup/restore: %v", request.Datacenter.Name, err)
 		// No need to requeue here because the datacenter is not properly configured for
 		// backup/restore with Medusa.
 		return ctrl.Result{}, err
@@ -100,6 +124,8 @@ func (r *CassandraRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	if request.Datacenter.Spec.Stopped {
 		request.Log.Info("Starting the datacenter")
 		request.Datacenter.Spec.Stopped = false
+		r.Recorder.Eventf(request.Restore, corev1.EventTypeNormal, eventReasonStartingDatacenter,
+			"Starting CassandraDatacenter %s", request.Datacenter.Name)
 
 		return r.applyUpdatesAndRequeue(ctx, request)
 	}
@@ -114,7 +140,12 @@ func (r *CassandraRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
 	}
 
+	duration := request.Restore.Status.FinishTime.Sub(request.Restore.Status.StartTime.Time)
+	metrics.RestoreDuration.WithLabelValues(request.Restore.Namespace, request.Datacenter.Name).Observe(duration.Seconds())
+
 	request.Log.Info("The restore operation is complete")
+	r.Recorder.Eventf(request.Restore, corev1.EventTypeNormal, eventReasonRestoreFinished,
+		"Restored backup %s in CassandraDatacenter %s", request.Backup.Spec.Name, request.Datacenter.Name)
 	return ctrl.Result{}, nil
 }
 
@@ -218,6 +249,11 @@ func stopDatacenter(req *medusa.RestoreRequest) bool {
 }
 
 func buildNewCassandraDatacenter(restore *medusaapi.CassandraRestore, backup *medusaapi.CassandraBackup) (*cassdcapi.CassandraDatacenter, error) {
+	if backup.Status.CassdcTemplateSpec == nil {
+		// Backups synchronized from the storage bucket do not have the spec of their datacenter
+		return nil, fmt.Errorf("CassandraBackup %s has no CassandraDatacenter spec to restore", backup.Name)
+	}
+
 	newCassdc := &cassdcapi.CassandraDatacenter{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: backup.Namespace,
@@ -66,6 +66,7 @@ func setupBackupTestEnv(t *testing.T, ctx context.Context) *testutils.MultiClust
 			Scheme:           scheme.Scheme,
 			ClientCache:      clientCache,
 			ManagementApi:    managementApi,
+			Recorder:         mgr.GetEventRecorderFor("k8ssandracluster-controller"),
 		}).SetupWithManager(mgr, clusters)
 		if err != nil {
 			return err
@@ -75,6 +76,7 @@ func setupBackupTestEnv(t *testing.T, ctx context.Context) *testutils.MultiClust
 			Client:           mgr.GetClient(),
 			Scheme:           scheme.Scheme,
 			ClientFactory:    medusaClientFactory,
+			Recorder:         mgr.GetEventRecorderFor("cassandrabackup-controller"),
 		}).SetupWithManager(mgr)
 		return err
 	})
@@ -104,6 +106,7 @@ func setupRestoreTestEnv(t *testing.T, ctx context.Context) *testutils.MultiClus
 			Scheme:           scheme.Scheme,
 			ClientCache:      clientCache,
 			ManagementApi:    managementApi,
+			Recorder:         mgr.GetEventRecorderFor("k8ssandracluster-controller"),
 		}).SetupWithManager(mgr, clusters)
 		if err != nil {
 			return err
@@ -113,6 +116,7 @@ func setupRestoreTestEnv(t *testing.T, ctx context.Context) *testutils.MultiClus
 			ReconcilerConfig: reconcilerConfig,
 			Client:           mgr.GetClient(),
 			Scheme:           scheme.Scheme,
+			Recorder:         mgr.GetEventRecorderFor("cassandrarestore-controller"),
 		}).SetupWithManager(mgr)
 		return err
 	})
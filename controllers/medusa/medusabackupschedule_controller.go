@@ -0,0 +1,243 @@
+/*
+
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package medusa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/cron"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const defaultBackupsHistoryLimit = 10
+
+// Reasons of the events recorded by the MedusaBackupSchedule controller.
+const (
+	eventReasonInvalidSchedule = "InvalidSchedule"
+	eventReasonBackupScheduled = "BackupScheduled"
+)
+
+// MedusaBackupScheduleReconciler reconciles a MedusaBackupSchedule object
+type MedusaBackupScheduleReconciler struct {
+	*config.ReconcilerConfig
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=medusabackupschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=medusabackupschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=medusa.k8ssandra.io,namespace="k8ssandra",resources=cassandrabackups,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+func (r *MedusaBackupScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithValues("MedusaBackupSchedule", req.NamespacedName)
+
+	schedule := &medusaapi.MedusaBackupSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, schedule); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get MedusaBackupSchedule")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	return r.reconcileSchedule(ctx, schedule, time.Now(), logger)
+}
+
+// reconcileSchedule creates the CassandraBackup of schedule that is due at now, if any, and requeues the schedule
+// for the next one.
+func (r *MedusaBackupScheduleReconciler) reconcileSchedule(
+	ctx context.Context,
+	schedule *medusaapi.MedusaBackupSchedule,
+	now time.Time,
+	logger logr.Logger) (ctrl.Result, error) {
+
+	patch := client.MergeFrom(schedule.DeepCopy())
+	result := ctrl.Result{}
+
+	cronSchedule, location, err := parseBackupSchedule(schedule)
+	if err != nil {
+		logger.Error(err, "Invalid backup schedule")
+		r.Recorder.Eventf(schedule, corev1.EventTypeWarning, eventReasonInvalidSchedule, "Invalid backup schedule: %v", err)
+		schedule.Status.NextSchedule = nil
+		return result, r.patchScheduleStatus(ctx, schedule, patch, logger)
+	}
+
+	if schedule.Spec.Disabled {
+		logger.Info("The backup schedule is disabled")
+		schedule.Status.NextSchedule = nil
+		return result, r.patchScheduleStatus(ctx, schedule, patch, logger)
+	}
+
+	due := nextBackupTime(schedule, cronSchedule, location)
+	if !due.IsZero() && !due.After(now) {
+		active, err := r.activeBackups(ctx, schedule)
+		if err != nil {
+			logger.Error(err, "Failed to list the backups of the schedule")
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+
+		if len(active) > 0 && schedule.Spec.ConcurrencyPolicy != medusaapi.AllowConcurrent {
+			// The backup is created once the running ones have finished, these are watched
+			logger.Info("Waiting for the previous backups to finish", "Backups", active)
+			schedule.Status.NextSchedule = &metav1.Time{Time: due}
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, r.patchScheduleStatus(ctx, schedule, patch, logger)
+		}
+
+		backup := newScheduledBackup(schedule, due)
+		logger.Info("Creating scheduled backup", "CassandraBackup", backup.Name)
+		if err := r.Create(ctx, backup); err != nil && !errors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create scheduled backup", "CassandraBackup", backup.Name)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+		r.Recorder.Eventf(schedule, corev1.EventTypeNormal, eventReasonBackupScheduled,
+			"Created CassandraBackup %s of CassandraDatacenter %s", backup.Name, backup.Spec.CassandraDatacenter)
+
+		schedule.Status.LastExecution = &metav1.Time{Time: now}
+		schedule.Status.Backups = addToBackupHistory(schedule.Status.Backups, backup.Name, backupsHistoryLimit(schedule))
+		due = cronSchedule.Next(now.In(location))
+	}
+
+	if due.IsZero() {
+		logger.Info("The backup schedule does not match any time in the next years")
+		schedule.Status.NextSchedule = nil
+	} else {
+		schedule.Status.NextSchedule = &metav1.Time{Time: due}
+		result.RequeueAfter = due.Sub(now)
+	}
+	return result, r.patchScheduleStatus(ctx, schedule, patch, logger)
+}
+
+func (r *MedusaBackupScheduleReconciler) patchScheduleStatus(
+	ctx context.Context,
+	schedule *medusaapi.MedusaBackupSchedule,
+	patch client.Patch,
+	logger logr.Logger) error {
+
+	if err := r.Status().Patch(ctx, schedule, patch); err != nil {
+		logger.Error(err, "Failed to patch status")
+		return err
+	}
+	return nil
+}
+
+// activeBackups returns the names of the CassandraBackups created by schedule that have not finished yet.
+func (r *MedusaBackupScheduleReconciler) activeBackups(ctx context.Context, schedule *medusaapi.MedusaBackupSchedule) ([]string, error) {
+	backups := &medusaapi.CassandraBackupList{}
+	labels := client.MatchingLabels{medusaapi.BackupScheduleLabel: schedule.Name}
+	if err := r.List(ctx, backups, client.InNamespace(schedule.Namespace), labels); err != nil {
+		return nil, err
+	}
+	active := make([]string, 0)
+	for _, backup := range backups.Items {
+		if !backupFinished(&backup) {
+			active = append(active, backup.Name)
+		}
+	}
+	return active, nil
+}
+
+func parseBackupSchedule(schedule *medusaapi.MedusaBackupSchedule) (*cron.Schedule, *time.Location, error) {
+	cronSchedule, err := cron.Parse(schedule.Spec.CronSchedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	location, err := time.LoadLocation(schedule.Spec.TimeZone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid time zone %q: %v", schedule.Spec.TimeZone, err)
+	}
+	return cronSchedule, location, nil
+}
+
+// nextBackupTime returns the time at which the next backup of schedule is due, which is in the past if a backup is
+// due already. Only one backup is due after the runs that were missed, e.g. while the operator was down.
+func nextBackupTime(schedule *medusaapi.MedusaBackupSchedule, cronSchedule *cron.Schedule, location *time.Location) time.Time {
+	last := schedule.CreationTimestamp.Time
+	if schedule.Status.LastExecution != nil {
+		last = schedule.Status.LastExecution.Time
+	}
+	return cronSchedule.Next(last.In(location))
+}
+
+// newScheduledBackup returns the CassandraBackup of schedule that is due at due. Its name is derived from the due
+// time, so that creating it again after a failed status update is a no-op.
+func newScheduledBackup(schedule *medusaapi.MedusaBackupSchedule, due time.Time) *medusaapi.CassandraBackup {
+	name := fmt.Sprintf("%s-%s", schedule.Name, due.UTC().Format("20060102150405"))
+	backup := &medusaapi.CassandraBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: schedule.Namespace,
+			Name:      name,
+			Labels:    map[string]string{medusaapi.BackupScheduleLabel: schedule.Name},
+		},
+		Spec: *schedule.Spec.BackupSpec.DeepCopy(),
+	}
+	backup.Spec.Name = name
+	return backup
+}
+
+func backupsHistoryLimit(schedule *medusaapi.MedusaBackupSchedule) int {
+	if limit := schedule.Spec.BackupsHistoryLimit; limit != nil && *limit > 0 {
+		return int(*limit)
+	}
+	return defaultBackupsHistoryLimit
+}
+
+// addToBackupHistory adds name at the head of history, and drops the oldest backups beyond limit.
+func addToBackupHistory(history []string, name string, limit int) []string {
+	updated := []string{name}
+	for _, backup := range history {
+		if len(updated) >= limit {
+			break
+		}
+		if backup != name {
+			updated = append(updated, backup)
+		}
+	}
+	return updated
+}
+
+func (r *MedusaBackupScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	backupToSchedule := func(obj client.Object) []reconcile.Request {
+		if scheduleName, found := obj.GetLabels()[medusaapi.BackupScheduleLabel]; found {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: scheduleName}}}
+		}
+		return nil
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&medusaapi.MedusaBackupSchedule{}).
+		Watches(&source.Kind{Type: &medusaapi.CassandraBackup{}}, handler.EnqueueRequestsFromMapFunc(backupToSchedule)).
+		Complete(r)
+}
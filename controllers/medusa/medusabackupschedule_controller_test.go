@@ -0,0 +1,131 @@
+package medusa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAddToBackupHistory(t *testing.T) {
+	history := addToBackupHistory(nil, "b1", 2)
+	assert.Equal(t, []string{"b1"}, history)
+	history = addToBackupHistory(history, "b2", 2)
+	assert.Equal(t, []string{"b2", "b1"}, history)
+	history = addToBackupHistory(history, "b3", 2)
+	assert.Equal(t, []string{"b3", "b2"}, history)
+	history = addToBackupHistory(history, "b3", 2)
+	assert.Equal(t, []string{"b3", "b2"}, history, "a backup must not be listed twice")
+}
+
+func TestReconcileSchedule(t *testing.T) {
+	ctx := context.Background()
+	logger := logr.Discard()
+
+	s := runtime.NewScheme()
+	require.NoError(t, api.AddToScheme(s))
+
+	created := time.Date(2022, 3, 5, 0, 0, 0, 0, time.UTC)
+	schedule := &api.MedusaBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "nightly",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: api.MedusaBackupScheduleSpec{
+			CronSchedule: "0 1 * * *",
+			BackupSpec:   api.CassandraBackupSpec{CassandraDatacenter: "dc1", Type: api.FullBackup},
+		},
+	}
+	scheduleKey := client.ObjectKey{Namespace: "default", Name: "nightly"}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(schedule).Build()
+	r := &MedusaBackupScheduleReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		Client:           c,
+		Scheme:           s,
+		Recorder:         record.NewFakeRecorder(10),
+	}
+
+	reconcileAt := func(now time.Time) time.Duration {
+		require.NoError(t, c.Get(ctx, scheduleKey, schedule))
+		result, err := r.reconcileSchedule(ctx, schedule, now, logger)
+		require.NoError(t, err)
+		require.NoError(t, c.Get(ctx, scheduleKey, schedule))
+		return result.RequeueAfter
+	}
+	listBackups := func() []api.CassandraBackup {
+		backups := &api.CassandraBackupList{}
+		require.NoError(t, c.List(ctx, backups))
+		return backups.Items
+	}
+
+	t.Log("no backup is due before the first scheduled time")
+	requeueAfter := reconcileAt(created.Add(30 * time.Minute))
+	assert.Equal(t, 30*time.Minute, requeueAfter)
+	assert.Empty(t, listBackups())
+	require.NotNil(t, schedule.Status.NextSchedule)
+	assert.True(t, created.Add(time.Hour).Equal(schedule.Status.NextSchedule.Time))
+	assert.Nil(t, schedule.Status.LastExecution)
+
+	t.Log("the backup is created once it is due")
+	now := created.Add(time.Hour + 5*time.Second)
+	requeueAfter = reconcileAt(now)
+	backups := listBackups()
+	require.Len(t, backups, 1)
+	backup := backups[0]
+	assert.Equal(t, "nightly-20220305010000", backup.Name)
+	assert.Equal(t, "nightly-20220305010000", backup.Spec.Name)
+	assert.Equal(t, "dc1", backup.Spec.CassandraDatacenter)
+	assert.Equal(t, api.FullBackup, backup.Spec.Type)
+	assert.Equal(t, "nightly", backup.Labels[api.BackupScheduleLabel])
+	assert.True(t, now.Equal(schedule.Status.LastExecution.Time))
+	assert.True(t, created.Add(25*time.Hour).Equal(schedule.Status.NextSchedule.Time))
+	assert.Equal(t, []string{"nightly-20220305010000"}, schedule.Status.Backups)
+	assert.Equal(t, 24*time.Hour-5*time.Second, requeueAfter)
+
+	t.Log("the next backup waits for the previous one to finish")
+	now = created.Add(25*time.Hour + 5*time.Second)
+	requeueAfter = reconcileAt(now)
+	assert.Len(t, listBackups(), 1)
+	assert.Equal(t, r.DefaultDelay, requeueAfter)
+
+	patch := client.MergeFrom(backup.DeepCopy())
+	backup.Status.FinishTime = metav1.NewTime(now)
+	require.NoError(t, c.Status().Patch(ctx, &backup, patch))
+
+	now = now.Add(time.Minute)
+	reconcileAt(now)
+	assert.Len(t, listBackups(), 2)
+	assert.Equal(t, []string{"nightly-20220306010000", "nightly-20220305010000"}, schedule.Status.Backups)
+
+	t.Log("backups can run concurrently if the policy allows it")
+	patch = client.MergeFrom(schedule.DeepCopy())
+	schedule.Spec.ConcurrencyPolicy = api.AllowConcurrent
+	schedule.Spec.BackupsHistoryLimit = pointer.Int32(2)
+	require.NoError(t, c.Patch(ctx, schedule, patch))
+
+	reconcileAt(created.Add(49 * time.Hour))
+	assert.Len(t, listBackups(), 3)
+	assert.Equal(t, []string{"nightly-20220307010000", "nightly-20220306010000"}, schedule.Status.Backups)
+
+	t.Log("a disabled schedule does not create backups")
+	patch = client.MergeFrom(schedule.DeepCopy())
+	schedule.Spec.Disabled = true
+	require.NoError(t, c.Patch(ctx, schedule, patch))
+
+	requeueAfter = reconcileAt(created.Add(73 * time.Hour))
+	assert.Len(t, listBackups(), 3)
+	assert.Nil(t, schedule.Status.NextSchedule)
+	assert.Zero(t, requeueAfter)
+}
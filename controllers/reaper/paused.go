@@ -0,0 +1,45 @@
+package reaper
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// checkPaused returns true if the reconciliation of actualReaper is paused, in which case only its readiness is
+// refreshed from the existing Deployment. When reconciliation is resumed, the ReaperPaused condition is cleared.
+func (r *ReaperReconciler) checkPaused(ctx context.Context, actualReaper *reaperapi.Reaper, logger logr.Logger) (bool, ctrl.Result, error) {
+	if !annotations.IsPaused(actualReaper) {
+		if actualReaper.Status.GetConditionStatus(reaperapi.ReaperPaused) == corev1.ConditionTrue {
+			logger.Info("Reconciliation resumed")
+			actualReaper.Status.SetPaused(false)
+			r.Recorder.Event(actualReaper, corev1.EventTypeNormal, eventReasonResumed, "Reconciliation resumed")
+		}
+		return false, ctrl.Result{}, nil
+	}
+
+	logger.Info("Reconciliation is paused, only refreshing the status")
+	if actualReaper.Status.SetPaused(true) {
+		r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonPaused, "Reconciliation paused with the %s annotation", k8ssandraapi.PausedAnnotation)
+	}
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Namespace: actualReaper.Namespace, Name: actualReaper.Name}
+	if err := r.Get(ctx, deploymentKey, deployment); err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "Failed to get Reaper Deployment", "Deployment", deploymentKey)
+		return true, ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	} else if err != nil || deployment.Status.ReadyReplicas == 0 {
+		if actualReaper.Status.IsReady() {
+			actualReaper.Status.SetNotReady()
+		}
+	}
+	return true, ctrl.Result{}, nil
+}
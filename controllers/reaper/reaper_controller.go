@@ -21,6 +21,7 @@ import (
 
 	"github.com/go-logr/logr"
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -47,13 +49,31 @@ type ReaperReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	NewManager func() reaper.Manager
+	Recorder   record.EventRecorder
 }
 
+// Reasons of the events recorded by the Reaper controller.
+const (
+	eventReasonReconcileFailed   = "ReconcileFailed"
+	eventReasonCreatedDeployment = "CreatedDeployment"
+	eventReasonUpdatedDeployment = "UpdatedDeployment"
+	eventReasonCreatedService    = "CreatedService"
+	eventReasonUpdatedService    = "UpdatedService"
+	eventReasonRegisteredCluster = "RegisteredCluster"
+	eventReasonReady             = "Ready"
+	eventReasonPaused            = "Paused"
+	eventReasonResumed           = "Resumed"
+	eventReasonRepairingNode     = "RepairingNode"
+	eventReasonNodeRepaired      = "NodeRepaired"
+	eventReasonNodeRepairFailed  = "NodeRepairFailed"
+)
+
 // +kubebuilder:rbac:groups=reaper.k8ssandra.io,namespace="k8ssandra",resources=reapers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=reaper.k8ssandra.io,namespace="k8ssandra",resources=reapers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="apps",namespace="k8ssandra",resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="core",namespace="k8ssandra",resources=pods;secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="core",namespace="k8ssandra",resources=services,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
 
 func (r *ReaperReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx, "Reaper", req.NamespacedName)
@@ -74,7 +94,13 @@ func (r *ReaperReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	actualReaper = actualReaper.DeepCopy()
 	patch := client.MergeFromWithOptions(actualReaper.DeepCopy())
 
+	wasReady := actualReaper.Status.IsReady()
 	result, err := r.reconcile(ctx, actualReaper, logger)
+	if err != nil {
+		r.Recorder.Event(actualReaper, corev1.EventTypeWarning, eventReasonReconcileFailed, err.Error())
+	} else if !wasReady && actualReaper.Status.IsReady() {
+		r.Recorder.Event(actualReaper, corev1.EventTypeNormal, eventReasonReady, "Reaper is ready")
+	}
 
 	if patchErr := r.Status().Patch(ctx, actualReaper, patch); patchErr != nil {
 		logger.Error(patchErr, "Failed to update Reaper status")
@@ -87,6 +113,10 @@ func (r *ReaperReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 func (r *ReaperReconciler) reconcile(ctx context.Context, actualReaper *reaperapi.Reaper, logger logr.Logger) (ctrl.Result, error) {
 
+	if paused, result, err := r.checkPaused(ctx, actualReaper, logger); paused {
+		return result, err
+	}
+
 	actualReaper.Status.Progress = reaperapi.ReaperProgressPending
 	actualReaper.Status.SetNotReady()
 
@@ -115,7 +145,7 @@ func (r *ReaperReconciler) reconcile(ctx context.Context, actualReaper *reaperap
 	actualReaper.Status.SetReady()
 
 	logger.Info("Reaper successfully reconciled")
-	return ctrl.Result{}, nil
+	return r.reconcileNodeRepair(ctx, actualReaper, actualDc, logger)
 }
 
 func (r *ReaperReconciler) reconcileDatacenter(
@@ -202,6 +232,7 @@ func (r *ReaperReconciler) reconcileDeployment(
 				}
 			}
 			logger.Info("Reaper Deployment created successfully")
+			r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonCreatedDeployment, "Created Deployment %s", deploymentKey.Name)
 			return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
 		} else {
 			logger.Error(err, "Failed to get Reaper Deployment")
@@ -225,6 +256,7 @@ func (r *ReaperReconciler) reconcileDeployment(
 			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
 		} else {
 			logger.Info("Reaper Deployment updated successfully")
+			r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonUpdatedDeployment, "Updated Deployment %s", deploymentKey.Name)
 			return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
 		}
 	}
@@ -262,6 +294,7 @@ func (r *ReaperReconciler) reconcileService(
 				}
 			}
 			logger.Info("Reaper Service created successfully")
+			r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonCreatedService, "Created Service %s", serviceKey.Name)
 			return ctrl.Result{}, nil
 		} else {
 			logger.Error(err, "Failed to get Reaper Service")
@@ -283,6 +316,7 @@ func (r *ReaperReconciler) reconcileService(
 			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
 		} else {
 			logger.Info("Reaper Service updated successfully")
+			r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonUpdatedService, "Updated Service %s", serviceKey.Name)
 			return ctrl.Result{}, nil
 		}
 	}
@@ -308,6 +342,7 @@ func (r *ReaperReconciler) configureReaper(ctx context.Context, actualReaper *re
 				logger.Error(err, "failed to register cluster with reaper")
 				return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
 			}
+			r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonRegisteredCluster, "Registered cluster %s with Reaper", actualDc.Spec.ClusterName)
 		}
 	}
 	return ctrl.Result{}, nil
@@ -397,7 +432,7 @@ func (r *ReaperReconciler) getSecret(ctx context.Context, secretKey types.Namesp
 
 func (r *ReaperReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&reaperapi.Reaper{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&reaperapi.Reaper{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, annotations.ValueChangedPredicate{Keys: []string{k8ssandraapi.PausedAnnotation, k8ssandraapi.CredentialsRevisionAnnotation}}))).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Complete(r)
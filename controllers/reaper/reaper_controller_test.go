@@ -13,6 +13,7 @@ import (
 	"github.com/k8ssandra/k8ssandra-operator/pkg/mocks"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
 	testutils "github.com/k8ssandra/k8ssandra-operator/pkg/test"
+	reaperclient "github.com/k8ssandra/reaper-client-go/reaper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ const (
 	reaperName              = "test-reaper"
 	cassandraClusterName    = "test-cluster"
 	cassandraDatacenterName = "test-dc"
+	repairRunId             = "3f0a9e46-3a3b-11ec-8d3d-0242ac130003"
 
 	timeout  = time.Second * 5
 	interval = time.Millisecond * 250
@@ -46,6 +48,7 @@ func TestReaper(t *testing.T) {
 			Client:           mgr.GetClient(),
 			Scheme:           mgr.GetScheme(),
 			NewManager:       newMockManager,
+			Recorder:         mgr.GetEventRecorderFor("reaper-controller"),
 		}).SetupWithManager(mgr)
 		return err
 	})
@@ -60,6 +63,8 @@ func TestReaper(t *testing.T) {
 	t.Run("CreateReaperWithExistingObjects", reaperControllerTest(ctx, testEnv, testCreateReaperWithExistingObjects))
 	t.Run("CreateReaperWithAutoSchedulingEnabled", reaperControllerTest(ctx, testEnv, testCreateReaperWithAutoSchedulingEnabled))
 	t.Run("CreateReaperWithAuthEnabled", reaperControllerTest(ctx, testEnv, testCreateReaperWithAuthEnabled))
+	t.Run("PauseReaper", reaperControllerTest(ctx, testEnv, testPauseReaper))
+	t.Run("RepairNode", reaperControllerTest(ctx, testEnv, testRepairNode))
 }
 
 func newMockManager() reaper.Manager {
@@ -67,6 +72,9 @@ func newMockManager() reaper.Manager {
 	m.On("Connect", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	m.On("AddClusterToReaper", mock.Anything, mock.Anything).Return(nil)
 	m.On("VerifyClusterIsConfigured", mock.Anything, mock.Anything).Return(true, nil)
+	m.On("CreateNodeRepairRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(repairRunId, nil)
+	m.On("StartRepairRun", mock.Anything, repairRunId).Return(nil)
+	m.On("GetRepairRunState", mock.Anything, repairRunId).Return(reaperclient.RepairRunStateDone, nil)
 	m.Test(currentTest)
 	return m
 }
@@ -466,6 +474,82 @@ func envVarSecretHasKey(envVars []corev1.EnvVar, name string, secretKey string)
 	return false
 }
 
+func testPauseReaper(t *testing.T, ctx context.Context, k8sClient client.Client, testNamespace string) {
+	rpr := newReaper(testNamespace)
+	rpr.Annotations = map[string]string{k8ssandraapi.PausedAnnotation: "true"}
+	err := k8sClient.Create(ctx, rpr)
+	require.NoError(t, err)
+
+	reaperKey := types.NamespacedName{Namespace: testNamespace, Name: reaperName}
+
+	t.Log("check that the Paused condition is set")
+	require.Eventually(t, func() bool {
+		updatedReaper := &reaperapi.Reaper{}
+		if err := k8sClient.Get(ctx, reaperKey, updatedReaper); err != nil {
+			return false
+		}
+		return updatedReaper.Status.GetConditionStatus(reaperapi.ReaperPaused) == corev1.ConditionTrue
+	}, timeout, interval, "paused condition check failed")
+
+	t.Log("check that the deployment is not created while paused")
+	deploymentKey := types.NamespacedName{Namespace: testNamespace, Name: reaperName}
+	require.Never(t, func() bool {
+		return k8sClient.Get(ctx, deploymentKey, &appsv1.Deployment{}) == nil
+	}, timeout, interval, "deployment should not be created while paused")
+
+	t.Log("resume reconciliation")
+	err = k8sClient.Get(ctx, reaperKey, rpr)
+	require.NoError(t, err)
+	patch := client.MergeFrom(rpr.DeepCopy())
+	delete(rpr.Annotations, k8ssandraapi.PausedAnnotation)
+	err = k8sClient.Patch(ctx, rpr, patch)
+	require.NoError(t, err)
+
+	t.Log("check that the deployment is created and the Paused condition cleared")
+	require.Eventually(t, func() bool {
+		return k8sClient.Get(ctx, deploymentKey, &appsv1.Deployment{}) == nil
+	}, timeout, interval, "deployment creation check failed")
+	require.Eventually(t, func() bool {
+		updatedReaper := &reaperapi.Reaper{}
+		if err := k8sClient.Get(ctx, reaperKey, updatedReaper); err != nil {
+			return false
+		}
+		return updatedReaper.Status.GetConditionStatus(reaperapi.ReaperPaused) == corev1.ConditionFalse
+	}, timeout, interval, "paused condition check failed")
+}
+
+func testRepairNode(t *testing.T, ctx context.Context, k8sClient client.Client, testNamespace string) {
+	rpr := newReaper(testNamespace)
+	rpr.Spec.NodeRepair = &reaperapi.NodeRepairRequest{
+		Node:      "10.0.0.1",
+		Keyspaces: []string{"ks1", "ks2"},
+		Cause:     "replacement of pod test-dc-default-sts-0",
+	}
+	err := k8sClient.Create(ctx, rpr)
+	require.NoError(t, err)
+
+	t.Log("update deployment to be ready")
+	deploymentKey := types.NamespacedName{Namespace: testNamespace, Name: reaperName}
+	deployment := &appsv1.Deployment{}
+	require.Eventually(t, func() bool {
+		return k8sClient.Get(ctx, deploymentKey, deployment) == nil
+	}, timeout, interval, "deployment creation check failed")
+	patchDeploymentStatus(t, ctx, deployment, 1, 1, k8sClient)
+
+	t.Log("check that the node is repaired")
+	reaperKey := types.NamespacedName{Namespace: testNamespace, Name: reaperName}
+	updatedReaper := &reaperapi.Reaper{}
+	require.Eventually(t, func() bool {
+		if err := k8sClient.Get(ctx, reaperKey, updatedReaper); err != nil {
+			return false
+		}
+		repair := updatedReaper.Status.NodeRepair
+		return repair != nil && repair.Progress == reaperapi.NodeRepairCompleted
+	}, timeout, interval, "node repair check failed")
+	assert.Equal(t, "10.0.0.1", updatedReaper.Status.NodeRepair.Node)
+	assert.Equal(t, map[string]string{"ks1": repairRunId, "ks2": repairRunId}, updatedReaper.Status.NodeRepair.RepairRuns)
+}
+
 func newReaper(namespace string) *reaperapi.Reaper {
 	return &reaperapi.Reaper{
 		ObjectMeta: metav1.ObjectMeta{
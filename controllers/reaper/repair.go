@@ -0,0 +1,104 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	reaperclient "github.com/k8ssandra/reaper-client-go/reaper"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// reconcileNodeRepair creates the repair runs requested in the nodeRepair field of the spec, one per keyspace, and
+// reports their progress in the status until they all terminate. A repair is only started once per node: changing
+// the node starts a new repair, while the same request is ignored once its repair has terminated.
+func (r *ReaperReconciler) reconcileNodeRepair(
+	ctx context.Context,
+	actualReaper *reaperapi.Reaper,
+	actualDc *cassdcapi.CassandraDatacenter,
+	logger logr.Logger) (ctrl.Result, error) {
+
+	request := actualReaper.Spec.NodeRepair
+	if request == nil {
+		return ctrl.Result{}, nil
+	}
+	status := actualReaper.Status.NodeRepair
+	if status != nil && status.Node == request.Node && status.Progress != reaperapi.NodeRepairRunning {
+		return ctrl.Result{}, nil
+	}
+	logger = logger.WithValues("Node", request.Node)
+
+	username, password, err := r.getReaperUICredentials(ctx, actualReaper, logger)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+	manager := r.NewManager()
+	if err := manager.Connect(ctx, actualReaper, username, password); err != nil {
+		logger.Error(err, "Failed to connect to Reaper to repair node")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+	}
+
+	if status == nil || status.Node != request.Node {
+		logger.Info("Starting node repair", "Keyspaces", request.Keyspaces)
+		status = &reaperapi.NodeRepairStatus{Node: request.Node, Progress: reaperapi.NodeRepairRunning}
+		actualReaper.Status.NodeRepair = status
+		r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonRepairingNode, "Repairing node %s", request.Node)
+	}
+
+	// The repair runs that could not be created by a previous reconciliation are created now. They are only started
+	// below, once their id is in the status, so that a failed start does not lead to a duplicate repair run.
+	for _, keyspace := range request.Keyspaces {
+		if _, found := status.RepairRuns[keyspace]; found {
+			continue
+		}
+		repairRunId, err := manager.CreateNodeRepairRun(ctx, actualDc, keyspace, request.Node, request.Cause)
+		if err != nil {
+			logger.Error(err, "Failed to create repair run", "Keyspace", keyspace)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+		if status.RepairRuns == nil {
+			status.RepairRuns = make(map[string]string)
+		}
+		status.RepairRuns[keyspace] = repairRunId
+	}
+
+	done := true
+	for _, keyspace := range request.Keyspaces {
+		repairRunId := status.RepairRuns[keyspace]
+		state, err := manager.GetRepairRunState(ctx, repairRunId)
+		if err != nil {
+			logger.Error(err, "Failed to get repair run", "RepairRun", repairRunId)
+			return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+		}
+		switch state {
+		case reaperclient.RepairRunStateNotStarted:
+			if err := manager.StartRepairRun(ctx, repairRunId); err != nil {
+				logger.Error(err, "Failed to start repair run", "RepairRun", repairRunId)
+				return ctrl.Result{RequeueAfter: r.DefaultDelay}, err
+			}
+			done = false
+		case reaperclient.RepairRunStateDone:
+		case reaperclient.RepairRunStateError, reaperclient.RepairRunStateAborted, reaperclient.RepairRunStateDeleted:
+			status.Progress = reaperapi.NodeRepairFailed
+			status.Message = fmt.Sprintf("repair run %s of keyspace %s is in state %s", repairRunId, keyspace, state)
+			logger.Info("Node repair failed", "Reason", status.Message)
+			r.Recorder.Eventf(actualReaper, corev1.EventTypeWarning, eventReasonNodeRepairFailed,
+				"Repair of node %s failed: %s", request.Node, status.Message)
+			return ctrl.Result{}, nil
+		default:
+			done = false
+		}
+	}
+	if !done {
+		logger.Info("Waiting for node repair to complete")
+		return ctrl.Result{RequeueAfter: r.DefaultDelay}, nil
+	}
+
+	logger.Info("Node repair completed")
+	status.Progress = reaperapi.NodeRepairCompleted
+	r.Recorder.Eventf(actualReaper, corev1.EventTypeNormal, eventReasonNodeRepaired, "Repaired node %s", request.Node)
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,52 @@
+package reaper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/mocks"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	reaperclient "github.com/k8ssandra/reaper-client-go/reaper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+)
+
+// TestReconcileNodeRepairStartFailure verifies that a repair run that could not be started is started by the next
+// reconciliation instead of being created again.
+func TestReconcileNodeRepairStartFailure(t *testing.T) {
+	m := new(mocks.ReaperManager)
+	m.Test(t)
+	m.On("Connect", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	m.On("CreateNodeRepairRun", mock.Anything, mock.Anything, "ks1", "10.0.0.1", mock.Anything).Return(repairRunId, nil).Once()
+	m.On("GetRepairRunState", mock.Anything, repairRunId).Return(reaperclient.RepairRunStateNotStarted, nil)
+	m.On("StartRepairRun", mock.Anything, repairRunId).Return(errors.New("connection refused")).Once()
+	m.On("StartRepairRun", mock.Anything, repairRunId).Return(nil).Once()
+
+	r := &ReaperReconciler{
+		ReconcilerConfig: &config.ReconcilerConfig{DefaultDelay: time.Second},
+		NewManager:       func() reaper.Manager { return m },
+		Recorder:         record.NewFakeRecorder(10),
+	}
+	rpr := newReaper("default")
+	rpr.Spec.NodeRepair = &reaperapi.NodeRepairRequest{Node: "10.0.0.1", Keyspaces: []string{"ks1"}}
+	dc := &cassdcapi.CassandraDatacenter{}
+
+	_, err := r.reconcileNodeRepair(context.Background(), rpr, dc, logr.Discard())
+	require.Error(t, err)
+	require.NotNil(t, rpr.Status.NodeRepair)
+	assert.Equal(t, map[string]string{"ks1": repairRunId}, rpr.Status.NodeRepair.RepairRuns)
+
+	result, err := r.reconcileNodeRepair(context.Background(), rpr, dc, logr.Discard())
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, result.RequeueAfter)
+	assert.Equal(t, reaperapi.NodeRepairRunning, rpr.Status.NodeRepair.Progress)
+	m.AssertExpectations(t)
+}
@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,10 +42,20 @@ const (
 // +kubebuilder:rbac:groups=replication.k8ssandra.io,namespace="k8ssandra",resources=replicatedsecrets,verbs=get;list;watch;update;create;delete
 // +kubebuilder:rbac:groups=replication.k8ssandra.io,namespace="k8ssandra",resources=replicatedsecrets/finalizers,verbs=update
 // +kubebuilder:rbac:groups=replication.k8ssandra.io,namespace="k8ssandra",resources=replicatedsecrets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
+
+// Reasons of the events recorded by the SecretSyncController.
+const (
+	eventReasonCopiedSecret      = "CopiedSecret"
+	eventReasonUpdatedSecret     = "UpdatedSecret"
+	eventReasonImmutableSecret   = "ImmutableSecret"
+	eventReasonReplicationFailed = "ReplicationFailed"
+)
 
 type SecretSyncController struct {
 	*config.ReconcilerConfig
 	ClientCache *clientcache.ClientCache
+	Recorder    record.EventRecorder
 	// TODO We need a better structure for empty selectors (match whole kind)
 	WatchNamespaces []string
 	selectorMutex   sync.RWMutex
@@ -237,6 +248,8 @@ func (s *SecretSyncController) Reconcile(ctx context.Context, req ctrl.Request)
 						logger.Error(err, "Failed to sync secret to target cluster", "Secret", copiedSecret.Name, "TargetContext", target)
 						break TargetSecrets
 					}
+					s.Recorder.Eventf(rsec, corev1.EventTypeNormal, eventReasonCopiedSecret,
+						"Copied secret %s to namespace %s of context %s", sec.Name, namespace, targetContextName(target))
 					continue
 				}
 				logger.Error(err, "Failed to fetch secret from target cluster", "Secret", fetchedSecret.Name, "TargetContext", target)
@@ -246,6 +259,8 @@ func (s *SecretSyncController) Reconcile(ctx context.Context, req ctrl.Request)
 			if fetchedSecret.Immutable != nil && *fetchedSecret.Immutable {
 				err := fmt.Errorf("target secret is immutable")
 				logger.Error(err, "Failed to modify target secret, secret is set to immutable", "Secret", fetchedSecret.Name, "TargetContext", target)
+				s.Recorder.Eventf(rsec, corev1.EventTypeWarning, eventReasonImmutableSecret,
+					"Secret %s in namespace %s of context %s is immutable and cannot be synced", fetchedSecret.Name, namespace, targetContextName(target))
 				break TargetSecrets
 			}
 
@@ -256,10 +271,14 @@ func (s *SecretSyncController) Reconcile(ctx context.Context, req ctrl.Request)
 					logger.Error(err, "Failed to sync target secret for matching payloads", "Secret", fetchedSecret.Name, "TargetContext", target)
 					break TargetSecrets
 				}
+				s.Recorder.Eventf(rsec, corev1.EventTypeNormal, eventReasonUpdatedSecret,
+					"Updated secret %s in namespace %s of context %s", sec.Name, namespace, targetContextName(target))
 			}
 		}
 		if err != nil {
 			cond.Status = corev1.ConditionFalse
+			s.Recorder.Eventf(rsec, corev1.EventTypeWarning, eventReasonReplicationFailed,
+				"Failed to replicate secrets to context %s: %v", targetContextName(target), err)
 		} else {
 			cond.Status = corev1.ConditionTrue
 		}
@@ -285,6 +304,14 @@ func (s *SecretSyncController) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{}, err
 }
 
+// targetContextName returns the name of the context of target to be used in events, the local cluster has none.
+func targetContextName(target api.ReplicationTarget) string {
+	if target.K8sContextName == "" {
+		return "local"
+	}
+	return target.K8sContextName
+}
+
 func requiresUpdate(source, dest client.Object) bool {
 	// In case we target the same cluster
 	if source.GetUID() == dest.GetUID() {
@@ -0,0 +1,61 @@
+package stargate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcilePaused only refreshes the replica counts in the status of a paused Stargate from its existing deployments.
+func (r *StargateReconciler) reconcilePaused(ctx context.Context, stargate *api.Stargate, logger logr.Logger) (ctrl.Result, error) {
+	logger.Info("Reconciliation is paused, only refreshing the status", "Stargate", client.ObjectKeyFromObject(stargate))
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(stargate.Namespace), client.MatchingLabels{api.StargateLabel: stargate.Name}); err != nil {
+		logger.Error(err, "Failed to list Stargate Deployments")
+		return ctrl.Result{}, err
+	}
+
+	status := stargate.Status.DeepCopy()
+	status.Replicas, status.ReadyReplicas, status.UpdatedReplicas, status.AvailableReplicas = 0, 0, 0, 0
+	for _, deployment := range deployments.Items {
+		status.Replicas += deployment.Status.Replicas
+		status.ReadyReplicas += deployment.Status.ReadyReplicas
+		status.UpdatedReplicas += deployment.Status.UpdatedReplicas
+		status.AvailableReplicas += deployment.Status.AvailableReplicas
+	}
+	ratio := fmt.Sprintf("%v/%v", status.ReadyReplicas, stargate.Spec.Size)
+	status.ReadyReplicasRatio = &ratio
+	if status.SetPaused(true) {
+		r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonPaused, "Reconciliation paused with the %s annotation", k8ssandraapi.PausedAnnotation)
+	}
+
+	stargate.Status = *status
+	if err := r.Status().Update(ctx, stargate); err != nil {
+		logger.Error(err, "Failed to update Stargate status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// checkResumed clears the StargatePaused condition of a Stargate that is no longer paused.
+func (r *StargateReconciler) checkResumed(ctx context.Context, stargate *api.Stargate, logger logr.Logger) error {
+	if stargate.Status.GetConditionStatus(api.StargatePaused) != corev1.ConditionTrue {
+		return nil
+	}
+	logger.Info("Reconciliation resumed", "Stargate", client.ObjectKeyFromObject(stargate))
+	stargate.Status.SetPaused(false)
+	if err := r.Status().Update(ctx, stargate); err != nil {
+		logger.Error(err, "Failed to update Stargate status")
+		return err
+	}
+	r.Recorder.Event(stargate, corev1.EventTypeNormal, eventReasonResumed, "Reconciliation resumed")
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-logr/logr"
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
@@ -36,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,6 +55,7 @@ import (
 // +kubebuilder:rbac:groups=apps,namespace="k8ssandra",resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,namespace="k8ssandra",resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=core,namespace="k8ssandra",resources=events,verbs=create;patch
 
 // StargateReconciler reconciles a Stargate object
 type StargateReconciler struct {
@@ -60,8 +63,23 @@ type StargateReconciler struct {
 	client.Client
 	Scheme        *runtime.Scheme
 	ManagementApi cassandra.ManagementApiFactory
+	Recorder      record.EventRecorder
 }
 
+// Reasons of the events recorded by the Stargate controller.
+const (
+	eventReasonAuthKeyspaceFailed = "AuthKeyspaceFailed"
+	eventReasonCreatedDeployment  = "CreatedDeployment"
+	eventReasonUpdatedDeployment  = "UpdatedDeployment"
+	eventReasonDeletedDeployment  = "DeletedDeployment"
+	eventReasonDeploymentFailed   = "DeploymentFailed"
+	eventReasonCreatedService     = "CreatedService"
+	eventReasonUpdatedService     = "UpdatedService"
+	eventReasonReady              = "Ready"
+	eventReasonPaused             = "Paused"
+	eventReasonResumed            = "Resumed"
+)
+
 func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
@@ -97,6 +115,12 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	if annotations.IsPaused(stargate) {
+		return r.reconcilePaused(ctx, stargate, logger)
+	} else if err := r.checkResumed(ctx, stargate, logger); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Fetch the target CassandraDatacenter resource
 	actualDc := &cassdcapi.CassandraDatacenter{}
 	dcKey := client.ObjectKey{Namespace: req.Namespace, Name: stargate.Spec.DatacenterRef.Name}
@@ -188,6 +212,7 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			logger.Error(err, "Failed to create ManagementApiFacade")
 			return ctrl.Result{}, err
 		} else if err = stargateutil.ReconcileAuthKeyspace(managementApi, cassandra.ComputeReplication(3, actualDc), logger); err != nil {
+			r.Recorder.Eventf(stargate, corev1.EventTypeWarning, eventReasonAuthKeyspaceFailed, "Failed to reconcile the Stargate auth keyspace: %v", err)
 			return ctrl.Result{}, err
 		}
 	}
@@ -234,6 +259,7 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 				return ctrl.Result{}, err
 			} else {
 				logger.Info("Stargate Deployment deleted successfully", "Deployment", deploymentKey)
+				r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonDeletedDeployment, "Deleted Deployment %s", deploymentKey.Name)
 				return ctrl.Result{RequeueAfter: r.ReconcilerConfig.LongDelay}, nil
 			}
 		} else {
@@ -249,9 +275,11 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 					return ctrl.Result{}, err
 				} else if err := r.Update(ctx, &actualDeployment); err != nil {
 					logger.Error(err, "Failed to update Stargate Deployment", "Deployment", deploymentKey)
+					r.Recorder.Eventf(stargate, corev1.EventTypeWarning, eventReasonDeploymentFailed, "Failed to update Deployment %s: %v", deploymentKey.Name, err)
 					return ctrl.Result{}, err
 				} else {
 					logger.Info("Stargate Deployment updated successfully", "Deployment", deploymentKey)
+					r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonUpdatedDeployment, "Updated Deployment %s", deploymentKey.Name)
 					return ctrl.Result{RequeueAfter: r.ReconcilerConfig.LongDelay}, nil
 				}
 			}
@@ -278,10 +306,12 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 				return ctrl.Result{Requeue: true}, nil
 			} else {
 				logger.Error(err, "Failed to create new Stargate Deployment", "Deployment", deploymentKey)
+				r.Recorder.Eventf(stargate, corev1.EventTypeWarning, eventReasonDeploymentFailed, "Failed to create Deployment %s: %v", deploymentKey.Name, err)
 				return ctrl.Result{}, err
 			}
 		} else {
 			logger.Info("Stargate Deployment created successfully", "Deployment", deploymentKey)
+			r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonCreatedDeployment, "Created Deployment %s", deploymentKey.Name)
 			return ctrl.Result{RequeueAfter: r.ReconcilerConfig.LongDelay}, nil
 		}
 	}
@@ -348,6 +378,7 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 				}
 			} else {
 				logger.Info("Stargate Service created successfully", "Service", serviceKey)
+				r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonCreatedService, "Created Service %s", serviceKey.Name)
 				return ctrl.Result{RequeueAfter: r.ReconcilerConfig.DefaultDelay}, nil
 			}
 		} else {
@@ -371,6 +402,7 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			return ctrl.Result{}, err
 		} else {
 			logger.Info("Stargate Service updated successfully", "Service", serviceKey)
+			r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonUpdatedService, "Updated Service %s", serviceKey.Name)
 			return ctrl.Result{RequeueAfter: r.ReconcilerConfig.LongDelay}, nil
 		}
 	}
@@ -389,6 +421,7 @@ func (r *StargateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			logger.Error(err, "Failed to update Stargate status", "Stargate", req.NamespacedName)
 			return ctrl.Result{}, err
 		}
+		r.Recorder.Eventf(stargate, corev1.EventTypeNormal, eventReasonReady, "All %d Stargate replicas are ready", stargate.Spec.Size)
 	}
 
 	logger.Info("Stargate successfully reconciled", "Stargate", req.NamespacedName)
@@ -468,7 +501,7 @@ func (r *StargateReconciler) reconcileStargateConfigMap(
 // SetupWithManager sets up the controller with the Manager.
 func (r *StargateReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&api.Stargate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&api.Stargate{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, annotations.ValueChangedPredicate{Keys: []string{k8ssandraapi.PausedAnnotation, k8ssandraapi.CredentialsRevisionAnnotation}}))).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
 		Complete(r)
@@ -46,6 +46,7 @@ func TestStargate(t *testing.T) {
 			Client:           mgr.GetClient(),
 			Scheme:           scheme.Scheme,
 			ManagementApi:    managementApiFactory,
+			Recorder:         mgr.GetEventRecorderFor("stargate-controller"),
 		}).SetupWithManager(mgr)
 		return err
 	})
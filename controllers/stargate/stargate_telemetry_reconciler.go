@@ -53,12 +53,12 @@ func (r *StargateReconciler) reconcileStargateTelemetry(
 	// If Stargate is attached
 	// Determine if we want a cleanup or a resource update.
 	switch {
-	case thisStargate.Spec.Telemetry == nil || thisStargate.Spec.Telemetry.Prometheus == nil:
-		logger.Info("Telemetry not present for Stargate, will delete resources", "TelemetrySpec", thisStargate.Spec.Telemetry)
+	case thisStargate.Spec.Telemetry == nil || thisStargate.Spec.Telemetry.Prometheus == nil || !thisStargate.Spec.Telemetry.Prometheus.Enabled:
+		logger.Info("Telemetry not enabled for Stargate, will delete resources", "TelemetrySpec", thisStargate.Spec.Telemetry)
 		if err := cfg.CleanupResources(ctx, remoteClient); err != nil {
 			return ctrl.Result{}, err
 		}
-	case thisStargate.Spec.Telemetry.Prometheus.Enabled:
+	default:
 		logger.Info("Prometheus config found", "TelemetrySpec", thisStargate.Spec.Telemetry)
 		desiredSM, err := cfg.NewStargateServiceMonitor()
 		if err != nil {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	cassctl "github.com/k8ssandra/cass-operator/apis/control/v1alpha1"
@@ -33,6 +34,7 @@ import (
 	"github.com/k8ssandra/k8ssandra-operator/pkg/config"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/medusa"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/reaper"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/storageversion"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -49,6 +51,7 @@ import (
 
 	configapi "github.com/k8ssandra/k8ssandra-operator/apis/config/v1beta1"
 	k8ssandraiov1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	k8ssandraiov1alpha2 "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha2"
 	medusav1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	reaperapi "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
 	replicationapi "github.com/k8ssandra/k8ssandra-operator/apis/replication/v1alpha1"
@@ -71,6 +74,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(k8ssandraiov1alpha1.AddToScheme(scheme))
+	utilruntime.Must(k8ssandraiov1alpha2.AddToScheme(scheme))
 	utilruntime.Must(cassdcapi.AddToScheme(scheme))
 	utilruntime.Must(cassctl.AddToScheme(scheme))
 	utilruntime.Must(replicationapi.AddToScheme(scheme))
@@ -86,11 +90,15 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var backupSyncInterval time.Duration
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&backupSyncInterval, "backup-sync-interval", medusactrl.DefaultBackupSyncInterval,
+		"How often the backups in the storage bucket of Medusa are synchronized as CassandraBackups. "+
+			"Set it to 0 to disable the synchronization.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -168,6 +176,7 @@ func main() {
 			Scheme:           mgr.GetScheme(),
 			ClientCache:      clientCache,
 			ManagementApi:    cassandra.NewManagementApiFactory(),
+			Recorder:         mgr.GetEventRecorderFor("k8ssandracluster-controller"),
 		}).SetupWithManager(mgr, additionalClusters); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "K8ssandraCluster")
 			os.Exit(1)
@@ -176,11 +185,69 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "K8ssandraCluster")
 			os.Exit(1)
 		}
+		if err = mgr.Add(&storageversion.Migrator{
+			Client:        uncachedClient,
+			Namespaces:    strings.Split(watchNamespace, ","),
+			RetryInterval: 30 * time.Second,
+			Logger:        ctrl.Log.WithName("storageversion"),
+		}); err != nil {
+			setupLog.Error(err, "unable to add the storage version migrator")
+			os.Exit(1)
+		}
+
+		if err = (&k8ssandractrl.CassandraKeyspaceReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			ClientCache:      clientCache,
+			ManagementApi:    cassandra.NewManagementApiFactory(),
+			Recorder:         mgr.GetEventRecorderFor("cassandrakeyspace-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CassandraKeyspace")
+			os.Exit(1)
+		}
+
+		if err = (&k8ssandractrl.CassandraRoleReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			ClientCache:      clientCache,
+			ManagementApi:    cassandra.NewManagementApiFactory(),
+			Recorder:         mgr.GetEventRecorderFor("cassandrarole-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CassandraRole")
+			os.Exit(1)
+		}
+
+		if err = (&k8ssandractrl.CassandraGrantReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			ClientCache:      clientCache,
+			ManagementApi:    cassandra.NewManagementApiFactory(),
+			Recorder:         mgr.GetEventRecorderFor("cassandragrant-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CassandraGrant")
+			os.Exit(1)
+		}
+
+		if err = (&medusactrl.CassandraClusterBackupReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			ClientCache:      clientCache,
+			Recorder:         mgr.GetEventRecorderFor("cassandraclusterbackup-controller"),
+			ClientFactory:    &medusa.DefaultFactory{},
+		}).SetupWithManager(mgr, additionalClusters); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CassandraClusterBackup")
+			os.Exit(1)
+		}
 
 		if err = (&replicationctrl.SecretSyncController{
 			ReconcilerConfig: reconcilerConfig,
 			ClientCache:      clientCache,
 			WatchNamespaces:  []string{watchNamespace},
+			Recorder:         mgr.GetEventRecorderFor("secretsync-controller"),
 		}).SetupWithManager(mgr, additionalClusters); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "SecretSync")
 			os.Exit(1)
@@ -192,6 +259,7 @@ func main() {
 		Client:           mgr.GetClient(),
 		Scheme:           mgr.GetScheme(),
 		ManagementApi:    cassandra.NewManagementApiFactory(),
+		Recorder:         mgr.GetEventRecorderFor("stargate-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Stargate")
 		os.Exit(1)
@@ -202,6 +270,7 @@ func main() {
 		Client:           mgr.GetClient(),
 		Scheme:           mgr.GetScheme(),
 		NewManager:       reaper.NewManager,
+		Recorder:         mgr.GetEventRecorderFor("reaper-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Reaper")
 		os.Exit(1)
@@ -213,6 +282,7 @@ func main() {
 		Client:           mgr.GetClient(),
 		Scheme:           mgr.GetScheme(),
 		ClientFactory:    &medusa.DefaultFactory{},
+		Recorder:         mgr.GetEventRecorderFor("cassandrabackup-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CassandraBackup")
 		os.Exit(1)
@@ -221,10 +291,33 @@ func main() {
 		ReconcilerConfig: reconcilerConfig,
 		Client:           mgr.GetClient(),
 		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorderFor("cassandrarestore-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CassandraRestore")
 		os.Exit(1)
 	}
+	if err = (&medusactrl.MedusaBackupScheduleReconciler{
+		ReconcilerConfig: reconcilerConfig,
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		Recorder:         mgr.GetEventRecorderFor("medusabackupschedule-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MedusaBackupSchedule")
+		os.Exit(1)
+	}
+	if backupSyncInterval > 0 {
+		if err = (&medusactrl.BackupSyncReconciler{
+			ReconcilerConfig: reconcilerConfig,
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			ClientFactory:    &medusa.DefaultFactory{},
+			Recorder:         mgr.GetEventRecorderFor("medusabackupsync-controller"),
+			SyncInterval:     backupSyncInterval,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MedusaBackupSync")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -0,0 +1,38 @@
+package annotations
+
+import (
+	"testing"
+
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestValueChangedPredicate(t *testing.T) {
+	running := &corev1.ConfigMap{}
+	paused := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{k8ssandraapi.PausedAnnotation: "true"}}}
+	resumed := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{k8ssandraapi.PausedAnnotation: "false"}}}
+
+	assert.False(t, IsPaused(running))
+	assert.True(t, IsPaused(paused))
+	assert.False(t, IsPaused(resumed))
+
+	p := ValueChangedPredicate{Keys: []string{k8ssandraapi.DryRunAnnotation, k8ssandraapi.PausedAnnotation}}
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: running, ObjectNew: paused}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: paused, ObjectNew: resumed}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: running, ObjectNew: resumed}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: paused, ObjectNew: paused.DeepCopy()}))
+}
+
+func TestKeepPaused(t *testing.T) {
+	paused := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{k8ssandraapi.PausedAnnotation: "true"}}}
+	desired := &corev1.ConfigMap{}
+	KeepPaused(paused, desired)
+	assert.True(t, IsPaused(desired))
+
+	desired = &corev1.ConfigMap{}
+	KeepPaused(&corev1.ConfigMap{}, desired)
+	assert.Nil(t, desired.Annotations)
+}
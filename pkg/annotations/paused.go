@@ -0,0 +1,18 @@
+package annotations
+
+import (
+	k8ssandraapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+)
+
+// IsPaused returns true if the reconciliation of obj was paused with the PausedAnnotation.
+func IsPaused(obj Annotated) bool {
+	return HasAnnotationWithValue(obj, k8ssandraapi.PausedAnnotation, "true")
+}
+
+// KeepPaused sets the PausedAnnotation on desired if actual is paused. It is used when actual is about to be replaced
+// with desired, so that the annotation a user set on an object managed by the operator is not lost.
+func KeepPaused(actual, desired Annotated) {
+	if IsPaused(actual) {
+		AddAnnotation(desired, k8ssandraapi.PausedAnnotation, "true")
+	}
+}
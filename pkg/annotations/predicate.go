@@ -0,0 +1,26 @@
+package annotations
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ValueChangedPredicate triggers a reconciliation when the value of one of the Keys annotations changes. It is meant
+// to be combined with predicate.GenerationChangedPredicate, which ignores metadata changes, for the annotations that
+// change how an object is reconciled.
+type ValueChangedPredicate struct {
+	predicate.Funcs
+	Keys []string
+}
+
+func (p ValueChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	for _, key := range p.Keys {
+		if GetAnnotation(e.ObjectOld, key) != GetAnnotation(e.ObjectNew, key) {
+			return true
+		}
+	}
+	return false
+}
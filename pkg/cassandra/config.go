@@ -69,6 +69,14 @@ func isCassandra4(version string) bool {
 	return strings.HasPrefix(version, "4.")
 }
 
+func isCassandra40(version string) bool {
+	return strings.HasPrefix(version, "4.0.")
+}
+
+func isCassandra41(version string) bool {
+	return strings.HasPrefix(version, "4.1.")
+}
+
 func (c config) MarshalJSON() ([]byte, error) {
 	cassConfig := make(map[string]interface{})
 
@@ -229,7 +237,7 @@ func validateConfig(config *CassYamlIR) error {
 		}
 	}
 
-	if config.CassandraYaml.CommitlogSyncPeriodInMs != nil && config.CassandraYaml.CommitlogSyncBatchWindowInMs != nil {
+	if (config.CassandraYaml.CommitlogSyncPeriodInMs != nil || config.CassandraYaml.CommitlogSyncPeriod != nil) && config.CassandraYaml.CommitlogSyncBatchWindowInMs != nil {
 		return fmt.Errorf("CommitlogSyncPeriodInMs and CommitlogSyncBatchWindowInMs are mutually exclusive")
 	}
 	return nil
@@ -322,8 +330,9 @@ func filterConfigForVersion(cassandraVersion string, cassandraYamlIR *CassYamlIR
 		cassandraYamlIR.UseDeterministicTableId = nil
 		cassandraYamlIR.UseOffheapMerkleTrees = nil
 		cassandraYamlIR.ValidationPreviewPurgeHeadStartInSec = nil
+		filterCassandra41Settings(cassandraYamlIR)
 	}
-	if isCassandra4(cassandraVersion) {
+	if isCassandra40(cassandraVersion) {
 		cassandraYamlIR.AuthReadConsistencyLevel = nil
 		cassandraYamlIR.AuthWriteConsistencyLevel = nil
 		cassandraYamlIR.AutoHintsCleanupEnabled = nil
@@ -362,6 +371,137 @@ func filterConfigForVersion(cassandraVersion string, cassandraYamlIR *CassYamlIR
 		cassandraYamlIR.TrackWarnings = nil
 		cassandraYamlIR.TraverseAuthFromRoot = nil
 		cassandraYamlIR.UseDeterministicTableId = nil
+		filterCassandra41Settings(cassandraYamlIR)
+	}
+	if isCassandra41(cassandraVersion) {
+		cassandraYamlIR.IndexInterval = nil
+		cassandraYamlIR.InternodeRecvBuffSizeInBytes = nil
+		cassandraYamlIR.InternodeSendBuffSizeInBytes = nil
+		cassandraYamlIR.OtcBacklogExpirationIntervalMs = nil
+		cassandraYamlIR.RequestScheduler = nil
+		cassandraYamlIR.RequestSchedulerId = nil
+		cassandraYamlIR.RequestSchedulerOptions = nil
+		cassandraYamlIR.StartRpc = nil
+		cassandraYamlIR.StreamingSocketTimeoutInMs = nil
+		cassandraYamlIR.ThriftFramedTransportSizeInMb = nil
+		cassandraYamlIR.ThriftMaxMessageLengthInMb = nil
+		cassandraYamlIR.ThriftPreparedStatementsCacheSizeMb = nil
+		renameSettingsForCassandra41(cassandraYamlIR)
+	}
+}
+
+// filterCassandra41Settings removes the settings that Cassandra 4.1 introduced to replace older ones.
+func filterCassandra41Settings(cassandraYamlIR *CassYamlIR) {
+	cassandraYamlIR.BatchSizeFailThreshold = nil
+	cassandraYamlIR.BatchSizeWarnThreshold = nil
+	cassandraYamlIR.CasContentionTimeout = nil
+	cassandraYamlIR.ColumnIndexSize = nil
+	cassandraYamlIR.CommitlogSegmentSize = nil
+	cassandraYamlIR.CommitlogSyncGroupWindow = nil
+	cassandraYamlIR.CommitlogSyncPeriod = nil
+	cassandraYamlIR.CommitlogTotalSpace = nil
+	cassandraYamlIR.CompactionThroughput = nil
+	cassandraYamlIR.CounterCacheSize = nil
+	cassandraYamlIR.CounterWriteRequestTimeout = nil
+	cassandraYamlIR.CredentialsUpdateInterval = nil
+	cassandraYamlIR.CredentialsValidity = nil
+	cassandraYamlIR.DropCompactStorageEnabled = nil
+	cassandraYamlIR.FileCacheSize = nil
+	cassandraYamlIR.GcLogThreshold = nil
+	cassandraYamlIR.GcWarnThreshold = nil
+	cassandraYamlIR.HintedHandoffThrottle = nil
+	cassandraYamlIR.HintsFlushPeriod = nil
+	cassandraYamlIR.KeyCacheSize = nil
+	cassandraYamlIR.MaterializedViewsEnabled = nil
+	cassandraYamlIR.MaxHintWindow = nil
+	cassandraYamlIR.MaxHintsFileSize = nil
+	cassandraYamlIR.MemtableHeapSpace = nil
+	cassandraYamlIR.MemtableOffheapSpace = nil
+	cassandraYamlIR.NativeTransportIdleTimeout = nil
+	cassandraYamlIR.NativeTransportMaxFrameSize = nil
+	cassandraYamlIR.PermissionsUpdateInterval = nil
+	cassandraYamlIR.PermissionsValidity = nil
+	cassandraYamlIR.RangeRequestTimeout = nil
+	cassandraYamlIR.ReadRequestTimeout = nil
+	cassandraYamlIR.RequestTimeout = nil
+	cassandraYamlIR.RolesUpdateInterval = nil
+	cassandraYamlIR.RolesValidity = nil
+	cassandraYamlIR.RowCacheSize = nil
+	cassandraYamlIR.SasiIndexesEnabled = nil
+	cassandraYamlIR.ScriptedUserDefinedFunctionsEnabled = nil
+	cassandraYamlIR.SlowQueryLogTimeout = nil
+	cassandraYamlIR.TransientReplicationEnabled = nil
+	cassandraYamlIR.TruncateRequestTimeout = nil
+	cassandraYamlIR.UserDefinedFunctionsEnabled = nil
+	cassandraYamlIR.UserDefinedFunctionsThreadsEnabled = nil
+	cassandraYamlIR.WriteRequestTimeout = nil
+}
+
+// renameSettingsForCassandra41 converts the settings that Cassandra 4.1 deprecated in favor of new names, and of values
+// with an explicit unit: read_request_timeout_in_ms: 5000 becomes read_request_timeout: 5000ms for example. When both
+// the old and the new setting are set, the new one wins.
+func renameSettingsForCassandra41(cassandraYamlIR *CassYamlIR) {
+	replaceIntSetting(&cassandraYamlIR.BatchSizeFailThresholdInKb, &cassandraYamlIR.BatchSizeFailThreshold, "KiB")
+	replaceIntSetting(&cassandraYamlIR.BatchSizeWarnThresholdInKb, &cassandraYamlIR.BatchSizeWarnThreshold, "KiB")
+	replaceIntSetting(&cassandraYamlIR.CasContentionTimeoutInMs, &cassandraYamlIR.CasContentionTimeout, "ms")
+	replaceIntSetting(&cassandraYamlIR.ColumnIndexSizeInKb, &cassandraYamlIR.ColumnIndexSize, "KiB")
+	replaceIntSetting(&cassandraYamlIR.CommitlogSegmentSizeInMb, &cassandraYamlIR.CommitlogSegmentSize, "MiB")
+	replaceIntSetting(&cassandraYamlIR.CommitlogSyncGroupWindowInMs, &cassandraYamlIR.CommitlogSyncGroupWindow, "ms")
+	replaceIntSetting(&cassandraYamlIR.CommitlogSyncPeriodInMs, &cassandraYamlIR.CommitlogSyncPeriod, "ms")
+	replaceIntSetting(&cassandraYamlIR.CommitlogTotalSpaceInMb, &cassandraYamlIR.CommitlogTotalSpace, "MiB")
+	replaceIntSetting(&cassandraYamlIR.CompactionThroughputMbPerSec, &cassandraYamlIR.CompactionThroughput, "MiB/s")
+	replaceIntSetting(&cassandraYamlIR.CounterCacheSizeInMb, &cassandraYamlIR.CounterCacheSize, "MiB")
+	replaceIntSetting(&cassandraYamlIR.CounterWriteRequestTimeoutInMs, &cassandraYamlIR.CounterWriteRequestTimeout, "ms")
+	replaceIntSetting(&cassandraYamlIR.CredentialsUpdateIntervalInMs, &cassandraYamlIR.CredentialsUpdateInterval, "ms")
+	replaceIntSetting(&cassandraYamlIR.CredentialsValidityInMs, &cassandraYamlIR.CredentialsValidity, "ms")
+	replaceBoolSetting(&cassandraYamlIR.EnableDropCompactStorage, &cassandraYamlIR.DropCompactStorageEnabled)
+	replaceIntSetting(&cassandraYamlIR.FileCacheSizeInMb, &cassandraYamlIR.FileCacheSize, "MiB")
+	replaceIntSetting(&cassandraYamlIR.GcLogThresholdInMs, &cassandraYamlIR.GcLogThreshold, "ms")
+	replaceIntSetting(&cassandraYamlIR.GcWarnThresholdInMs, &cassandraYamlIR.GcWarnThreshold, "ms")
+	replaceIntSetting(&cassandraYamlIR.HintedHandoffThrottleInKb, &cassandraYamlIR.HintedHandoffThrottle, "KiB")
+	replaceIntSetting(&cassandraYamlIR.HintsFlushPeriodInMs, &cassandraYamlIR.HintsFlushPeriod, "ms")
+	replaceIntSetting(&cassandraYamlIR.KeyCacheSizeInMb, &cassandraYamlIR.KeyCacheSize, "MiB")
+	replaceBoolSetting(&cassandraYamlIR.EnableMaterializedViews, &cassandraYamlIR.MaterializedViewsEnabled)
+	replaceIntSetting(&cassandraYamlIR.MaxHintWindowInMs, &cassandraYamlIR.MaxHintWindow, "ms")
+	replaceIntSetting(&cassandraYamlIR.MaxHintsFileSizeInMb, &cassandraYamlIR.MaxHintsFileSize, "MiB")
+	replaceIntSetting(&cassandraYamlIR.MemtableHeapSpaceInMb, &cassandraYamlIR.MemtableHeapSpace, "MiB")
+	replaceIntSetting(&cassandraYamlIR.MemtableOffheapSpaceInMb, &cassandraYamlIR.MemtableOffheapSpace, "MiB")
+	replaceIntSetting(&cassandraYamlIR.NativeTransportIdleTimeoutInMs, &cassandraYamlIR.NativeTransportIdleTimeout, "ms")
+	replaceIntSetting(&cassandraYamlIR.NativeTransportMaxFrameSizeInMb, &cassandraYamlIR.NativeTransportMaxFrameSize, "MiB")
+	replaceIntSetting(&cassandraYamlIR.PermissionsUpdateIntervalInMs, &cassandraYamlIR.PermissionsUpdateInterval, "ms")
+	replaceIntSetting(&cassandraYamlIR.PermissionsValidityInMs, &cassandraYamlIR.PermissionsValidity, "ms")
+	replaceIntSetting(&cassandraYamlIR.RangeRequestTimeoutInMs, &cassandraYamlIR.RangeRequestTimeout, "ms")
+	replaceIntSetting(&cassandraYamlIR.ReadRequestTimeoutInMs, &cassandraYamlIR.ReadRequestTimeout, "ms")
+	replaceIntSetting(&cassandraYamlIR.RequestTimeoutInMs, &cassandraYamlIR.RequestTimeout, "ms")
+	replaceIntSetting(&cassandraYamlIR.RolesUpdateIntervalInMs, &cassandraYamlIR.RolesUpdateInterval, "ms")
+	replaceIntSetting(&cassandraYamlIR.RolesValidityInMs, &cassandraYamlIR.RolesValidity, "ms")
+	replaceIntSetting(&cassandraYamlIR.RowCacheSizeInMb, &cassandraYamlIR.RowCacheSize, "MiB")
+	replaceBoolSetting(&cassandraYamlIR.EnableSasiIndexes, &cassandraYamlIR.SasiIndexesEnabled)
+	replaceBoolSetting(&cassandraYamlIR.EnableScriptedUserDefinedFunctions, &cassandraYamlIR.ScriptedUserDefinedFunctionsEnabled)
+	replaceIntSetting(&cassandraYamlIR.SlowQueryLogTimeoutInMs, &cassandraYamlIR.SlowQueryLogTimeout, "ms")
+	replaceBoolSetting(&cassandraYamlIR.EnableTransientReplication, &cassandraYamlIR.TransientReplicationEnabled)
+	replaceIntSetting(&cassandraYamlIR.TruncateRequestTimeoutInMs, &cassandraYamlIR.TruncateRequestTimeout, "ms")
+	replaceBoolSetting(&cassandraYamlIR.EnableUserDefinedFunctions, &cassandraYamlIR.UserDefinedFunctionsEnabled)
+	replaceBoolSetting(&cassandraYamlIR.EnableUserDefinedFunctionsThreads, &cassandraYamlIR.UserDefinedFunctionsThreadsEnabled)
+	replaceIntSetting(&cassandraYamlIR.WriteRequestTimeoutInMs, &cassandraYamlIR.WriteRequestTimeout, "ms")
+}
+
+func replaceIntSetting(old **int, replacement **string, unit string) {
+	if *old != nil {
+		if *replacement == nil {
+			value := fmt.Sprintf("%d%s", **old, unit)
+			*replacement = &value
+		}
+		*old = nil
+	}
+}
+
+func replaceBoolSetting(old **bool, replacement **bool) {
+	if *old != nil {
+		if *replacement == nil {
+			*replacement = *old
+		}
+		*old = nil
 	}
 }
 
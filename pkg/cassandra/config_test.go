@@ -1352,6 +1352,154 @@ func TestCreateJsonConfig(t *testing.T) {
               }
             }`,
 		},
+		{
+			name: "[4.1.0] heap size",
+			config: DatacenterConfig{
+				ServerVersion: "4.1.0",
+				CassandraConfig: api.CassandraConfig{
+					JvmOptions: api.JvmOptions{
+						HeapSize: &heapSize,
+					},
+				},
+			},
+			want: `{
+              "cassandra-yaml": {
+                "num_tokens": 16
+              },
+              "jvm-server-options": {
+                "initial_heap_size": 1073741824,
+                "max_heap_size": 1073741824
+              }
+            }`,
+		},
+		{
+			name: "[4.1.0] deprecated settings are renamed",
+			config: DatacenterConfig{
+				ServerVersion: "4.1.0",
+				CassandraConfig: api.CassandraConfig{
+					CassandraYaml: api.CassandraYaml{
+						BatchSizeWarnThresholdInKb:   pointer.Int(10),
+						CommitlogSegmentSizeInMb:     pointer.Int(64),
+						CommitlogSyncPeriodInMs:      pointer.Int(10000),
+						CompactionThroughputMbPerSec: pointer.Int(128),
+						EnableMaterializedViews:      pointer.Bool(true),
+						EnableUserDefinedFunctions:   pointer.Bool(false),
+						KeyCacheSizeInMb:             pointer.Int(100),
+						ReadRequestTimeoutInMs:       pointer.Int(5000),
+						WriteRequestTimeoutInMs:      pointer.Int(2000),
+					},
+				},
+			},
+			want: `{
+              "cassandra-yaml": {
+                "num_tokens": 16,
+                "batch_size_warn_threshold": "10KiB",
+                "commitlog_segment_size": "64MiB",
+                "commitlog_sync_period": "10000ms",
+                "compaction_throughput": "128MiB/s",
+                "materialized_views_enabled": true,
+                "user_defined_functions_enabled": false,
+                "key_cache_size": "100MiB",
+                "read_request_timeout": "5000ms",
+                "write_request_timeout": "2000ms"
+              }
+            }`,
+		},
+		{
+			name: "[4.1.0] new settings take precedence over deprecated ones",
+			config: DatacenterConfig{
+				ServerVersion: "4.1.0",
+				CassandraConfig: api.CassandraConfig{
+					CassandraYaml: api.CassandraYaml{
+						ReadRequestTimeoutInMs:  pointer.Int(5000),
+						ReadRequestTimeout:      pointer.String("10s"),
+						EnableSasiIndexes:       pointer.Bool(true),
+						SasiIndexesEnabled:      pointer.Bool(false),
+						CommitlogTotalSpace:     pointer.String("8GiB"),
+						CommitlogTotalSpaceInMb: pointer.Int(1024),
+					},
+				},
+			},
+			want: `{
+              "cassandra-yaml": {
+                "num_tokens": 16,
+                "read_request_timeout": "10s",
+                "sasi_indexes_enabled": false,
+                "commitlog_total_space": "8GiB"
+              }
+            }`,
+		},
+		{
+			name: "[4.1.0] settings introduced and removed in 4.1",
+			config: DatacenterConfig{
+				ServerVersion: "4.1.0",
+				CassandraConfig: api.CassandraConfig{
+					CassandraYaml: api.CassandraYaml{
+						AuthReadConsistencyLevel:      pointer.String("LOCAL_QUORUM"),
+						EnablePartitionDenylist:       pointer.Bool(true),
+						MinimumKeyspaceRf:             pointer.Int(2),
+						TraverseAuthFromRoot:          pointer.Bool(true),
+						ConcurrentValidations:         pointer.Int(4),
+						IndexInterval:                 pointer.Int(128),
+						RequestScheduler:              pointer.String("org.apache.cassandra.scheduler.NoScheduler"),
+						StreamingSocketTimeoutInMs:    pointer.Int(86400000),
+						ThriftFramedTransportSizeInMb: pointer.Int(15),
+					},
+				},
+			},
+			want: `{
+              "cassandra-yaml": {
+                "num_tokens": 16,
+                "auth_read_consistency_level": "LOCAL_QUORUM",
+                "enable_partition_denylist": true,
+                "minimum_keyspace_rf": 2,
+                "traverse_auth_from_root": true,
+                "concurrent_validations": 4
+              }
+            }`,
+		},
+		{
+			name: "[4.0.0] settings introduced in 4.1 are removed",
+			config: DatacenterConfig{
+				ServerVersion: "4.0.0",
+				CassandraConfig: api.CassandraConfig{
+					CassandraYaml: api.CassandraYaml{
+						AuthReadConsistencyLevel: pointer.String("LOCAL_QUORUM"),
+						ReadRequestTimeout:       pointer.String("10s"),
+						ReadRequestTimeoutInMs:   pointer.Int(5000),
+						SasiIndexesEnabled:       pointer.Bool(false),
+						EnableSasiIndexes:        pointer.Bool(true),
+					},
+				},
+			},
+			want: `{
+              "cassandra-yaml": {
+                "num_tokens": 16,
+                "read_request_timeout_in_ms": 5000,
+                "enable_sasi_indexes": true
+              }
+            }`,
+		},
+		{
+			name: "[3.11.11] settings introduced in 4.1 are removed",
+			config: DatacenterConfig{
+				ServerVersion: "3.11.11",
+				CassandraConfig: api.CassandraConfig{
+					CassandraYaml: api.CassandraYaml{
+						CommitlogSegmentSize:     pointer.String("64MiB"),
+						CommitlogSegmentSizeInMb: pointer.Int(32),
+						MaterializedViewsEnabled: pointer.Bool(true),
+					},
+				},
+			},
+			want: `{
+              "cassandra-yaml": {
+                "num_tokens": 256,
+                "start_rpc": false,
+                "commitlog_segment_size_in_mb": 32
+              }
+            }`,
+		},
 	}
 
 	for _, tc := range tests {
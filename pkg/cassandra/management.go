@@ -2,10 +2,14 @@ package cassandra
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/errors"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
+	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
@@ -94,9 +98,61 @@ type ManagementApiFacade interface {
 	// alters it to match the desired replication.
 	EnsureKeyspaceReplication(keyspaceName string, replication map[string]int) error
 
+	// ListRoles calls the management API "GET /ops/auth/role" endpoint to retrieve the names of all roles.
+	ListRoles() ([]string, error)
+
+	// CreateRole calls the management API "POST /ops/auth/role" endpoint to create a new role.
+	CreateRole(role *RoleDefinition) error
+
+	// AlterRole calls the management API "PUT /ops/auth/role" endpoint to update the password and the login and
+	// superuser flags of an existing role.
+	AlterRole(role *RoleDefinition) error
+
+	// DropRole calls the management API "DELETE /ops/auth/role" endpoint to drop the given role. Dropping a role that
+	// does not exist is a no-op.
+	DropRole(roleName string) error
+
+	// GrantPermissions calls the management API "POST /ops/auth/permissions" endpoint to grant permissions to a role.
+	GrantPermissions(permissions *PermissionsDefinition) error
+
+	// RevokePermissions calls the management API "DELETE /ops/auth/permissions" endpoint to revoke permissions from a
+	// role.
+	RevokePermissions(permissions *PermissionsDefinition) error
+
+	// AlterKeyspaceDurableWrites calls the management API "POST /ops/keyspace/alter" endpoint to set the
+	// durable_writes option of the given keyspace. The replication is sent along since the endpoint requires it.
+	AlterKeyspaceDurableWrites(keyspaceName string, replication map[string]int, durableWrites bool) error
+
 	// GetSchemaVersions list all of the schema versions know to this node. The map keys are schema version UUIDs.
 	// The values are list of node IPs.
 	GetSchemaVersions() (map[string][]string, error)
+
+	// HasSchemaAgreement returns true if all the reachable nodes of the cluster report the same schema version.
+	HasSchemaAgreement() (bool, error)
+
+	// UpgradeSSTables calls the management API "POST /api/v1/ops/tables/sstables/upgrade" endpoint to rewrite, in
+	// the background, the SSTables of the given pod that are not in the current format. It returns the id of the job.
+	UpgradeSSTables(pod *corev1.Pod) (string, error)
+
+	// GetJobDetails calls the management API "GET /api/v0/ops/executor/job" endpoint to retrieve the status of a job
+	// started on the given pod. The status is empty if the pod does not know the job, e.g. after a restart.
+	GetJobDetails(pod *corev1.Pod, jobId string) (*httphelper.JobDetails, error)
+}
+
+// RoleDefinition describes a CQL role.
+type RoleDefinition struct {
+	Name      string
+	Password  string
+	Superuser bool
+	Login     bool
+}
+
+// PermissionsDefinition describes CQL permissions granted to a role on a keyspace, or on a table if Table is set.
+type PermissionsDefinition struct {
+	Role        string   `json:"role"`
+	Keyspace    string   `json:"keyspace_name"`
+	Table       string   `json:"table_name,omitempty"`
+	Permissions []string `json:"permissions"`
 }
 
 type defaultManagementApiFacade struct {
@@ -315,6 +371,127 @@ func (r *defaultManagementApiFacade) EnsureKeyspaceReplication(keyspaceName stri
 	}
 }
 
+func (r *defaultManagementApiFacade) ListRoles() ([]string, error) {
+	request := managementApiRequest{
+		method:   http.MethodGet,
+		endpoint: "/api/v0/ops/auth/role",
+	}
+
+	body, err := r.callOnAnyPod("list roles", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []map[string]string
+	if err := json.Unmarshal(body, &roles); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role["name"])
+	}
+	return names, nil
+}
+
+func (r *defaultManagementApiFacade) CreateRole(role *RoleDefinition) error {
+	if _, err := r.callOnAnyPod(fmt.Sprintf("create role %s", role.Name), r.newRoleRequest(http.MethodPost, role)); err != nil {
+		return err
+	}
+	r.logger.Info(fmt.Sprintf("Successfully created role %s", role.Name))
+	return nil
+}
+
+func (r *defaultManagementApiFacade) AlterRole(role *RoleDefinition) error {
+	if _, err := r.callOnAnyPod(fmt.Sprintf("alter role %s", role.Name), r.newRoleRequest(http.MethodPut, role)); err != nil {
+		return err
+	}
+	r.logger.Info(fmt.Sprintf("Successfully altered role %s", role.Name))
+	return nil
+}
+
+func (r *defaultManagementApiFacade) newRoleRequest(method string, role *RoleDefinition) managementApiRequest {
+	params := url.Values{}
+	params.Set("username", role.Name)
+	params.Set("password", role.Password)
+	params.Set("can_login", strconv.FormatBool(role.Login))
+	params.Set("is_superuser", strconv.FormatBool(role.Superuser))
+
+	return managementApiRequest{
+		method:   method,
+		endpoint: fmt.Sprintf("/api/v0/ops/auth/role?%s", params.Encode()),
+		timeout:  60 * time.Second,
+		secret:   role.Password,
+	}
+}
+
+func (r *defaultManagementApiFacade) DropRole(roleName string) error {
+	params := url.Values{}
+	params.Set("username", roleName)
+	request := managementApiRequest{
+		method:   http.MethodDelete,
+		endpoint: fmt.Sprintf("/api/v0/ops/auth/role?%s", params.Encode()),
+	}
+
+	if _, err := r.callOnAnyPod(fmt.Sprintf("drop role %s", roleName), request); err != nil {
+		return err
+	}
+	r.logger.Info(fmt.Sprintf("Successfully dropped role %s", roleName))
+	return nil
+}
+
+func (r *defaultManagementApiFacade) GrantPermissions(permissions *PermissionsDefinition) error {
+	request := managementApiRequest{
+		method:   http.MethodPost,
+		endpoint: "/api/v0/ops/auth/permissions",
+		body:     permissions,
+	}
+
+	if _, err := r.callOnAnyPod(fmt.Sprintf("grant permissions to role %s", permissions.Role), request); err != nil {
+		return err
+	}
+	r.logger.Info(fmt.Sprintf("Successfully granted permissions %v to role %s", permissions.Permissions, permissions.Role))
+	return nil
+}
+
+func (r *defaultManagementApiFacade) RevokePermissions(permissions *PermissionsDefinition) error {
+	request := managementApiRequest{
+		method:   http.MethodDelete,
+		endpoint: "/api/v0/ops/auth/permissions",
+		body:     permissions,
+	}
+
+	if _, err := r.callOnAnyPod(fmt.Sprintf("revoke permissions from role %s", permissions.Role), request); err != nil {
+		return err
+	}
+	r.logger.Info(fmt.Sprintf("Successfully revoked permissions %v from role %s", permissions.Permissions, permissions.Role))
+	return nil
+}
+
+func (r *defaultManagementApiFacade) AlterKeyspaceDurableWrites(keyspaceName string, replication map[string]int, durableWrites bool) error {
+	if agreement, err := r.HasSchemaAgreement(); err != nil {
+		return err
+	} else if !agreement {
+		return errors.NewSchemaDisagreementError(fmt.Sprintf("cannot alter keyspace %s", keyspaceName))
+	}
+
+	request := managementApiRequest{
+		method:   http.MethodPost,
+		endpoint: "/api/v0/ops/keyspace/alter",
+		body: map[string]interface{}{
+			"keyspace_name":        keyspaceName,
+			"replication_settings": r.createReplicationConfig(replication),
+			"durable_writes":       durableWrites,
+		},
+	}
+
+	if _, err := r.callOnAnyPod(fmt.Sprintf("alter keyspace %s durable writes", keyspaceName), request); err != nil {
+		return err
+	}
+	r.logger.Info(fmt.Sprintf("Successfully altered keyspace %s durable writes", keyspaceName))
+	return nil
+}
+
 func (r *defaultManagementApiFacade) GetSchemaVersions() (map[string][]string, error) {
 	pods, err := r.fetchDatacenterPods()
 	if err != nil {
@@ -360,3 +537,22 @@ func (r *defaultManagementApiFacade) HasSchemaAgreement() (bool, error) {
 
 	return len(versions) == 1, nil
 }
+
+func (r *defaultManagementApiFacade) UpgradeSSTables(pod *corev1.Pod) (string, error) {
+	request := managementApiRequest{
+		method:   http.MethodPost,
+		endpoint: "/api/v1/ops/tables/sstables/upgrade?excludeCurrentVersion=true",
+		body:     &keyspaceRequest{Jobs: 2, KeyspaceName: "ALL"},
+	}
+
+	body, err := r.callEndpoint(pod, request)
+	if err != nil {
+		r.logger.Error(err, fmt.Sprintf("Failed to CALL upgrade sstables on pod %v", pod.Name))
+		return "", err
+	}
+	return string(body), nil
+}
+
+func (r *defaultManagementApiFacade) GetJobDetails(pod *corev1.Pod, jobId string) (*httphelper.JobDetails, error) {
+	return r.nodeMgmtClient.JobDetails(pod, jobId)
+}
@@ -0,0 +1,131 @@
+package cassandra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/k8ssandra/cass-operator/pkg/httphelper"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// managementApiRequest describes a call to a management API endpoint that httphelper.NodeMgmtClient does not expose
+// yet.
+type managementApiRequest struct {
+	method   string
+	endpoint string
+	body     interface{}
+	timeout  time.Duration
+
+	// secret is a value, such as a password, that must never show up in errors or logs.
+	secret string
+}
+
+// keyspaceRequest is the body of the management API endpoints that operate on the tables of a keyspace. The
+// "ALL" keyspace name stands for all the keyspaces.
+type keyspaceRequest struct {
+	Jobs         int      `json:"jobs"`
+	KeyspaceName string   `json:"keyspace_name"`
+	Tables       []string `json:"tables,omitempty"`
+}
+
+// callOnAnyPod sends the request to the ready pods of the datacenter, one at a time, until one of them succeeds.
+func (r *defaultManagementApiFacade) callOnAnyPod(description string, request managementApiRequest) ([]byte, error) {
+	if pods, err := r.fetchDatacenterPods(); err != nil {
+		r.logger.Error(err, "Failed to fetch datacenter pods")
+		return nil, err
+	} else {
+		for _, pod := range pods {
+			if body, err := r.callEndpoint(&pod, request); err != nil {
+				r.logger.Error(err, fmt.Sprintf("Failed to CALL %s on pod %v", description, pod.Name))
+			} else {
+				return body, nil
+			}
+		}
+		return nil, fmt.Errorf("CALL %s failed on all datacenter %v pods", description, r.dc.Name)
+	}
+}
+
+// callEndpoint sends the request to the management API of the given pod, reusing the HTTP client and protocol of
+// the facade's NodeMgmtClient. The body, if any, is JSON-encoded. Errors caused by a non-2xx status code are
+// returned as *httphelper.RequestError, like the ones returned by NodeMgmtClient.
+func (r *defaultManagementApiFacade) callEndpoint(pod *corev1.Pod, request managementApiRequest) ([]byte, error) {
+	body, err := r.doCallEndpoint(pod, request)
+	if err != nil && request.secret != "" {
+		// The error could include the secret, e.g. when it is sent as a query parameter, strip it
+		strippedErrMsg := strings.ReplaceAll(err.Error(), request.secret, "******")
+		strippedErrMsg = strings.ReplaceAll(strippedErrMsg, url.QueryEscape(request.secret), "******")
+		if reqErr, ok := err.(*httphelper.RequestError); ok {
+			return nil, &httphelper.RequestError{StatusCode: reqErr.StatusCode, Err: errors.New(strippedErrMsg)}
+		}
+		return nil, errors.New(strippedErrMsg)
+	}
+	return body, err
+}
+
+func (r *defaultManagementApiFacade) doCallEndpoint(pod *corev1.Pod, request managementApiRequest) ([]byte, error) {
+	podHost, err := httphelper.BuildPodHostFromPod(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointUrl := fmt.Sprintf("%s://%s:8080%s", r.nodeMgmtClient.Protocol, podHost, request.endpoint)
+
+	var reqBody io.Reader
+	if request.body != nil {
+		if b, err := json.Marshal(request.body); err != nil {
+			return nil, err
+		} else {
+			reqBody = bytes.NewBuffer(b)
+		}
+	}
+
+	req, err := http.NewRequest(request.method, endpointUrl, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Close = true
+	if request.body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	timeout := request.timeout
+	if timeout == 0 {
+		timeout = 20 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(r.ctx, timeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	res, err := r.nodeMgmtClient.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			r.logger.Error(err, "unable to close response body")
+		}
+	}()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, &httphelper.RequestError{
+			StatusCode: res.StatusCode,
+			Err:        fmt.Errorf("incorrect status code of %d when calling endpoint %s %s", res.StatusCode, request.method, request.endpoint),
+		}
+	}
+
+	return body, nil
+}
@@ -0,0 +1,55 @@
+package cassandra
+
+import (
+	"fmt"
+	"strings"
+)
+
+// releaseSeries lists the Cassandra release series supported by the operator, in upgrade order. Upgrades can only move
+// from one series to the next one. 4.1 is not listed since the CassandraDatacenter CRD of cass-operator v1.10.0 does
+// not accept it.
+var releaseSeries = []string{"3.11", "4.0"}
+
+// getReleaseSeries returns the index in releaseSeries of the series of the given version, e.g. "4.0" for "4.0.3".
+func getReleaseSeries(version string) (int, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return -1, fmt.Errorf("invalid Cassandra version %s", version)
+	}
+	series := parts[0] + "." + parts[1]
+	for i, s := range releaseSeries {
+		if s == series {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("unsupported Cassandra version %s", version)
+}
+
+// ValidateUpgrade returns an error if a datacenter cannot be moved from fromVersion to toVersion. Patch releases can
+// be changed freely within a series, but a series can only be upgraded to the next one, e.g. 3.11 to 4.0. Downgrades
+// to a previous series are never supported since older versions cannot read the newer SSTable format.
+func ValidateUpgrade(fromVersion, toVersion string) error {
+	from, err := getReleaseSeries(fromVersion)
+	if err != nil {
+		return err
+	}
+	to, err := getReleaseSeries(toVersion)
+	if err != nil {
+		return err
+	}
+	if to < from {
+		return fmt.Errorf("downgrading from %s to %s is not supported", fromVersion, toVersion)
+	}
+	if to > from+1 {
+		return fmt.Errorf("upgrading from %s to %s is not supported, upgrade to %s.x first", fromVersion, toVersion, releaseSeries[from+1])
+	}
+	return nil
+}
+
+// RequiresSSTablesUpgrade returns true if SSTables need to be rewritten after upgrading from fromVersion to toVersion,
+// which is the case whenever the release series changes.
+func RequiresSSTablesUpgrade(fromVersion, toVersion string) bool {
+	from, fromErr := getReleaseSeries(fromVersion)
+	to, toErr := getReleaseSeries(toVersion)
+	return fromErr != nil || toErr != nil || from != to
+}
@@ -0,0 +1,41 @@
+package cassandra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUpgrade(t *testing.T) {
+	tests := []struct {
+		name        string
+		fromVersion string
+		toVersion   string
+		wantErr     bool
+	}{
+		{"patch upgrade", "4.0.1", "4.0.3", false},
+		{"patch downgrade", "4.0.3", "4.0.1", false},
+		{"3.11 to 4.0", "3.11.11", "4.0.3", false},
+		{"4.0 to 4.1", "4.0.3", "4.1.0", true},
+		{"4.0 to 3.11", "4.0.3", "3.11.11", true},
+		{"unsupported from version", "2.2.19", "3.11.11", true},
+		{"unsupported to version", "4.0.3", "5.0.0", true},
+		{"invalid version", "4", "4.0.3", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpgrade(tt.fromVersion, tt.toVersion)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequiresSSTablesUpgrade(t *testing.T) {
+	assert.False(t, RequiresSSTablesUpgrade("4.0.1", "4.0.3"))
+	assert.True(t, RequiresSSTablesUpgrade("3.11.11", "4.0.3"))
+}
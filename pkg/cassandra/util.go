@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
@@ -21,6 +22,24 @@ func DatacenterUpdatedAfter(t time.Time, dc *cassdcapi.CassandraDatacenter) bool
 	return updateCondition.LastTransitionTime.After(t)
 }
 
+// DatacenterScaledUpAfter returns true if cass-operator finished scaling up the datacenter after t.
+func DatacenterScaledUpAfter(t time.Time, dc *cassdcapi.CassandraDatacenter) bool {
+	scalingUpCondition, found := dc.GetCondition(cassdcapi.DatacenterScalingUp)
+	if !found || scalingUpCondition.Status != corev1.ConditionFalse {
+		return false
+	}
+	return scalingUpCondition.LastTransitionTime.After(t)
+}
+
+// DatacenterReplacedNodesAfter returns true if cass-operator finished replacing nodes of the datacenter after t.
+func DatacenterReplacedNodesAfter(t time.Time, dc *cassdcapi.CassandraDatacenter) bool {
+	replacingCondition, found := dc.GetCondition(cassdcapi.DatacenterReplacingNodes)
+	if !found || replacingCondition.Status != corev1.ConditionFalse {
+		return false
+	}
+	return replacingCondition.LastTransitionTime.After(t)
+}
+
 func DatacenterReady(dc *cassdcapi.CassandraDatacenter) bool {
 	return dc.GetConditionStatus(cassdcapi.DatacenterReady) == corev1.ConditionTrue && dc.Status.CassandraOperatorProgress == cassdcapi.ProgressReady
 }
@@ -93,7 +112,62 @@ func ComputeReplicationFromDatacenters(maxReplicationPerDc int, externalDatacent
 	return desiredReplication
 }
 
-const NetworkTopology = "org.apache.cassandra.locator.NetworkTopologyStrategy"
+// ComputeKeyspaceReplication computes the desired replication of a CassandraKeyspace. The datacenters should only
+// include those that are already deployed, since Cassandra 4 rejects replication settings that reference unknown
+// DCs. External datacenters are only included when the spec lists them explicitly. DCs with a replication factor of
+// 0 are omitted from the result.
+func ComputeKeyspaceReplication(spec *api.CassandraKeyspaceSpec, externalDatacenters []string, datacenters ...api.CassandraDatacenterTemplate) map[string]int {
+	defaultRf := 3
+	if spec.DefaultReplicationFactor != nil {
+		defaultRf = *spec.DefaultReplicationFactor
+	}
+
+	desiredReplication := make(map[string]int, len(datacenters))
+	for _, dcTemplate := range datacenters {
+		replicationFactor, found := spec.Replication[dcTemplate.Meta.Name]
+		if !found {
+			replicationFactor = int(math.Min(float64(defaultRf), float64(dcTemplate.Size)))
+		}
+		if replicationFactor > 0 {
+			desiredReplication[dcTemplate.Meta.Name] = replicationFactor
+		}
+	}
+	for _, dcName := range externalDatacenters {
+		if replicationFactor := spec.Replication[dcName]; replicationFactor > 0 {
+			desiredReplication[dcName] = replicationFactor
+		}
+	}
+
+	return desiredReplication
+}
+
+const (
+	NetworkTopology = "org.apache.cassandra.locator.NetworkTopologyStrategy"
+	SimpleStrategy  = "org.apache.cassandra.locator.SimpleStrategy"
+)
+
+// DatacenterReplicationFactor returns the number of replicas that the replication settings of a keyspace, as returned
+// by GetKeyspaceReplication, place in dcName. Transient replicas, e.g. "3/1", are counted. Keyspaces that use a
+// LocalStrategy, like system and system_schema, have no replicas as such.
+func DatacenterReplicationFactor(replication map[string]string, dcName string) int {
+	var rf string
+	switch replication["class"] {
+	case NetworkTopology:
+		rf = replication[dcName]
+	case SimpleStrategy:
+		rf = replication["replication_factor"]
+	default:
+		return 0
+	}
+	if i := strings.Index(rf, "/"); i >= 0 {
+		rf = rf[:i]
+	}
+	replicas, err := strconv.Atoi(rf)
+	if err != nil {
+		return 0
+	}
+	return replicas
+}
 
 func CompareReplications(actualReplication map[string]string, desiredReplication map[string]int) bool {
 	if len(actualReplication) == 0 {
@@ -6,6 +6,7 @@ import (
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	"github.com/stretchr/testify/require"
@@ -229,6 +230,42 @@ func TestComputeReplicationWithExternalDc(t *testing.T) {
 	}
 }
 
+func TestComputeKeyspaceReplication(t *testing.T) {
+	dcs := []api.CassandraDatacenterTemplate{
+		{Meta: api.EmbeddedObjectMeta{Name: "dc1"}, Size: 3},
+		{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, Size: 1},
+		{Meta: api.EmbeddedObjectMeta{Name: "dc3"}, Size: 10},
+	}
+	tests := []struct {
+		name     string
+		spec     api.CassandraKeyspaceSpec
+		expected map[string]int
+	}{
+		{"defaults", api.CassandraKeyspaceSpec{}, map[string]int{"dc1": 3, "dc2": 1, "dc3": 3}},
+		{"default rf", api.CassandraKeyspaceSpec{
+			DefaultReplicationFactor: pointer.Int(2),
+		}, map[string]int{"dc1": 2, "dc2": 1, "dc3": 2}},
+		{"explicit rf", api.CassandraKeyspaceSpec{
+			Replication: map[string]int{"dc3": 5},
+		}, map[string]int{"dc1": 3, "dc2": 1, "dc3": 5}},
+		{"excluded dc", api.CassandraKeyspaceSpec{
+			Replication: map[string]int{"dc2": 0},
+		}, map[string]int{"dc1": 3, "dc3": 3}},
+		{"external dc", api.CassandraKeyspaceSpec{
+			Replication: map[string]int{"dc4": 2},
+		}, map[string]int{"dc1": 3, "dc2": 1, "dc3": 3, "dc4": 2}},
+		{"undeployed dc", api.CassandraKeyspaceSpec{
+			Replication: map[string]int{"dc6": 3},
+		}, map[string]int{"dc1": 3, "dc2": 1, "dc3": 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ComputeKeyspaceReplication(&tt.spec, []string{"dc4", "dc5"}, dcs...)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestCompareReplications(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -253,6 +290,27 @@ func TestCompareReplications(t *testing.T) {
 	}
 }
 
+func TestDatacenterReplicationFactor(t *testing.T) {
+	tests := []struct {
+		name        string
+		replication map[string]string
+		expected    int
+	}{
+		{"nil", nil, 0},
+		{"local", map[string]string{"class": "org.apache.cassandra.locator.LocalStrategy"}, 0},
+		{"simple", map[string]string{"class": SimpleStrategy, "replication_factor": "2"}, 2},
+		{"network topology", map[string]string{"class": NetworkTopology, "dc1": "3", "dc2": "1"}, 3},
+		{"missing dc", map[string]string{"class": NetworkTopology, "dc2": "1"}, 0},
+		{"transient replicas", map[string]string{"class": NetworkTopology, "dc1": "5/2"}, 5},
+		{"invalid rf", map[string]string{"class": NetworkTopology, "dc1": "not a number"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DatacenterReplicationFactor(tt.replication, "dc1"))
+		})
+	}
+}
+
 func TestParseReplication(t *testing.T) {
 	tests := []struct {
 		name        string
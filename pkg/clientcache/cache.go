@@ -9,10 +9,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 
 	//"k8s.io/client-go/tools/clientcmd"
 
 	api "github.com/k8ssandra/k8ssandra-operator/apis/config/v1beta1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -26,16 +28,20 @@ type ClientCache struct {
 	// RemoteClients to other clusters. The string is the name of the KubeConfig item targeting
 	// another cluster.
 	remoteClients map[string]client.Client
+
+	// remoteRecorders record events on objects of other clusters, keyed like remoteClients.
+	remoteRecorders map[string]record.EventRecorder
 }
 
 func New(localClient client.Client, noCacheClient client.Client, scheme *runtime.Scheme) *ClientCache {
 
 	// Call to create new RemoteClients here?
 	return &ClientCache{
-		localClient:   localClient,
-		noCacheClient: noCacheClient,
-		scheme:        scheme,
-		remoteClients: make(map[string]client.Client),
+		localClient:     localClient,
+		noCacheClient:   noCacheClient,
+		scheme:          scheme,
+		remoteClients:   make(map[string]client.Client),
+		remoteRecorders: make(map[string]record.EventRecorder),
 	}
 }
 
@@ -48,6 +54,7 @@ func (c *ClientCache) GetRemoteClient(k8sContextName string) (client.Client, err
 	if cli, found := c.remoteClients[k8sContextName]; found {
 		return cli, nil
 	}
+	metrics.RemoteClientErrors.WithLabelValues(k8sContextName).Inc()
 	return nil, errors.New("No known client for context-name " + k8sContextName)
 }
 
@@ -61,9 +68,21 @@ func (c *ClientCache) GetRemoteClients() map[string]client.Client {
 	return c.remoteClients
 }
 
-// AddClient adds a new remoteClient with the name k8sContextName
+// AddClient adds a new remoteClient with the name k8sContextName. Errors of the client are counted in the
+// remote client errors metric.
 func (c *ClientCache) AddClient(k8sContextName string, cli client.Client) {
-	c.remoteClients[k8sContextName] = cli
+	c.remoteClients[k8sContextName] = newMetricsClient(k8sContextName, cli)
+}
+
+// GetRemoteRecorder returns the event recorder for objects of the remote cluster with name k8sContextName, or nil
+// if no such recorder is cached.
+func (c *ClientCache) GetRemoteRecorder(k8sContextName string) record.EventRecorder {
+	return c.remoteRecorders[k8sContextName]
+}
+
+// AddRecorder adds a new event recorder for the remote cluster with the name k8sContextName
+func (c *ClientCache) AddRecorder(k8sContextName string, recorder record.EventRecorder) {
+	c.remoteRecorders[k8sContextName] = recorder
 }
 
 // createClient creates a remoteClient and stores it in the cache. If already stored, returns the existing client
@@ -79,6 +98,7 @@ func (c *ClientCache) createClient(contextName string, restConfig *rest.Config)
 	}
 
 	// Store for later use and return to the caller
+	remoteClient = newMetricsClient(contextName, remoteClient)
 	c.remoteClients[contextName] = remoteClient
 	return remoteClient, nil
 
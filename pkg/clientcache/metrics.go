@@ -0,0 +1,75 @@
+package clientcache
+
+import (
+	"context"
+
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// metricsClient counts the errors returned by the API server of a remote cluster. Errors that are part of the normal
+// reconciliation flow, like a missing object or a stale resource version, are not counted.
+type metricsClient struct {
+	client.Client
+	k8sContextName string
+}
+
+func newMetricsClient(k8sContextName string, cli client.Client) client.Client {
+	if _, ok := cli.(*metricsClient); ok {
+		return cli
+	}
+	return &metricsClient{Client: cli, k8sContextName: k8sContextName}
+}
+
+func (c *metricsClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return c.observe(c.Client.Get(ctx, key, obj))
+}
+
+func (c *metricsClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.observe(c.Client.List(ctx, list, opts...))
+}
+
+func (c *metricsClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.observe(c.Client.Create(ctx, obj, opts...))
+}
+
+func (c *metricsClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.observe(c.Client.Delete(ctx, obj, opts...))
+}
+
+func (c *metricsClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.observe(c.Client.Update(ctx, obj, opts...))
+}
+
+func (c *metricsClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.observe(c.Client.Patch(ctx, obj, patch, opts...))
+}
+
+func (c *metricsClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.observe(c.Client.DeleteAllOf(ctx, obj, opts...))
+}
+
+func (c *metricsClient) Status() client.StatusWriter {
+	return &metricsStatusWriter{StatusWriter: c.Client.Status(), client: c}
+}
+
+func (c *metricsClient) observe(err error) error {
+	if err != nil && !errors.IsNotFound(err) && !errors.IsAlreadyExists(err) && !errors.IsConflict(err) {
+		metrics.RemoteClientErrors.WithLabelValues(c.k8sContextName).Inc()
+	}
+	return err
+}
+
+type metricsStatusWriter struct {
+	client.StatusWriter
+	client *metricsClient
+}
+
+func (w *metricsStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return w.client.observe(w.StatusWriter.Update(ctx, obj, opts...))
+}
+
+func (w *metricsStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return w.client.observe(w.StatusWriter.Patch(ctx, obj, patch, opts...))
+}
@@ -0,0 +1,41 @@
+package clientcache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/k8ssandra/k8ssandra-operator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRemoteClientErrors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	cache := New(nil, nil, scheme)
+	cache.AddClient("remote", fake.NewClientBuilder().WithScheme(scheme).Build())
+	errors := metrics.RemoteClientErrors.WithLabelValues("remote")
+
+	remoteClient, err := cache.GetRemoteClient("remote")
+	require.NoError(t, err)
+
+	err = remoteClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "missing"}, &corev1.Secret{})
+	require.Error(t, err)
+	assert.Equal(t, 0.0, testutil.ToFloat64(errors), "not found errors should not be counted")
+
+	// The scheme of the remote client does not know this type
+	err = remoteClient.List(context.Background(), &appsv1.DeploymentList{})
+	require.Error(t, err)
+	assert.Equal(t, 1.0, testutil.ToFloat64(errors))
+
+	_, err = cache.GetRemoteClient("unknown")
+	require.Error(t, err)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RemoteClientErrors.WithLabelValues("unknown")))
+}
@@ -0,0 +1,74 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		err  bool
+	}{
+		{"every minute", "* * * * *", false},
+		{"lists, ranges and steps", "0,30 1-5 */2 1-12/3 mon-fri", false},
+		{"names", "0 2 * jan,jul sun", false},
+		{"sunday as 7", "0 2 * * 7", false},
+		{"macro", "@daily", false},
+		{"too few fields", "0 2 * *", true},
+		{"too many fields", "0 0 2 * * *", true},
+		{"out of range", "60 * * * *", true},
+		{"invalid range", "0 5-1 * * *", true},
+		{"invalid step", "*/0 * * * *", true},
+		{"invalid name", "0 0 * foo *", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.expr)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	// Wednesday
+	from := time.Date(2022, 3, 2, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"every minute", "* * * * *", from, time.Date(2022, 3, 2, 10, 31, 0, 0, time.UTC)},
+		{"strictly after", "30 10 * * *", time.Date(2022, 3, 2, 10, 30, 0, 0, time.UTC), time.Date(2022, 3, 3, 10, 30, 0, 0, time.UTC)},
+		{"daily", "@daily", from, time.Date(2022, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"every 15 minutes", "*/15 * * * *", from, time.Date(2022, 3, 2, 10, 45, 0, 0, time.UTC)},
+		{"weekly on saturday", "0 2 * * sat", from, time.Date(2022, 3, 5, 2, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 2 * * 7", from, time.Date(2022, 3, 6, 2, 0, 0, 0, time.UTC)},
+		{"next month", "0 0 1 * *", from, time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"next year", "0 0 1 jan *", from, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 15 * mon", from, time.Date(2022, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", from, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"never", "0 0 30 2 *", from, time.Time{}},
+		{"time zone", "0 2 * * *", from.In(paris), time.Date(2022, 3, 3, 2, 0, 0, 0, paris)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.expr)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(s.Next(tc.from)), "expected %v, got %v", tc.want, s.Next(tc.from))
+		})
+	}
+}
@@ -11,6 +11,11 @@ func GetDatacenterForDecommission(kc *api.K8ssandraCluster) string {
 		dcNames = append(dcNames, dc.Meta.Name)
 	}
 
+	// The temporary datacenter of a move is not part of the spec, it is decommissioned as part of the move
+	if migration := kc.Status.Migration; migration != nil && kc.IsTemporaryDatacenter(migration.TemporaryDatacenter) {
+		dcNames = append(dcNames, migration.TemporaryDatacenter)
+	}
+
 	// First look for a status that already has started decommission
 	for dcName, status := range kc.Status.Datacenters {
 		if !slices.Contains(dcNames, dcName) {
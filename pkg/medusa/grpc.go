@@ -34,7 +34,16 @@ type Client interface {
 
 	CreateBackup(ctx context.Context, name string, backupType string) error
 
+	// AsyncBackup starts the backup name on the node of the sidecar and returns without waiting for it to finish.
+	AsyncBackup(ctx context.Context, name string, backupType string) error
+
+	// BackupStatus returns the progress of the backup name as seen by the node of the sidecar.
+	BackupStatus(ctx context.Context, name string) (*BackupStatusResponse, error)
+
 	GetBackups(ctx context.Context) ([]*BackupSummary, error)
+
+	// DeleteBackup deletes the backup name of all the nodes of the cluster from the storage backend.
+	DeleteBackup(ctx context.Context, name string) error
 }
 
 func (c *defaultClient) Close() error {
@@ -42,18 +51,32 @@ func (c *defaultClient) Close() error {
 }
 
 func (c *defaultClient) CreateBackup(ctx context.Context, name string, backupType string) error {
+	request := newBackupRequest(name, backupType)
+	_, err := c.grpcClient.Backup(ctx, request)
+
+	return err
+}
+
+func (c *defaultClient) AsyncBackup(ctx context.Context, name string, backupType string) error {
+	request := newBackupRequest(name, backupType)
+	_, err := c.grpcClient.AsyncBackup(ctx, request)
+	return err
+}
+
+func newBackupRequest(name string, backupType string) *BackupRequest {
 	backupMode := BackupRequest_DIFFERENTIAL
 	if backupType == "full" {
 		backupMode = BackupRequest_FULL
 	}
-
-	request := BackupRequest{
+	return &BackupRequest{
 		Name: name,
 		Mode: backupMode,
 	}
-	_, err := c.grpcClient.Backup(ctx, &request)
+}
 
-	return err
+func (c *defaultClient) BackupStatus(ctx context.Context, name string) (*BackupStatusResponse, error) {
+	request := BackupStatusRequest{BackupName: name}
+	return c.grpcClient.BackupStatus(ctx, &request)
 }
 
 func (c *defaultClient) GetBackups(ctx context.Context) ([]*BackupSummary, error) {
@@ -66,6 +89,6 @@ func (c *defaultClient) GetBackups(ctx context.Context) ([]*BackupSummary, error
 
 func (c *defaultClient) DeleteBackup(ctx context.Context, name string) error {
 	request := DeleteBackupRequest{Name: name}
-	_, err := c.grpcClient.DeleteBackup(context.Background(), &request)
+	_, err := c.grpcClient.DeleteBackup(ctx, &request)
 	return err
 }
@@ -19,6 +19,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MedusaClient interface {
 	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
+	AsyncBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
 	BackupStatus(ctx context.Context, in *BackupStatusRequest, opts ...grpc.CallOption) (*BackupStatusResponse, error)
 	DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error)
 	GetBackups(ctx context.Context, in *GetBackupsRequest, opts ...grpc.CallOption) (*GetBackupsResponse, error)
@@ -41,6 +42,15 @@ func (c *medusaClient) Backup(ctx context.Context, in *BackupRequest, opts ...gr
 	return out, nil
 }
 
+func (c *medusaClient) AsyncBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/Medusa/AsyncBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *medusaClient) BackupStatus(ctx context.Context, in *BackupStatusRequest, opts ...grpc.CallOption) (*BackupStatusResponse, error) {
 	out := new(BackupStatusResponse)
 	err := c.cc.Invoke(ctx, "/Medusa/BackupStatus", in, out, opts...)
@@ -73,6 +83,7 @@ func (c *medusaClient) GetBackups(ctx context.Context, in *GetBackupsRequest, op
 // for forward compatibility
 type MedusaServer interface {
 	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
+	AsyncBackup(context.Context, *BackupRequest) (*BackupResponse, error)
 	BackupStatus(context.Context, *BackupStatusRequest) (*BackupStatusResponse, error)
 	DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error)
 	GetBackups(context.Context, *GetBackupsRequest) (*GetBackupsResponse, error)
@@ -86,6 +97,9 @@ type UnimplementedMedusaServer struct {
 func (UnimplementedMedusaServer) Backup(context.Context, *BackupRequest) (*BackupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
 }
+func (UnimplementedMedusaServer) AsyncBackup(context.Context, *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AsyncBackup not implemented")
+}
 func (UnimplementedMedusaServer) BackupStatus(context.Context, *BackupStatusRequest) (*BackupStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BackupStatus not implemented")
 }
@@ -126,6 +140,24 @@ func _Medusa_Backup_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Medusa_AsyncBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedusaServer).AsyncBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Medusa/AsyncBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedusaServer).AsyncBackup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Medusa_BackupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BackupStatusRequest)
 	if err := dec(in); err != nil {
@@ -191,6 +223,10 @@ var Medusa_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Backup",
 			Handler:    _Medusa_Backup_Handler,
 		},
+		{
+			MethodName: "AsyncBackup",
+			Handler:    _Medusa_AsyncBackup_Handler,
+		},
 		{
 			MethodName: "BackupStatus",
 			Handler:    _Medusa_BackupStatus_Handler,
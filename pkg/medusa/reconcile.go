@@ -18,18 +18,13 @@ import (
 )
 
 const (
-	DefaultMedusaImageRepository = "k8ssandra"
-	DefaultMedusaImageName       = "medusa"
-	DefaultMedusaVersion         = "0.11.3"
+	DefaultMedusaImageRepository = api.DefaultImageRepository
+	DefaultMedusaImageName       = api.DefaultImageName
+	DefaultMedusaVersion         = api.DefaultVersion
 )
 
 var (
-	defaultMedusaImage = images.Image{
-		Registry:   images.DefaultRegistry,
-		Repository: DefaultMedusaImageRepository,
-		Name:       DefaultMedusaImageName,
-		Tag:        DefaultMedusaVersion,
-	}
+	defaultMedusaImage = api.DefaultContainerImage
 )
 
 func CreateMedusaIni(kc *k8ss.K8ssandraCluster) string {
@@ -0,0 +1,119 @@
+package medusa
+
+import (
+	"sort"
+	"time"
+
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupRetentionPolicy returns the backup retention policy of the datacenter dcName: its own policy if it overrides
+// the one of the cluster, the policy of the cluster otherwise. nil means that backups are kept until they are deleted
+// by hand.
+func BackupRetentionPolicy(kc *api.K8ssandraCluster, dcName string) *medusaapi.BackupRetentionPolicy {
+	if kc.Spec.Medusa == nil {
+		return nil
+	}
+	for _, dcTemplate := range kc.Spec.Cassandra.Datacenters {
+		if dcTemplate.Meta.Name == dcName && dcTemplate.BackupRetention != nil {
+			return dcTemplate.BackupRetention
+		}
+	}
+	return kc.Spec.Medusa.BackupRetention
+}
+
+// ExpiredBackups returns the finished backups of the datacenter dcName that policy does not keep at now, along with
+// the time at which the next of the kept backups exceeds the maximum age of policy, if any. The most recent backup is
+// always kept, so that there is something to restore. Backups that are being deleted are ignored, and so are the
+// backups synchronized from the storage bucket since deleting them would not free any space. The backups of
+// CassandraClusterBackups are ignored too, their retention is enforced on the cluster backups, see
+// ExpiredClusterBackups.
+func ExpiredBackups(
+	backups []medusaapi.CassandraBackup,
+	dcName string,
+	policy *medusaapi.BackupRetentionPolicy,
+	now time.Time) ([]medusaapi.CassandraBackup, *time.Time) {
+
+	finished := make([]medusaapi.CassandraBackup, 0, len(backups))
+	for _, backup := range backups {
+		if backup.Spec.CassandraDatacenter == dcName && !backup.Status.FinishTime.IsZero() && backup.DeletionTimestamp == nil &&
+			!backup.IsSynced() && !backup.IsClusterBackup() {
+			finished = append(finished, backup)
+		}
+	}
+	// The most recent first
+	sort.SliceStable(finished, func(i, j int) bool {
+		return finished[j].Status.StartTime.Before(&finished[i].Status.StartTime)
+	})
+
+	startTimes := make([]metav1.Time, len(finished))
+	for i, backup := range finished {
+		startTimes[i] = backup.Status.StartTime
+	}
+	var expired []medusaapi.CassandraBackup
+	indexes, nextExpiry := expiredIndexes(startTimes, policy, now)
+	for _, i := range indexes {
+		expired = append(expired, finished[i])
+	}
+	return expired, nextExpiry
+}
+
+// ExpiredClusterBackups returns the finished CassandraClusterBackups of the cluster clusterName that policy does not
+// keep at now, along with the time at which the next of the kept backups exceeds the maximum age of policy, if any.
+// The same rules as ExpiredBackups apply.
+func ExpiredClusterBackups(
+	clusterBackups []medusaapi.CassandraClusterBackup,
+	clusterName string,
+	policy *medusaapi.BackupRetentionPolicy,
+	now time.Time) ([]medusaapi.CassandraClusterBackup, *time.Time) {
+
+	finished := make([]medusaapi.CassandraClusterBackup, 0, len(clusterBackups))
+	for _, clusterBackup := range clusterBackups {
+		if clusterBackup.Spec.Cluster.Name == clusterName && !clusterBackup.Status.FinishTime.IsZero() && clusterBackup.DeletionTimestamp == nil {
+			finished = append(finished, clusterBackup)
+		}
+	}
+	// The most recent first
+	sort.SliceStable(finished, func(i, j int) bool {
+		return finished[j].Status.StartTime.Before(&finished[i].Status.StartTime)
+	})
+
+	startTimes := make([]metav1.Time, len(finished))
+	for i, clusterBackup := range finished {
+		startTimes[i] = clusterBackup.Status.StartTime
+	}
+	var expired []medusaapi.CassandraClusterBackup
+	indexes, nextExpiry := expiredIndexes(startTimes, policy, now)
+	for _, i := range indexes {
+		expired = append(expired, finished[i])
+	}
+	return expired, nextExpiry
+}
+
+// expiredIndexes returns the indexes of the backups that policy does not keep at now, given their start times from
+// the most recent to the oldest, along with the time at which the next of the kept backups exceeds the maximum age of
+// policy, if any.
+func expiredIndexes(startTimes []metav1.Time, policy *medusaapi.BackupRetentionPolicy, now time.Time) ([]int, *time.Time) {
+	var expired []int
+	var nextExpiry *time.Time
+	for i, startTime := range startTimes {
+		if i == 0 || policy == nil {
+			continue
+		}
+		if policy.MaxBackupCount > 0 && i >= int(policy.MaxBackupCount) {
+			expired = append(expired, i)
+			continue
+		}
+		if policy.MaxBackupAge != nil {
+			expiry := startTime.Add(policy.MaxBackupAge.Duration)
+			if !expiry.After(now) {
+				expired = append(expired, i)
+			} else if nextExpiry == nil || expiry.Before(*nextExpiry) {
+				nextExpiry = &expiry
+			}
+		}
+	}
+	return expired, nextExpiry
+}
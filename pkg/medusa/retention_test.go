@@ -0,0 +1,146 @@
+package medusa
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupRetentionPolicy(t *testing.T) {
+	clusterPolicy := &medusaapi.BackupRetentionPolicy{MaxBackupCount: 10}
+	dcPolicy := &medusaapi.BackupRetentionPolicy{MaxBackupCount: 3}
+	kc := &api.K8ssandraCluster{
+		Spec: api.K8ssandraClusterSpec{
+			Cassandra: &api.CassandraClusterTemplate{
+				Datacenters: []api.CassandraDatacenterTemplate{
+					{Meta: api.EmbeddedObjectMeta{Name: "dc1"}},
+					{Meta: api.EmbeddedObjectMeta{Name: "dc2"}, BackupRetention: dcPolicy},
+				},
+			},
+		},
+	}
+	assert.Nil(t, BackupRetentionPolicy(kc, "dc2"), "there is no retention without Medusa")
+
+	kc.Spec.Medusa = &medusaapi.MedusaClusterTemplate{BackupRetention: clusterPolicy}
+	assert.Equal(t, clusterPolicy, BackupRetentionPolicy(kc, "dc1"))
+	assert.Equal(t, dcPolicy, BackupRetentionPolicy(kc, "dc2"))
+}
+
+func TestExpiredBackups(t *testing.T) {
+	now := time.Date(2022, 3, 10, 12, 0, 0, 0, time.UTC)
+	newBackup := func(name, dcName string, age time.Duration, finished bool) medusaapi.CassandraBackup {
+		backup := medusaapi.CassandraBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       medusaapi.CassandraBackupSpec{CassandraDatacenter: dcName},
+		}
+		backup.Status.StartTime = metav1.NewTime(now.Add(-age))
+		if finished {
+			backup.Status.FinishTime = metav1.NewTime(now.Add(-age + time.Minute))
+		}
+		return backup
+	}
+	day := 24 * time.Hour
+	backups := []medusaapi.CassandraBackup{
+		newBackup("dc1-day3", "dc1", 3*day, true),
+		newBackup("dc1-day1", "dc1", day, true),
+		newBackup("dc1-now", "dc1", 0, false),
+		newBackup("dc1-day2", "dc1", 2*day, true),
+		newBackup("dc2-day5", "dc2", 5*day, true),
+	}
+	names := func(backups []medusaapi.CassandraBackup) []string {
+		names := make([]string, 0)
+		for _, backup := range backups {
+			names = append(names, backup.Name)
+		}
+		return names
+	}
+
+	expired, next := ExpiredBackups(backups, "dc1", nil, now)
+	assert.Empty(t, expired)
+	assert.Nil(t, next)
+
+	expired, next = ExpiredBackups(backups, "dc1", &medusaapi.BackupRetentionPolicy{MaxBackupCount: 2}, now)
+	assert.Equal(t, []string{"dc1-day3"}, names(expired), "running backups do not count")
+	assert.Nil(t, next)
+
+	maxAge := &metav1.Duration{Duration: 36 * time.Hour}
+	expired, next = ExpiredBackups(backups, "dc1", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Equal(t, []string{"dc1-day2", "dc1-day3"}, names(expired))
+	assert.Nil(t, next, "the most recent backup never expires")
+
+	expired, _ = ExpiredBackups(backups, "dc2", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Empty(t, expired, "the most recent backup must be kept")
+
+	maxAge = &metav1.Duration{Duration: 60 * time.Hour}
+	expired, next = ExpiredBackups(backups, "dc1", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Equal(t, []string{"dc1-day3"}, names(expired))
+	require.NotNil(t, next)
+	assert.True(t, now.Add(12*time.Hour).Equal(*next), "unexpected next expiry %v", next)
+
+	deleted := metav1.NewTime(now)
+	backups[0].DeletionTimestamp = &deleted
+	expired, _ = ExpiredBackups(backups, "dc1", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Empty(t, expired, "backups that are being deleted must be ignored")
+
+	backups[0].DeletionTimestamp = nil
+	backups[0].Labels = map[string]string{medusaapi.BackupSyncedLabel: ""}
+	expired, _ = ExpiredBackups(backups, "dc1", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Empty(t, expired, "synchronized backups must be ignored")
+
+	backups[0].Labels = map[string]string{medusaapi.ClusterBackupNameLabel: "cluster-backup"}
+	expired, _ = ExpiredBackups(backups, "dc1", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Empty(t, expired, "the backups of cluster backups must be ignored")
+}
+
+func TestExpiredClusterBackups(t *testing.T) {
+	now := time.Date(2022, 3, 10, 12, 0, 0, 0, time.UTC)
+	newClusterBackup := func(name, clusterName string, age time.Duration, finished bool) medusaapi.CassandraClusterBackup {
+		clusterBackup := medusaapi.CassandraClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       medusaapi.CassandraClusterBackupSpec{Cluster: corev1.LocalObjectReference{Name: clusterName}},
+		}
+		clusterBackup.Status.StartTime = metav1.NewTime(now.Add(-age))
+		if finished {
+			clusterBackup.Status.FinishTime = metav1.NewTime(now.Add(-age + time.Minute))
+		}
+		return clusterBackup
+	}
+	day := 24 * time.Hour
+	clusterBackups := []medusaapi.CassandraClusterBackup{
+		newClusterBackup("c1-day3", "c1", 3*day, true),
+		newClusterBackup("c1-day1", "c1", day, true),
+		newClusterBackup("c1-now", "c1", 0, false),
+		newClusterBackup("c1-day2", "c1", 2*day, true),
+		newClusterBackup("c2-day5", "c2", 5*day, true),
+	}
+	names := func(clusterBackups []medusaapi.CassandraClusterBackup) []string {
+		names := make([]string, 0)
+		for _, clusterBackup := range clusterBackups {
+			names = append(names, clusterBackup.Name)
+		}
+		return names
+	}
+
+	expired, next := ExpiredClusterBackups(clusterBackups, "c1", nil, now)
+	assert.Empty(t, expired)
+	assert.Nil(t, next)
+
+	expired, next = ExpiredClusterBackups(clusterBackups, "c1", &medusaapi.BackupRetentionPolicy{MaxBackupCount: 2}, now)
+	assert.Equal(t, []string{"c1-day3"}, names(expired), "running backups do not count")
+	assert.Nil(t, next)
+
+	maxAge := &metav1.Duration{Duration: 60 * time.Hour}
+	expired, next = ExpiredClusterBackups(clusterBackups, "c1", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Equal(t, []string{"c1-day3"}, names(expired))
+	require.NotNil(t, next)
+	assert.True(t, now.Add(12*time.Hour).Equal(*next), "unexpected next expiry %v", next)
+
+	expired, _ = ExpiredClusterBackups(clusterBackups, "c2", &medusaapi.BackupRetentionPolicy{MaxBackupAge: maxAge}, now)
+	assert.Empty(t, expired, "the most recent backup must be kept")
+}
@@ -0,0 +1,125 @@
+// Package metrics defines the Prometheus metrics that the operator exposes in addition to the controller-runtime
+// ones. They are registered with the controller-runtime registry, and are therefore served by the manager's metrics
+// endpoint.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "k8ssandra"
+
+// Label names.
+const (
+	LabelNamespace  = "namespace"
+	LabelCluster    = "cluster"
+	LabelDatacenter = "datacenter"
+	LabelK8sContext = "k8s_context"
+	LabelResult     = "result"
+)
+
+// Values of the LabelResult label.
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+)
+
+// Values of DatacenterDecommissionProgress.
+const (
+	DecommissionNone                = 0
+	DecommissionUpdatingReplication = 1
+	DecommissionDeleting            = 2
+)
+
+var (
+	// DatacenterReady is 1 if the CassandraDatacenter of a K8ssandraCluster is ready and 0 otherwise.
+	DatacenterReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "cluster",
+		Name:      "datacenter_ready",
+		Help:      "Whether the CassandraDatacenter of a K8ssandraCluster is ready (1) or not (0).",
+	}, []string{LabelNamespace, LabelCluster, LabelDatacenter})
+
+	// DatacenterDecommissionProgress reports how far the decommission of a datacenter went, see the Decommission*
+	// constants.
+	DatacenterDecommissionProgress = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "cluster",
+		Name:      "datacenter_decommission_progress",
+		Help:      "Decommission progress of a datacenter: 0 not decommissioning, 1 updating replication, 2 decommissioning nodes.",
+	}, []string{LabelNamespace, LabelCluster, LabelDatacenter})
+
+	// DatacenterRebuildInProgress is 1 while a datacenter added to an existing cluster streams its data from another
+	// datacenter.
+	DatacenterRebuildInProgress = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "cluster",
+		Name:      "datacenter_rebuild_in_progress",
+		Help:      "Whether a datacenter is being rebuilt (1) or not (0).",
+	}, []string{LabelNamespace, LabelCluster, LabelDatacenter})
+
+	// SchemaAgreement is 1 if all the nodes of a K8ssandraCluster agree on the schema version and 0 otherwise. The
+	// series is absent while the agreement is unknown.
+	SchemaAgreement = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "cluster",
+		Name:      "schema_agreement",
+		Help:      "Whether the nodes of a K8ssandraCluster agree on the schema version (1) or not (0).",
+	}, []string{LabelNamespace, LabelCluster})
+
+	// BackupDuration observes the time taken by finished CassandraBackups.
+	BackupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "medusa",
+		Name:      "backup_duration_seconds",
+		Help:      "Duration of the CassandraBackups, from their start until all the pods are done.",
+		Buckets:   prometheus.ExponentialBuckets(30, 2, 12),
+	}, []string{LabelNamespace, LabelDatacenter})
+
+	// BackupPodResults counts the outcome of each pod backup.
+	BackupPodResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "medusa",
+		Name:      "backup_pod_results_total",
+		Help:      "Number of pod backups that succeeded or failed.",
+	}, []string{LabelNamespace, LabelDatacenter, LabelResult})
+
+	// RestoreDuration observes the time taken by finished CassandraRestores.
+	RestoreDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "medusa",
+		Name:      "restore_duration_seconds",
+		Help:      "Duration of the CassandraRestores, from their start until the datacenter is back online.",
+		Buckets:   prometheus.ExponentialBuckets(30, 2, 12),
+	}, []string{LabelNamespace, LabelDatacenter})
+
+	// RemoteClientErrors counts the failed requests made to the API server of each Kubernetes context.
+	RemoteClientErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "client",
+		Name:      "remote_errors_total",
+		Help:      "Number of failed requests to the API server of a Kubernetes context, or attempts to use an unknown context.",
+	}, []string{LabelK8sContext})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		DatacenterReady,
+		DatacenterDecommissionProgress,
+		DatacenterRebuildInProgress,
+		SchemaAgreement,
+		BackupDuration,
+		BackupPodResults,
+		RestoreDuration,
+		RemoteClientErrors,
+	)
+}
+
+// BoolToFloat converts b to the value of a boolean gauge.
+func BoolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
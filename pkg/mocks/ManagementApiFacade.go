@@ -3,8 +3,13 @@
 package mocks
 
 import (
+	cassandra "github.com/k8ssandra/k8ssandra-operator/pkg/cassandra"
+
 	httphelper "github.com/k8ssandra/cass-operator/pkg/httphelper"
+
 	mock "github.com/stretchr/testify/mock"
+
+	v1 "k8s.io/api/core/v1"
 )
 
 // ManagementApiFacade is an autogenerated mock type for the ManagementApiFacade type
@@ -26,6 +31,34 @@ func (_m *ManagementApiFacade) AlterKeyspace(keyspaceName string, replicationSet
 	return r0
 }
 
+// AlterKeyspaceDurableWrites provides a mock function with given fields: keyspaceName, replication, durableWrites
+func (_m *ManagementApiFacade) AlterKeyspaceDurableWrites(keyspaceName string, replication map[string]int, durableWrites bool) error {
+	ret := _m.Called(keyspaceName, replication, durableWrites)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]int, bool) error); ok {
+		r0 = rf(keyspaceName, replication, durableWrites)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlterRole provides a mock function with given fields: role
+func (_m *ManagementApiFacade) AlterRole(role *cassandra.RoleDefinition) error {
+	ret := _m.Called(role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cassandra.RoleDefinition) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateKeyspaceIfNotExists provides a mock function with given fields: keyspaceName, replication
 func (_m *ManagementApiFacade) CreateKeyspaceIfNotExists(keyspaceName string, replication map[string]int) error {
 	ret := _m.Called(keyspaceName, replication)
@@ -40,6 +73,20 @@ func (_m *ManagementApiFacade) CreateKeyspaceIfNotExists(keyspaceName string, re
 	return r0
 }
 
+// CreateRole provides a mock function with given fields: role
+func (_m *ManagementApiFacade) CreateRole(role *cassandra.RoleDefinition) error {
+	ret := _m.Called(role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cassandra.RoleDefinition) error); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateTable provides a mock function with given fields: definition
 func (_m *ManagementApiFacade) CreateTable(definition *httphelper.TableDefinition) error {
 	ret := _m.Called(definition)
@@ -54,6 +101,20 @@ func (_m *ManagementApiFacade) CreateTable(definition *httphelper.TableDefinitio
 	return r0
 }
 
+// DropRole provides a mock function with given fields: roleName
+func (_m *ManagementApiFacade) DropRole(roleName string) error {
+	ret := _m.Called(roleName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EnsureKeyspaceReplication provides a mock function with given fields: keyspaceName, replication
 func (_m *ManagementApiFacade) EnsureKeyspaceReplication(keyspaceName string, replication map[string]int) error {
 	ret := _m.Called(keyspaceName, replication)
@@ -68,6 +129,29 @@ func (_m *ManagementApiFacade) EnsureKeyspaceReplication(keyspaceName string, re
 	return r0
 }
 
+// GetJobDetails provides a mock function with given fields: pod, jobId
+func (_m *ManagementApiFacade) GetJobDetails(pod *v1.Pod, jobId string) (*httphelper.JobDetails, error) {
+	ret := _m.Called(pod, jobId)
+
+	var r0 *httphelper.JobDetails
+	if rf, ok := ret.Get(0).(func(*v1.Pod, string) *httphelper.JobDetails); ok {
+		r0 = rf(pod, jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*httphelper.JobDetails)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.Pod, string) error); ok {
+		r1 = rf(pod, jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetKeyspaceReplication provides a mock function with given fields: keyspaceName
 func (_m *ManagementApiFacade) GetKeyspaceReplication(keyspaceName string) (map[string]string, error) {
 	ret := _m.Called(keyspaceName)
@@ -114,6 +198,41 @@ func (_m *ManagementApiFacade) GetSchemaVersions() (map[string][]string, error)
 	return r0, r1
 }
 
+// GrantPermissions provides a mock function with given fields: permissions
+func (_m *ManagementApiFacade) GrantPermissions(permissions *cassandra.PermissionsDefinition) error {
+	ret := _m.Called(permissions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cassandra.PermissionsDefinition) error); ok {
+		r0 = rf(permissions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HasSchemaAgreement provides a mock function with given fields:
+func (_m *ManagementApiFacade) HasSchemaAgreement() (bool, error) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListKeyspaces provides a mock function with given fields: keyspaceName
 func (_m *ManagementApiFacade) ListKeyspaces(keyspaceName string) ([]string, error) {
 	ret := _m.Called(keyspaceName)
@@ -137,6 +256,29 @@ func (_m *ManagementApiFacade) ListKeyspaces(keyspaceName string) ([]string, err
 	return r0, r1
 }
 
+// ListRoles provides a mock function with given fields:
+func (_m *ManagementApiFacade) ListRoles() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListTables provides a mock function with given fields: keyspaceName
 func (_m *ManagementApiFacade) ListTables(keyspaceName string) ([]string, error) {
 	ret := _m.Called(keyspaceName)
@@ -159,3 +301,38 @@ func (_m *ManagementApiFacade) ListTables(keyspaceName string) ([]string, error)
 
 	return r0, r1
 }
+
+// RevokePermissions provides a mock function with given fields: permissions
+func (_m *ManagementApiFacade) RevokePermissions(permissions *cassandra.PermissionsDefinition) error {
+	ret := _m.Called(permissions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cassandra.PermissionsDefinition) error); ok {
+		r0 = rf(permissions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpgradeSSTables provides a mock function with given fields: pod
+func (_m *ManagementApiFacade) UpgradeSSTables(pod *v1.Pod) (string, error) {
+	ret := _m.Called(pod)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*v1.Pod) string); ok {
+		r0 = rf(pod)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.Pod) error); ok {
+		r1 = rf(pod)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -9,6 +9,8 @@ import (
 
 	v1alpha1 "github.com/k8ssandra/k8ssandra-operator/apis/reaper/v1alpha1"
 
+	reaper "github.com/k8ssandra/reaper-client-go/reaper"
+
 	v1beta1 "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
 )
 
@@ -45,6 +47,62 @@ func (_m *ReaperManager) Connect(ctx context.Context, _a1 *v1alpha1.Reaper, user
 	return r0
 }
 
+// CreateNodeRepairRun provides a mock function with given fields: ctx, cassdc, keyspace, node, cause
+func (_m *ReaperManager) CreateNodeRepairRun(ctx context.Context, cassdc *v1beta1.CassandraDatacenter, keyspace string, node string, cause string) (string, error) {
+	ret := _m.Called(ctx, cassdc, keyspace, node, cause)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, *v1beta1.CassandraDatacenter, string, string, string) string); ok {
+		r0 = rf(ctx, cassdc, keyspace, node, cause)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *v1beta1.CassandraDatacenter, string, string, string) error); ok {
+		r1 = rf(ctx, cassdc, keyspace, node, cause)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRepairRunState provides a mock function with given fields: ctx, repairRunId
+func (_m *ReaperManager) GetRepairRunState(ctx context.Context, repairRunId string) (reaper.RepairRunState, error) {
+	ret := _m.Called(ctx, repairRunId)
+
+	var r0 reaper.RepairRunState
+	if rf, ok := ret.Get(0).(func(context.Context, string) reaper.RepairRunState); ok {
+		r0 = rf(ctx, repairRunId)
+	} else {
+		r0 = ret.Get(0).(reaper.RepairRunState)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, repairRunId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartRepairRun provides a mock function with given fields: ctx, repairRunId
+func (_m *ReaperManager) StartRepairRun(ctx context.Context, repairRunId string) error {
+	ret := _m.Called(ctx, repairRunId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, repairRunId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // VerifyClusterIsConfigured provides a mock function with given fields: ctx, cassdc
 func (_m *ReaperManager) VerifyClusterIsConfigured(ctx context.Context, cassdc *v1beta1.CassandraDatacenter) (bool, error) {
 	ret := _m.Called(ctx, cassdc)
@@ -0,0 +1,31 @@
+package plan
+
+import "strings"
+
+// datacenterFieldsWithoutRestart are the fields of CassandraDatacenterSpec that cass-operator applies without changing
+// the pod template of the StatefulSets.
+var datacenterFieldsWithoutRestart = map[string]bool{
+	"size":                    true,
+	"stopped":                 true,
+	"users":                   true,
+	"superuserSecretName":     true,
+	"additionalServiceConfig": true,
+	"canaryUpgrade":           true,
+	"canaryUpgradeCount":      true,
+	"replaceNodes":            true,
+}
+
+// DatacenterRequiresRollingRestart returns true if applying the changes of a CassandraDatacenter spec restarts its
+// Cassandra pods. The paths of the changes must be relative to the spec.
+func DatacenterRequiresRollingRestart(changes []FieldChange) bool {
+	for _, change := range changes {
+		field := change.Path
+		if i := strings.IndexAny(field, ".["); i >= 0 {
+			field = field[:i]
+		}
+		if !datacenterFieldsWithoutRestart[field] {
+			return true
+		}
+	}
+	return false
+}
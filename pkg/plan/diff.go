@@ -0,0 +1,143 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const unset = "<unset>"
+
+// FieldChange is a field whose desired value differs from its actual value. Values are JSON encoded.
+type FieldChange struct {
+	Path    string
+	Actual  string
+	Desired string
+}
+
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, c.Actual, c.Desired)
+}
+
+// Diff compares the JSON representations of actual and desired, and returns the changes sorted by path. Paths are
+// JSON paths, e.g. spec.racks[0].name, and the keys of the objects embedded as raw JSON, like the Cassandra config,
+// are part of the paths.
+//
+// Objects read from the API server have defaults that the operator does not set, so fields that are only set in actual
+// are ignored, unless desired sets the top-level field they belong to: the operator owns that whole field, and the
+// fields are reported as removed.
+func Diff(actual, desired interface{}) ([]FieldChange, error) {
+	actualFields, err := flatten(actual)
+	if err != nil {
+		return nil, err
+	}
+	desiredFields, err := flatten(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]FieldChange, 0)
+	desiredTopLevelFields := make(map[string]bool)
+	for path, desiredValue := range desiredFields {
+		desiredTopLevelFields[topLevelField(path)] = true
+		if actualValue, found := actualFields[path]; !found {
+			changes = append(changes, FieldChange{Path: path, Actual: unset, Desired: desiredValue})
+		} else if actualValue != desiredValue {
+			changes = append(changes, FieldChange{Path: path, Actual: actualValue, Desired: desiredValue})
+		}
+	}
+	for path, actualValue := range actualFields {
+		if _, found := desiredFields[path]; !found && desiredTopLevelFields[topLevelField(path)] {
+			changes = append(changes, FieldChange{Path: path, Actual: actualValue, Desired: unset})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// flatten returns the JSON encoded leaf values of obj indexed by their path.
+func flatten(obj interface{}) (map[string]string, error) {
+	bytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err = json.Unmarshal(bytes, &value); err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string)
+	if err = flattenValue("", value, fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func flattenValue(path string, value interface{}, fields map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) > 0 {
+			for key, child := range v {
+				if err := flattenValue(joinPath(path, key), child, fields); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		// Lists of scalar values, like JVM options, are easier to read as a whole
+		if len(v) > 0 && !isScalarList(v) {
+			for i, child := range v {
+				if err := flattenValue(fmt.Sprintf("%s[%d]", path, i), child, fields); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case nil:
+		return nil
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	fields[path] = string(bytes)
+	return nil
+}
+
+// topLevelField returns the first element of path, e.g. config for config.cassandra-yaml.num_tokens.
+func topLevelField(path string) string {
+	if strings.HasPrefix(path, "[") {
+		if i := strings.Index(path, "]"); i > 0 {
+			return path[:i+1]
+		}
+		return path
+	}
+	if i := strings.IndexAny(path, ".["); i > 0 {
+		return path[:i]
+	}
+	return path
+}
+
+func isScalarList(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+	}
+	return true
+}
+
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, ".[]") {
+		key = fmt.Sprintf("[%q]", key)
+		return path + key
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
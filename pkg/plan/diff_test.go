@@ -0,0 +1,66 @@
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+
+	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	actual := cassdcapi.CassandraDatacenterSpec{
+		Size:          3,
+		ServerVersion: "4.0.3",
+		ServerImage:   "cassandra:4.0.3",
+		Config:        json.RawMessage(`{"cassandra-yaml":{"num_tokens":16,"concurrent_reads":32}}`),
+		Racks:         []cassdcapi.Rack{{Name: "rack1"}},
+		ReplaceNodes:  []string{"pod1"},
+	}
+	desired := cassdcapi.CassandraDatacenterSpec{
+		Size:          4,
+		ServerVersion: "4.0.3",
+		Config:        json.RawMessage(`{"cassandra-yaml":{"num_tokens":16,"concurrent_writes":64}}`),
+		Racks:         []cassdcapi.Rack{{Name: "rack1"}, {Name: "rack2"}},
+		ReplaceNodes:  []string{"pod2", "pod1"},
+	}
+
+	changes, err := Diff(actual, desired)
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.String())
+	}
+	// The server image is only set on the actual object, it is ignored, but the config is set on both and the setting
+	// missing from desired is reported as removed
+	assert.Equal(t, []string{
+		`config.cassandra-yaml.concurrent_reads: 32 -> <unset>`,
+		`config.cassandra-yaml.concurrent_writes: <unset> -> 64`,
+		`racks[1].name: <unset> -> "rack2"`,
+		`replaceNodes: ["pod1"] -> ["pod2","pod1"]`,
+		`size: 3 -> 4`,
+	}, paths)
+	assert.True(t, DatacenterRequiresRollingRestart(changes))
+
+	// Removing a setting is a change on its own
+	removed := actual.DeepCopy()
+	removed.Config = json.RawMessage(`{"cassandra-yaml":{"num_tokens":16}}`)
+	changes, err = Diff(actual, removed)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, `config.cassandra-yaml.concurrent_reads: 32 -> <unset>`, changes[0].String())
+	assert.True(t, DatacenterRequiresRollingRestart(changes))
+
+	changes, err = Diff(actual, actual)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDatacenterRequiresRollingRestart(t *testing.T) {
+	assert.False(t, DatacenterRequiresRollingRestart(nil))
+	assert.False(t, DatacenterRequiresRollingRestart([]FieldChange{{Path: "size"}, {Path: "users[0].secretName"}}))
+	assert.True(t, DatacenterRequiresRollingRestart([]FieldChange{{Path: "size"}, {Path: "serverVersion"}}))
+	assert.True(t, DatacenterRequiresRollingRestart([]FieldChange{{Path: "podTemplateSpec.spec.containers[0].image"}}))
+}
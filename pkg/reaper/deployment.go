@@ -214,6 +214,11 @@ func NewDeployment(reaper *api.Reaper, dc *cassdcapi.CassandraDatacenter, keysto
 		},
 	}
 	addAuthEnvVars(deployment, authVars)
+	// The credentials are passed as environment variables, changing the revision restarts Reaper so that it picks
+	// up rotated credentials.
+	if revision := annotations.GetAnnotation(reaper, v1alpha1.CredentialsRevisionAnnotation); revision != "" {
+		annotations.AddAnnotation(&deployment.Spec.Template, v1alpha1.CredentialsRevisionAnnotation, revision)
+	}
 	annotations.AddHashAnnotation(deployment)
 	return deployment
 }
@@ -428,6 +428,17 @@ func TestSkipSchemaMigration(t *testing.T) {
 	assert.Len(t, deployment.Spec.Template.Spec.InitContainers, 0, "expected pod template to not have any init container")
 }
 
+func TestCredentialsRevision(t *testing.T) {
+	reaper := newTestReaper()
+	deployment := NewDeployment(reaper, newTestDatacenter(), nil, nil)
+	assert.NotContains(t, deployment.Spec.Template.Annotations, k8ssandraapi.CredentialsRevisionAnnotation)
+
+	reaper.Annotations = map[string]string{k8ssandraapi.CredentialsRevisionAnnotation: "2"}
+	rotated := NewDeployment(reaper, newTestDatacenter(), nil, nil)
+	assert.Equal(t, "2", rotated.Spec.Template.Annotations[k8ssandraapi.CredentialsRevisionAnnotation])
+	assert.NotEqual(t, deployment.Annotations[k8ssandraapi.ResourceHashAnnotation], rotated.Annotations[k8ssandraapi.ResourceHashAnnotation])
+}
+
 func newTestReaper() *reaperapi.Reaper {
 	namespace := "service-test"
 	reaperName := "test-reaper"
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/google/uuid"
+
 	"github.com/k8ssandra/k8ssandra-operator/pkg/utils"
 
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
@@ -16,6 +18,13 @@ type Manager interface {
 	Connect(ctx context.Context, reaper *api.Reaper, username, password string) error
 	AddClusterToReaper(ctx context.Context, cassdc *cassdcapi.CassandraDatacenter) error
 	VerifyClusterIsConfigured(ctx context.Context, cassdc *cassdcapi.CassandraDatacenter) (bool, error)
+	// CreateNodeRepairRun creates a repair run of keyspace restricted to the token ranges of node, without starting
+	// it. The id of the repair run is returned.
+	CreateNodeRepairRun(ctx context.Context, cassdc *cassdcapi.CassandraDatacenter, keyspace, node, cause string) (string, error)
+	// StartRepairRun starts a repair run that was created with CreateNodeRepairRun.
+	StartRepairRun(ctx context.Context, repairRunId string) error
+	// GetRepairRunState returns the state of a repair run, e.g. RUNNING or DONE.
+	GetRepairRunState(ctx context.Context, repairRunId string) (reaperclient.RepairRunState, error)
 }
 
 func NewManager() Manager {
@@ -55,3 +64,35 @@ func (r *restReaperManager) VerifyClusterIsConfigured(ctx context.Context, cassd
 	}
 	return utils.SliceContains(clusters, cassdc.Name), nil
 }
+
+func (r *restReaperManager) CreateNodeRepairRun(ctx context.Context, cassdc *cassdcapi.CassandraDatacenter, keyspace, node, cause string) (string, error) {
+	options := &reaperclient.RepairRunCreateOptions{
+		Cause: cause,
+		Nodes: []string{node},
+	}
+	repairRunId, err := r.reaperClient.CreateRepairRun(ctx, cassdc.Spec.ClusterName, keyspace, RepairRunOwner, options)
+	if err != nil {
+		return "", err
+	}
+	return repairRunId.String(), nil
+}
+
+func (r *restReaperManager) StartRepairRun(ctx context.Context, repairRunId string) error {
+	id, err := uuid.Parse(repairRunId)
+	if err != nil {
+		return err
+	}
+	return r.reaperClient.StartRepairRun(ctx, id)
+}
+
+func (r *restReaperManager) GetRepairRunState(ctx context.Context, repairRunId string) (reaperclient.RepairRunState, error) {
+	id, err := uuid.Parse(repairRunId)
+	if err != nil {
+		return "", err
+	}
+	repairRun, err := r.reaperClient.RepairRun(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return repairRun.State, nil
+}
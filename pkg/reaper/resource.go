@@ -16,6 +16,9 @@ const (
 
 	DatacenterAvailabilityEach = "EACH"
 	DatacenterAvailabilityAll  = "ALL"
+
+	// RepairRunOwner is the owner of the repair runs created by the operator.
+	RepairRunOwner = "k8ssandra-operator"
 )
 
 // DefaultResourceName generates a name for a new Reaper resource that is derived from the Cassandra cluster and DC
@@ -71,6 +74,9 @@ func NewReaper(
 	if kc.Status.GetConditionStatus(k8ssandraapi.CassandraInitialized) == corev1.ConditionTrue && kc.HasStoppedDatacenters() {
 		desiredReaper.Spec.SkipSchemaMigration = true
 	}
+	if revision := kc.CredentialsRevision(); revision != "" {
+		desiredReaper.Annotations[k8ssandraapi.CredentialsRevisionAnnotation] = revision
+	}
 	annotations.AddHashAnnotation(desiredReaper)
 	return desiredReaper
 }
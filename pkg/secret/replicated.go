@@ -53,6 +53,12 @@ func DefaultSuperuserSecretName(clusterName string) string {
 // ReconcileSecret creates a new secret with proper "managed-by" annotations, or ensure the existing secret has such
 // annotations.
 func ReconcileSecret(ctx context.Context, c client.Client, secretName string, kcKey client.ObjectKey) error {
+	return ReconcileSecretWithUsername(ctx, c, secretName, secretName, kcKey)
+}
+
+// ReconcileSecretWithUsername is like ReconcileSecret, except that the username stored in a newly created secret is
+// the given one instead of the secret name.
+func ReconcileSecretWithUsername(ctx context.Context, c client.Client, secretName, username string, kcKey client.ObjectKey) error {
 	if secretName == "" {
 		return fmt.Errorf("secretName is required")
 	}
@@ -71,7 +77,7 @@ func ReconcileSecret(ctx context.Context, c client.Client, secretName string, kc
 				// Immutable feature is only available from 1.21 and up (beta in 1.19 and up)
 				// Immutable:  true,
 				Data: map[string][]byte{
-					"username": []byte(secretName),
+					"username": []byte(username),
 					"password": password,
 				},
 			}
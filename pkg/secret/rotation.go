@@ -0,0 +1,37 @@
+package secret
+
+import (
+	"context"
+
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/annotations"
+	"github.com/k8ssandra/k8ssandra-operator/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsGenerated returns true if the secret was generated by the operator for the given K8ssandraCluster. Secrets
+// provided by the user are also labelled for replication by ReconcileSecret, but they are flagged with the
+// OrphanResourceAnnotation.
+func IsGenerated(sec *corev1.Secret, kcKey client.ObjectKey) bool {
+	return labels.IsManagedBy(sec, kcKey) && !annotations.HasAnnotationWithValue(sec, OrphanResourceAnnotation, "true")
+}
+
+// RotatePassword stores a new random password in the secret and records the revision of the rotation in the
+// CredentialsRevisionAnnotation. Nothing is done if the secret was already rotated for this revision, which makes it
+// safe to call again after a failure.
+func RotatePassword(ctx context.Context, c client.Client, sec *corev1.Secret, revision string) error {
+	if annotations.HasAnnotationWithValue(sec, api.CredentialsRevisionAnnotation, revision) {
+		return nil
+	}
+	password, err := generateRandomString(passwordCharacters, 20)
+	if err != nil {
+		return err
+	}
+	if sec.Data == nil {
+		sec.Data = map[string][]byte{}
+	}
+	sec.Data["password"] = password
+	annotations.AddAnnotation(sec, api.CredentialsRevisionAnnotation, revision)
+	return c.Update(ctx, sec)
+}
@@ -0,0 +1,63 @@
+package secret
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsGenerated(t *testing.T) {
+	ctx := context.Background()
+	kcKey := client.ObjectKey{Namespace: "default", Name: "test"}
+	c := fake.NewClientBuilder().Build()
+
+	require.NoError(t, ReconcileSecret(ctx, c, "generated", kcKey))
+	generated := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "generated"}, generated))
+	assert.True(t, IsGenerated(generated, kcKey))
+	assert.False(t, IsGenerated(generated, client.ObjectKey{Namespace: "default", Name: "other"}))
+
+	provided := &corev1.Secret{}
+	provided.Namespace = "default"
+	provided.Name = "provided"
+	require.NoError(t, c.Create(ctx, provided))
+	require.NoError(t, ReconcileSecret(ctx, c, "provided", kcKey))
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "provided"}, provided))
+	assert.False(t, IsGenerated(provided, kcKey))
+}
+
+func TestRotatePassword(t *testing.T) {
+	ctx := context.Background()
+	kcKey := client.ObjectKey{Namespace: "default", Name: "test"}
+	secretKey := client.ObjectKey{Namespace: "default", Name: "test-superuser"}
+	c := fake.NewClientBuilder().Build()
+	require.NoError(t, ReconcileSecret(ctx, c, secretKey.Name, kcKey))
+
+	sec := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, secretKey, sec))
+	initialPassword := string(sec.Data["password"])
+
+	require.NoError(t, RotatePassword(ctx, c, sec, "1"))
+	require.NoError(t, c.Get(ctx, secretKey, sec))
+	rotatedPassword := string(sec.Data["password"])
+	assert.NotEqual(t, initialPassword, rotatedPassword)
+	assert.Len(t, rotatedPassword, 20)
+	assert.Equal(t, "test-superuser", string(sec.Data["username"]))
+	assert.Equal(t, "1", sec.Annotations[api.CredentialsRevisionAnnotation])
+
+	// Rotating again for the same revision keeps the password
+	require.NoError(t, RotatePassword(ctx, c, sec, "1"))
+	require.NoError(t, c.Get(ctx, secretKey, sec))
+	assert.Equal(t, rotatedPassword, string(sec.Data["password"]))
+
+	require.NoError(t, RotatePassword(ctx, c, sec, "2"))
+	require.NoError(t, c.Get(ctx, secretKey, sec))
+	assert.NotEqual(t, rotatedPassword, string(sec.Data["password"]))
+	assert.Equal(t, "2", sec.Annotations[api.CredentialsRevisionAnnotation])
+}
@@ -220,6 +220,10 @@ func NewDeployments(stargate *api.Stargate, dc *cassdcapi.CassandraDatacenter) m
 			)
 		}
 
+		if revision := annotations.GetAnnotation(stargate, coreapi.CredentialsRevisionAnnotation); revision != "" {
+			annotations.AddAnnotation(&deployment.Spec.Template, coreapi.CredentialsRevisionAnnotation, revision)
+		}
+
 		annotations.AddHashAnnotation(&deployment)
 		deployments[deploymentName] = deployment
 	}
@@ -241,9 +245,10 @@ func computeClusterVersion(dc *cassdcapi.CassandraDatacenter) ClusterVersion {
 	cassandraVersion := dc.Spec.ServerVersion
 	if strings.HasPrefix(cassandraVersion, "3") {
 		return ClusterVersion3
-	} else {
-		return ClusterVersion4
 	}
+	// Stargate has no persistence module for Cassandra 4.1. Its 4.0 module is used instead: 4.1 nodes still speak the
+	// 4.0 messaging protocol.
+	return ClusterVersion4
 }
 
 func computeImage(template *api.StargateTemplate, clusterVersion ClusterVersion) *images.Image {
@@ -258,21 +263,7 @@ func computeResourceRequirements(template *api.StargateTemplate) corev1.Resource
 	if template.Resources != nil {
 		return *template.Resources
 	} else {
-		heapSize := computeHeapSize(template)
-		memoryRequest := heapSize.DeepCopy()
-		memoryRequest.Add(memoryRequest) // heap x2
-		memoryLimit := memoryRequest.DeepCopy()
-		memoryLimit.Add(memoryLimit) // heap x4
-		return corev1.ResourceRequirements{
-			Requests: map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU:    resource.MustParse("200m"),
-				corev1.ResourceMemory: memoryRequest,
-			},
-			Limits: map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU:    resource.MustParse("1000m"),
-				corev1.ResourceMemory: memoryLimit,
-			},
-		}
+		return api.DefaultResources(computeHeapSize(template))
 	}
 }
 
@@ -335,7 +326,7 @@ func computeHeapSize(template *api.StargateTemplate) resource.Quantity {
 	if template.HeapSize != nil {
 		return *template.HeapSize
 	}
-	return resource.MustParse("256Mi")
+	return api.DefaultHeapSize.DeepCopy()
 }
 
 // This config map will always be created by the k8ssandra controller.
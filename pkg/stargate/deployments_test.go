@@ -7,6 +7,7 @@ import (
 	"github.com/k8ssandra/k8ssandra-operator/pkg/images"
 
 	cassdcapi "github.com/k8ssandra/cass-operator/apis/cassandra/v1beta1"
+	coreapi "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
 	api "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
 	"github.com/k8ssandra/k8ssandra-operator/pkg/encryption"
 	"github.com/stretchr/testify/assert"
@@ -74,6 +75,35 @@ func TestNewDeployments(t *testing.T) {
 	t.Run("CassandraConfigMap", testNewDeploymentsCassandraConfigMap)
 	t.Run("Custom images", testImages)
 	t.Run("Encryption", testNewDeploymentsEncryption)
+	t.Run("CredentialsRevision", testNewDeploymentsCredentialsRevision)
+}
+
+func TestComputeClusterVersion(t *testing.T) {
+	tests := []struct {
+		serverVersion string
+		want          ClusterVersion
+	}{
+		{"3.11.11", ClusterVersion3},
+		{"4.0.1", ClusterVersion4},
+		{"4.1.0", ClusterVersion4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.serverVersion, func(t *testing.T) {
+			dc := &cassdcapi.CassandraDatacenter{Spec: cassdcapi.CassandraDatacenterSpec{ServerVersion: tt.serverVersion}}
+			assert.Equal(t, tt.want, computeClusterVersion(dc))
+		})
+	}
+}
+
+func testNewDeploymentsCredentialsRevision(t *testing.T) {
+	deployment := NewDeployments(stargate, dc)["cluster1-dc1-default-stargate-deployment"]
+	assert.NotContains(t, deployment.Spec.Template.Annotations, coreapi.CredentialsRevisionAnnotation)
+
+	rotatedStargate := stargate.DeepCopy()
+	rotatedStargate.Annotations = map[string]string{coreapi.CredentialsRevisionAnnotation: "3"}
+	rotated := NewDeployments(rotatedStargate, dc)["cluster1-dc1-default-stargate-deployment"]
+	assert.Equal(t, "3", rotated.Spec.Template.Annotations[coreapi.CredentialsRevisionAnnotation])
+	assert.NotEqual(t, deployment.Annotations[coreapi.ResourceHashAnnotation], rotated.Annotations[coreapi.ResourceHashAnnotation])
 }
 
 func testNewDeploymentsDefaultRackSingleReplica(t *testing.T) {
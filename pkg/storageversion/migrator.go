@@ -0,0 +1,151 @@
+// Package storageversion rewrites the K8ssandraCluster objects stored in etcd at an older API version so that they
+// are persisted at the current storage version.
+package storageversion
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// K8ssandraClusterCRDName is the name of the K8ssandraCluster CustomResourceDefinition.
+const K8ssandraClusterCRDName = "k8ssandraclusters.k8ssandra.io"
+
+var crdGvk = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// The CRD is cluster-scoped, so the permissions to read it and update its status are granted by the ClusterRole in
+// config/rbac/crd_role.yaml rather than by RBAC markers, which generate the namespaced Role of the operator.
+
+// Migrator is a manager.Runnable which, once the operator is started, updates every K8ssandraCluster so that the API
+// server persists it at the storage version. When all namespaces are watched, it then removes the older versions from
+// the status.storedVersions of the CRD, which allows them to be dropped from the CRD in a later release.
+type Migrator struct {
+	// Client should not be backed by the manager cache: the objects are only read once.
+	Client client.Client
+
+	// Namespaces are the namespaces watched by the operator. An empty slice, or a single empty string, means all
+	// namespaces.
+	Namespaces []string
+
+	// RetryInterval is the delay between two attempts, e.g. while the conversion webhook is not reachable yet.
+	RetryInterval time.Duration
+
+	Logger logr.Logger
+}
+
+var _ manager.Runnable = &Migrator{}
+var _ manager.LeaderElectionRunnable = &Migrator{}
+
+// NeedLeaderElection makes sure that only the leader migrates objects.
+func (m *Migrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the migration until it succeeds or the context is cancelled.
+func (m *Migrator) Start(ctx context.Context) error {
+	err := wait.PollImmediateUntil(m.RetryInterval, func() (bool, error) {
+		if err := m.Migrate(ctx); err != nil {
+			m.Logger.Error(err, "Failed to migrate K8ssandraClusters to the storage version, will retry",
+				"storageVersion", v1alpha2.GroupVersion.Version)
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		// The context was cancelled, i.e. the manager is stopping.
+		return nil
+	}
+	return err
+}
+
+// Migrate rewrites the K8ssandraClusters and then updates the CRD stored versions, if allowed to.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	for _, namespace := range m.namespaces() {
+		if err := m.migrateNamespace(ctx, namespace); err != nil {
+			return err
+		}
+	}
+
+	if !m.allNamespaces() {
+		m.Logger.Info("Not updating the stored versions of the CRD since only some namespaces are watched",
+			"crd", K8ssandraClusterCRDName)
+		return nil
+	}
+	return m.updateStoredVersions(ctx)
+}
+
+func (m *Migrator) migrateNamespace(ctx context.Context, namespace string) error {
+	kcList := &api.K8ssandraClusterList{}
+	if err := m.Client.List(ctx, kcList, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+	for i := range kcList.Items {
+		kc := &kcList.Items[i]
+		// A patch that does not change anything still makes the API server write the object at the storage version.
+		// It goes through the status subresource, which the defaulting webhook does not intercept, so that the spec
+		// is stored exactly as it is.
+		if err := m.Client.Status().Patch(ctx, kc, client.RawPatch(types.MergePatchType, []byte("{}"))); err != nil {
+			if apierrors.IsNotFound(err) {
+				// The object has been deleted.
+				continue
+			}
+			return err
+		}
+		m.Logger.V(1).Info("Migrated K8ssandraCluster", "K8ssandraCluster", client.ObjectKeyFromObject(kc))
+	}
+	return nil
+}
+
+func (m *Migrator) updateStoredVersions(ctx context.Context) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGvk)
+	if err := m.Client.Get(ctx, client.ObjectKey{Name: K8ssandraClusterCRDName}, crd); err != nil {
+		if apierrors.IsForbidden(err) {
+			m.Logger.Info("Not allowed to read the CRD, its stored versions will not be updated", "crd", K8ssandraClusterCRDName)
+			return nil
+		}
+		return err
+	}
+
+	storedVersions, _, err := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	if err != nil {
+		return err
+	}
+	if len(storedVersions) == 1 && storedVersions[0] == v1alpha2.GroupVersion.Version {
+		return nil
+	}
+
+	if err = unstructured.SetNestedStringSlice(crd.Object, []string{v1alpha2.GroupVersion.Version}, "status", "storedVersions"); err != nil {
+		return err
+	}
+	if err = m.Client.Status().Update(ctx, crd); err != nil {
+		if apierrors.IsForbidden(err) {
+			m.Logger.Info("Not allowed to update the CRD status, its stored versions will not be updated", "crd", K8ssandraClusterCRDName)
+			return nil
+		}
+		return err
+	}
+	m.Logger.Info("Updated the stored versions of the CRD", "crd", K8ssandraClusterCRDName, "previous", storedVersions)
+	return nil
+}
+
+func (m *Migrator) namespaces() []string {
+	if m.allNamespaces() {
+		return []string{""}
+	}
+	return m.Namespaces
+}
+
+func (m *Migrator) allNamespaces() bool {
+	return len(m.Namespaces) == 0 || (len(m.Namespaces) == 1 && m.Namespaces[0] == "")
+}
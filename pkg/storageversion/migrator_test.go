@@ -0,0 +1,85 @@
+package storageversion
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestMigrator(t *testing.T) {
+	t.Run("all namespaces", testMigrateAllNamespaces)
+	t.Run("some namespaces", testMigrateSomeNamespaces)
+}
+
+func newCRD(storedVersions ...interface{}) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{"storedVersions": storedVersions},
+	}}
+	crd.SetGroupVersionKind(crdGvk)
+	crd.SetName(K8ssandraClusterCRDName)
+	return crd
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, api.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newK8ssandraCluster(namespace, name string) *api.K8ssandraCluster {
+	return &api.K8ssandraCluster{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
+func getStoredVersions(t *testing.T, c client.Client) []string {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGvk)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: K8ssandraClusterCRDName}, crd))
+	storedVersions, _, err := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	require.NoError(t, err)
+	return storedVersions
+}
+
+func getResourceVersion(t *testing.T, c client.Client, namespace, name string) string {
+	kc := &api.K8ssandraCluster{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, kc))
+	return kc.ResourceVersion
+}
+
+func testMigrateAllNamespaces(t *testing.T) {
+	c := newFakeClient(t, newK8ssandraCluster("ns1", "kc1"), newK8ssandraCluster("ns2", "kc2"), newCRD("v1alpha1", "v1alpha2"))
+	before1 := getResourceVersion(t, c, "ns1", "kc1")
+	before2 := getResourceVersion(t, c, "ns2", "kc2")
+
+	m := &Migrator{Client: c, Namespaces: []string{""}, Logger: log.Log}
+	require.NoError(t, m.Migrate(context.Background()))
+
+	assert.NotEqual(t, before1, getResourceVersion(t, c, "ns1", "kc1"))
+	assert.NotEqual(t, before2, getResourceVersion(t, c, "ns2", "kc2"))
+	assert.Equal(t, []string{"v1alpha2"}, getStoredVersions(t, c))
+
+	// Running the migration again is a no-op for the CRD.
+	require.NoError(t, m.Migrate(context.Background()))
+	assert.Equal(t, []string{"v1alpha2"}, getStoredVersions(t, c))
+}
+
+func testMigrateSomeNamespaces(t *testing.T) {
+	c := newFakeClient(t, newK8ssandraCluster("ns1", "kc1"), newK8ssandraCluster("ns2", "kc2"), newCRD("v1alpha1", "v1alpha2"))
+	before1 := getResourceVersion(t, c, "ns1", "kc1")
+	before2 := getResourceVersion(t, c, "ns2", "kc2")
+
+	m := &Migrator{Client: c, Namespaces: []string{"ns1"}, Logger: log.Log}
+	require.NoError(t, m.Migrate(context.Background()))
+
+	assert.NotEqual(t, before1, getResourceVersion(t, c, "ns1", "kc1"))
+	assert.Equal(t, before2, getResourceVersion(t, c, "ns2", "kc2"))
+	assert.Equal(t, []string{"v1alpha1", "v1alpha2"}, getStoredVersions(t, c))
+}
@@ -28,12 +28,14 @@ var defaultAdapter ManagementApiFactoryAdapter = func(
 
 	m := new(mocks.ManagementApiFacade)
 	m.On(EnsureKeyspaceReplication, mock.Anything, mock.Anything).Return(nil)
+	m.On(AlterKeyspaceDurableWrites, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	m.On(ListTables, stargate.AuthKeyspace).Return([]string{"token"}, nil)
 	m.On(CreateTable, mock.MatchedBy(func(def *httphelper.TableDefinition) bool {
 		return def.KeyspaceName == stargate.AuthKeyspace && def.TableName == stargate.AuthTable
 	})).Return(nil)
 	m.On(ListKeyspaces, "").Return([]string{}, nil)
 	m.On(GetSchemaVersions).Return(map[string][]string{"fake": {"test"}}, nil)
+	m.On(HasSchemaAgreement).Return(true, nil)
 	return m, nil
 }
 
@@ -87,14 +89,24 @@ func (f *FakeManagementApiFactory) NewManagementApiFacade(
 type ManagementApiMethod string
 
 const (
-	EnsureKeyspaceReplication = "EnsureKeyspaceReplication"
-	GetKeyspaceReplication    = "GetKeyspaceReplication"
-	CreateKeyspaceIfNotExists = "CreateKeyspaceIfNotExists"
-	AlterKeyspace             = "AlterKeyspace"
-	ListKeyspaces             = "ListKeyspaces"
-	CreateTable               = "CreateTable"
-	ListTables                = "ListTables"
-	GetSchemaVersions         = "GetSchemaVersions"
+	EnsureKeyspaceReplication  = "EnsureKeyspaceReplication"
+	GetKeyspaceReplication     = "GetKeyspaceReplication"
+	CreateKeyspaceIfNotExists  = "CreateKeyspaceIfNotExists"
+	AlterKeyspace              = "AlterKeyspace"
+	AlterKeyspaceDurableWrites = "AlterKeyspaceDurableWrites"
+	ListKeyspaces              = "ListKeyspaces"
+	CreateTable                = "CreateTable"
+	ListTables                 = "ListTables"
+	GetSchemaVersions          = "GetSchemaVersions"
+	HasSchemaAgreement         = "HasSchemaAgreement"
+	ListRoles                  = "ListRoles"
+	CreateRole                 = "CreateRole"
+	AlterRole                  = "AlterRole"
+	DropRole                   = "DropRole"
+	GrantPermissions           = "GrantPermissions"
+	RevokePermissions          = "RevokePermissions"
+	UpgradeSSTables            = "UpgradeSSTables"
+	GetJobDetails              = "GetJobDetails"
 )
 
 type FakeManagementApiFacade struct {
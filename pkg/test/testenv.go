@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -35,6 +36,7 @@ import (
 	cassctlapi "github.com/k8ssandra/cass-operator/apis/control/v1alpha1"
 	configapi "github.com/k8ssandra/k8ssandra-operator/apis/config/v1beta1"
 	api "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha1"
+	apiv1alpha2 "github.com/k8ssandra/k8ssandra-operator/apis/k8ssandra/v1alpha2"
 	medusaapi "github.com/k8ssandra/k8ssandra-operator/apis/medusa/v1alpha1"
 	replicationapi "github.com/k8ssandra/k8ssandra-operator/apis/replication/v1alpha1"
 	stargateapi "github.com/k8ssandra/k8ssandra-operator/apis/stargate/v1alpha1"
@@ -179,6 +181,7 @@ func (e *MultiClusterTestEnv) Start(ctx context.Context, t *testing.T, initRecon
 	e.testEnvs = make([]*envtest.Environment, 0)
 	cfgs := make([]*rest.Config, e.clustersToCreate)
 	clusters := make([]cluster.Cluster, 0, e.clustersToCreate)
+	recorders := make(map[string]record.EventRecorder)
 
 	for i := 0; i < e.clustersToCreate; i++ {
 		clusterName := fmt.Sprintf(clusterProtoName, i)
@@ -216,6 +219,7 @@ func (e *MultiClusterTestEnv) Start(ctx context.Context, t *testing.T, initRecon
 			return err
 		}
 		clusters = append(clusters, c)
+		recorders[clusterName] = c.GetEventRecorderFor("k8ssandra-operator")
 	}
 
 	webhookInstallOptions := &e.testEnvs[0].WebhookInstallOptions
@@ -241,6 +245,7 @@ func (e *MultiClusterTestEnv) Start(ctx context.Context, t *testing.T, initRecon
 	clientCache := clientcache.New(k8sManager.GetClient(), e.Clients[controlCluster], scheme.Scheme)
 	for ctxName, cli := range e.Clients {
 		clientCache.AddClient(ctxName, cli)
+		clientCache.AddRecorder(ctxName, recorders[ctxName])
 	}
 
 	if initReconcilers != nil {
@@ -372,6 +377,10 @@ func registerApis() error {
 		return err
 	}
 
+	if err := apiv1alpha2.AddToScheme(scheme.Scheme); err != nil {
+		return err
+	}
+
 	if err := cassdcapi.AddToScheme(scheme.Scheme); err != nil {
 		return err
 	}
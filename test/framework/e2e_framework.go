@@ -211,6 +211,16 @@ replacements:
       kind: ValidatingWebhookConfiguration
     fieldPaths:
       - webhooks.0.clientConfig.service.namespace
+  - select:
+      name: k8ssandra-operator-mutating-webhook-configuration
+      kind: MutatingWebhookConfiguration
+    fieldPaths:
+      - webhooks.0.clientConfig.service.namespace
+  - select:
+      name: k8ssandraclusters.k8ssandra.io
+      kind: CustomResourceDefinition
+    fieldPaths:
+      - spec.conversion.webhook.clientConfig.service.namespace
 `
 
 	dataPlaneTmpl := `
@@ -262,6 +272,16 @@ replacements:
       kind: ValidatingWebhookConfiguration
     fieldPaths:
       - webhooks.0.clientConfig.service.namespace
+  - select:
+      name: k8ssandra-operator-mutating-webhook-configuration
+      kind: MutatingWebhookConfiguration
+    fieldPaths:
+      - webhooks.0.clientConfig.service.namespace
+  - select:
+      name: k8ssandraclusters.k8ssandra.io
+      kind: CustomResourceDefinition
+    fieldPaths:
+      - spec.conversion.webhook.clientConfig.service.namespace
 `
 
 	k := Kustomization{Namespace: config.Namespace, ImageTag: config.ImageTag}
@@ -478,6 +478,24 @@ func (f *Framework) DatacenterExists(ctx context.Context, key ClusterKey) func()
 	})
 }
 
+// EventRecorded returns a function that checks whether an event with the given reason was recorded for the object
+// identified by key. The object can be of any kind. It is intended to be used with require.Eventually.
+func (f *Framework) EventRecorded(ctx context.Context, key ClusterKey, reason string) func() bool {
+	return func() bool {
+		events := &corev1.EventList{}
+		if err := f.List(ctx, key, events, client.InNamespace(key.Namespace)); err != nil {
+			f.logger.Error(err, "failed to list events", "key", key)
+			return false
+		}
+		for _, event := range events.Items {
+			if event.InvolvedObject.Name == key.Name && event.Reason == reason {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // NewWithStargate is a function generator for withStargate that is bound to ctx, and key.
 func (f *Framework) NewWithStargate(ctx context.Context, key ClusterKey) func(func(stargate *stargateapi.Stargate) bool) func() bool {
 	return func(condition func(*stargateapi.Stargate) bool) func() bool {